@@ -137,6 +137,7 @@ description: Overview of all clickup CLI commands, grouped by category.
 	b.WriteString("<!-- This file is auto-generated by gen-docs. Do not edit manually. -->\n\n")
 	b.WriteString("All commands are invoked as subcommands of `clickup`. Run `clickup --help` for a summary, or `clickup <command> --help` for details on any command.\n\n")
 	b.WriteString("Every command that produces output supports `--json` for machine-readable output, `--jq` for inline filtering, and `--template` for Go template formatting.\n\n")
+	b.WriteString("List commands (`task list`, `task search`, `task recent`, `task time list`, `sprint current`, `member list`, `comment list`) also accept the global `--format` flag: `csv`, `tsv`, `markdown`, `yaml` or `ndjson`. Formatted output has no hints or footers, so it can be piped or pasted as is.\n\n")
 	b.WriteString("Flag details, examples, and options for each command are in the auto-generated [Reference](/clickup-cli/reference/clickup/) pages.\n\n")
 
 	for i, cat := range categories {
@@ -173,10 +174,18 @@ func collectCategories(rootCmd *cobra.Command) []commandCategory {
 		"attachment": {"Attachments", 4},
 		"link":       {"Git & GitHub integration", 5},
 		"sprint":     {"Sprints", 6},
+		"board":      {"Sprints", 6},
 		"inbox":      {"Workspace", 7},
 		"member":     {"Workspace", 7},
 		"space":      {"Workspace", 7},
+		"history":    {"Setup & utilities", 8},
+		"undo":       {"Setup & utilities", 8},
 		"auth":       {"Setup & utilities", 8},
+		"alias":      {"Setup & utilities", 8},
+		"search":     {"Setup & utilities", 8},
+		"config":     {"Setup & utilities", 8},
+		"api":        {"Setup & utilities", 8},
+		"dev":        {"Setup & utilities", 8},
 		"version":    {"Setup & utilities", 8},
 		"completion": {"Setup & utilities", 8},
 	}
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/triptechtravel/clickup-cli/internal/build"
@@ -16,39 +19,169 @@ type Client struct {
 	RateLimiter *RateLimiter
 	baseURL     string
 	token       string
+
+	// auth is the client's transport, kept here because WrapClient may
+	// wrap it.
+	auth *authTransport
 }
 
-// authTransport injects the Authorization header into every request.
+// TokenRefresher returns a replacement for an access token the API
+// rejected as expired.
+type TokenRefresher func(ctx context.Context, expired string) (string, error)
+
+// authTransport injects the Authorization header into every request and
+// retries transient failures according to its RetryPolicy. With a refresher
+// set, a request rejected for an expired token is retried once with a
+// fresh token.
 type authTransport struct {
+	base    http.RoundTripper
+	rl      *RateLimiter
+	retry   RetryPolicy
+	debug   io.Writer
+	refresh TokenRefresher
+
+	mu    sync.Mutex
 	token string
-	base  http.RoundTripper
-	rl    *RateLimiter
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", t.token)
-	req.Header.Set("User-Agent", fmt.Sprintf("clickup-cli/%s", build.Version))
+	token := t.currentToken()
+	resp, err := t.send(req, token)
 
-	t.rl.Wait()
+	var expired *AuthExpiredError
+	if t.refresh == nil || !errors.As(err, &expired) {
+		return resp, err
+	}
+	fresh, refreshErr := t.refreshToken(req.Context(), token)
+	if refreshErr != nil {
+		t.debugf("auth: token refresh failed: %v", refreshErr)
+		return nil, err
+	}
+	t.debugf("auth: refreshed expired token; retrying %s %s", req.Method, req.URL)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("replay request body: %w", err)
+		}
+		req.Body = body
+	}
+	return t.send(req, fresh)
+}
+
+func (t *authTransport) currentToken() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
 
-	resp, err := t.base.RoundTrip(req)
+// refreshToken replaces the expired token. Concurrent requests that fail
+// with the same token share one refresh.
+func (t *authTransport) refreshToken(ctx context.Context, expired string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != expired {
+		return t.token, nil
+	}
+	fresh, err := t.refresh(ctx, expired)
 	if err != nil {
-		return resp, err
+		return "", err
 	}
+	if fresh == "" || fresh == expired {
+		return "", fmt.Errorf("no new token issued")
+	}
+	t.token = fresh
+	return fresh, nil
+}
 
-	t.rl.Update(resp)
+// send performs req with token, retrying transient failures.
+func (t *authTransport) send(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set("Authorization", token)
+	req.Header.Set("User-Agent", fmt.Sprintf("clickup-cli/%s", build.Version))
+
+	if err := replayableBody(req); err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	start := time.Now()
+	maxAttempts := t.retry.attempts()
+
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("replay request body: %w", err)
+			}
+			req.Body = body
+		}
 
-	// Retry once on 429
-	if t.rl.ShouldRetry(resp) {
-		resp.Body.Close()
 		t.rl.Wait()
-		resp, err = t.base.RoundTrip(req)
-		if err != nil {
-			return resp, err
+
+		var err error
+		resp, err = t.attempt(req)
+		if err == nil {
+			t.rl.Update(resp)
+		}
+
+		var reason string
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if !isRetryableError(ctx, req.Method, err) {
+				return nil, err
+			}
+			reason = err.Error()
+		case isRetryableStatus(req.Method, resp.StatusCode):
+			reason = resp.Status
+			if d, ok := serverDelay(resp, time.Now()); ok {
+				delay = d
+			}
+		default:
+			return t.finish(resp)
 		}
-		t.rl.Update(resp)
+
+		delay = max(delay, t.retry.backoff(attempt))
+		outOfBudget := t.retry.MaxElapsed > 0 && time.Since(start)+delay > t.retry.MaxElapsed
+		if attempt >= maxAttempts || outOfBudget {
+			t.debugf("retry: %s %s giving up after %d attempt(s) (%s)", req.Method, req.URL, attempt, reason)
+			if err != nil {
+				return nil, err
+			}
+			return t.finish(resp)
+		}
+
+		t.debugf("retry: %s %s attempt %d/%d failed (%s); retrying in %s",
+			req.Method, req.URL, attempt, maxAttempts, reason, delay.Round(time.Millisecond))
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err := sleepCtx(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt performs a single round trip, bounded by the policy's
+// AttemptTimeout. The per-attempt context stays alive until the response
+// body is closed so callers can still read it.
+func (t *authTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.retry.AttemptTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.retry.AttemptTimeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
 	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
 
+// finish applies the 401 handling to the final response.
+func (t *authTransport) finish(resp *http.Response) (*http.Response, error) {
 	if resp.StatusCode == 401 {
 		// Read the body before closing so we can include the actual API error.
 		// ClickUp returns 401 for permission errors (not just expired tokens),
@@ -60,10 +193,9 @@ func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		// error, not an expired token. Return it as a regular API error so the
 		// caller gets the real message instead of "re-authenticate".
 		if len(body) > 0 && strings.Contains(string(body), "ECODE") {
-			return nil, &APIError{
-				StatusCode: 401,
-				Message:    string(body),
-			}
+			apiErr := &APIError{StatusCode: 401}
+			apiErr.parseErrorBody(body)
+			return nil, apiErr
 		}
 		return nil, &AuthExpiredError{Detail: string(body)}
 	}
@@ -71,31 +203,90 @@ func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+func (t *authTransport) debugf(format string, args ...any) {
+	if t.debug != nil {
+		fmt.Fprintf(t.debug, format+"\n", args...)
+	}
+}
+
 const defaultBaseURL = "https://api.clickup.com/api/v2"
 
 // NewClient creates a new API client with the given token.
 func NewClient(token string) *Client {
 	rl := NewRateLimiter()
 
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-		Transport: &authTransport{
-			token: token,
-			base:  http.DefaultTransport,
-			rl:    rl,
-		},
+	transport := &authTransport{
+		token: token,
+		base:  http.DefaultTransport,
+		rl:    rl,
+		retry: DefaultRetryPolicy(),
 	}
 
+	// No http.Client.Timeout: it would span all retry attempts. The
+	// transport applies RetryPolicy.AttemptTimeout to each attempt instead.
+	httpClient := &http.Client{Transport: transport}
+
 	return &Client{
 		HTTPClient:  httpClient,
 		RateLimiter: rl,
 		baseURL:     defaultBaseURL,
 		token:       token,
+		auth:        transport,
 	}
 }
 
-// Token returns the API token used by this client.
+// transport returns the client's authentication transport.
+func (c *Client) transport() (*authTransport, bool) {
+	return c.auth, c.auth != nil
+}
+
+// SetRetryPolicy replaces the retry policy used by the client's transport.
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	t, ok := c.transport()
+	if !ok {
+		return
+	}
+	t.retry = p
+	t.debugf("retry: policy %s", p)
+}
+
+// SetTokenRefresher enables transparent token refresh: when the API rejects
+// the token as expired, refresh is asked for a new one and the request is
+// retried once.
+func (c *Client) SetTokenRefresher(refresh TokenRefresher) {
+	if t, ok := c.transport(); ok {
+		t.refresh = refresh
+	}
+}
+
+// WrapTransport wraps the underlying HTTP transport, beneath authentication,
+// rate limiting and retries, so wrap sees each attempt as sent on the wire.
+func (c *Client) WrapTransport(wrap func(http.RoundTripper) http.RoundTripper) {
+	if t, ok := c.transport(); ok {
+		t.base = wrap(t.base)
+	}
+}
+
+// WrapClient wraps the client's transport above authentication, rate
+// limiting and retries, so wrap sees each request once, however many
+// attempts it takes.
+func (c *Client) WrapClient(wrap func(http.RoundTripper) http.RoundTripper) {
+	c.HTTPClient.Transport = wrap(c.HTTPClient.Transport)
+}
+
+// SetBaseURL points the client at another ClickUp-compatible host, such as
+// a fake server. host is the root URL (e.g. "http://127.0.0.1:8787"); the
+// /api/v2 and /api/v3 paths are appended.
+func (c *Client) SetBaseURL(host string) {
+	c.baseURL = strings.TrimRight(host, "/") + "/api/v2"
+}
+
+// Token returns the API token used by this client, which changes after a
+// refresh.
 func (c *Client) Token() string {
+	if t, ok := c.transport(); ok {
+		return t.currentToken()
+	}
 	return c.token
 }
 
@@ -131,18 +322,20 @@ func (c *Client) DoRequest(req *http.Request) (*http.Response, error) {
 func NewTestClient(baseURL string) *Client {
 	rl := NewRateLimiter()
 
-	httpClient := &http.Client{
-		Transport: &authTransport{
-			token: "test-token",
-			base:  http.DefaultTransport,
-			rl:    rl,
-		},
+	transport := &authTransport{
+		token: "test-token",
+		base:  http.DefaultTransport,
+		rl:    rl,
+		// Retry once without backoff so error-path tests stay fast.
+		retry: RetryPolicy{MaxAttempts: 2},
 	}
+	httpClient := &http.Client{Transport: transport}
 
 	return &Client{
 		HTTPClient:  httpClient,
 		RateLimiter: rl,
 		baseURL:     baseURL + "/api/v2",
 		token:       "test-token",
+		auth:        transport,
 	}
 }
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// dryRunTransport stops requests that would change anything. Reads go
+// through so commands can still resolve names and IDs; every other
+// request is printed and answered with a placeholder object instead of
+// being sent. The placeholder's ID, e.g. "dry-run-1", stands in for the
+// ID of anything the request would have created, so requests that build
+// on it still name it. The placeholder is shaped like the endpoint's
+// response (see dryRunPlaceholder) so the ID is found where callers look.
+type dryRunTransport struct {
+	base http.RoundTripper
+
+	mu  sync.Mutex
+	out io.Writer
+	n   int
+}
+
+// EnableDryRun makes the client print the method, URL and body of each
+// request that would modify data to w instead of sending it. GET, HEAD
+// and OPTIONS requests are still sent.
+func (c *Client) EnableDryRun(w io.Writer) {
+	t, ok := c.transport()
+	if !ok {
+		return
+	}
+	if _, dry := t.base.(*dryRunTransport); !dry {
+		t.base = &dryRunTransport{base: t.base, out: w}
+	}
+}
+
+// DryRunCount returns the number of requests held back by EnableDryRun.
+func (c *Client) DryRunCount() int {
+	t, ok := c.transport()
+	if !ok {
+		return 0
+	}
+	dry, ok := t.base.(*dryRunTransport)
+	if !ok {
+		return 0
+	}
+	dry.mu.Lock()
+	defer dry.mu.Unlock()
+	return dry.n
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.base.RoundTrip(req)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[dry-run] %s %s\n", req.Method, req.URL)
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		writeDryRunBody(&b, req.Header.Get("Content-Type"), data)
+	}
+
+	t.mu.Lock()
+	t.n++
+	body := dryRunPlaceholder(req.URL.Path, t.n)
+	fmt.Fprint(t.out, b.String())
+	t.mu.Unlock()
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// writeDryRunBody prints a JSON body indented beneath the request line.
+// Other bodies, such as file uploads, are summarised by size.
+func writeDryRunBody(b *strings.Builder, contentType string, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	var out bytes.Buffer
+	if json.Indent(&out, data, "  ", "  ") != nil {
+		fmt.Fprintf(b, "  [%d bytes of %s]\n", len(data), contentType)
+		return
+	}
+	fmt.Fprintf(b, "  %s\n", out.String())
+}
+
+// dryRunResponses shape the placeholder for endpoints whose response
+// wraps the object they create or change, keyed by the path below
+// /api/v2/ or /api/v3/. %[1]s is the placeholder ID, %[2]d its number for
+// endpoints with numeric IDs and %[3]s the first ID in the path.
+var dryRunResponses = []struct {
+	path *regexp.Regexp
+	body string
+}{
+	{regexp.MustCompile(`^task/[^/]+/checklist$`), `{"checklist":{"id":%[1]q,"items":[]}}`},
+	{regexp.MustCompile(`^checklist/[^/]+/checklist_item(/[^/]+)?$`), `{"checklist":{"id":%[3]q,"items":[{"id":%[1]q}]}}`},
+	{regexp.MustCompile(`^checklist/[^/]+$`), `{"checklist":{"id":%[3]q,"items":[]}}`},
+	{regexp.MustCompile(`^(task|list|view)/[^/]+/comment$|^comment/[^/]+/reply$`), `{"id":%[2]d,"hist_id":%[1]q,"date":0}`},
+	{regexp.MustCompile(`^team/[^/]+/time_entries(/start|/stop|/[^/]+)?$`), `{"data":{"id":%[1]q}}`},
+	{regexp.MustCompile(`^team/[^/]+/goal$|^goal/[^/]+$`), `{"goal":{"id":%[1]q}}`},
+	{regexp.MustCompile(`^goal/[^/]+/key_result$|^key_result/[^/]+$`), `{"key_result":{"id":%[1]q}}`},
+	{regexp.MustCompile(`^(team|space|folder|list)/[^/]+/view$|^view/[^/]+$`), `{"view":{"id":%[1]q}}`},
+	{regexp.MustCompile(`^team/[^/]+/webhook$|^webhook/[^/]+$`), `{"id":%[1]q,"webhook":{"id":%[1]q}}`},
+}
+
+// dryRunPlaceholder returns the response body standing in for the nth
+// request held back by --dry-run, to path.
+func dryRunPlaceholder(path string, n int) string {
+	rel := strings.TrimPrefix(strings.TrimPrefix(path, "/api/v2/"), "/api/v3/")
+	rel = strings.Trim(rel, "/")
+	id := fmt.Sprintf("dry-run-%d", n)
+	for _, r := range dryRunResponses {
+		if r.path.MatchString(rel) {
+			_, rest, _ := strings.Cut(rel, "/")
+			first, _, _ := strings.Cut(rest, "/")
+			return fmt.Sprintf(r.body, id, n, first)
+		}
+	}
+	return fmt.Sprintf(`{"id":%q}`, id)
+}
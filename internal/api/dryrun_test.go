@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableDryRun(t *testing.T) {
+	var methods []string
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`{"id":"task1","name":"Real"}`))
+	})
+	defer server.Close()
+
+	var out bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.EnableDryRun(&out)
+	client.EnableDryRun(&out) // idempotent
+
+	req, _ := http.NewRequest("GET", client.URL("task/task1"), nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "Real", "reads should go through")
+
+	req, _ = http.NewRequest("PUT", client.URL("task/task1"), strings.NewReader(`{"name":"Renamed"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = client.DoRequest(req)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.JSONEq(t, `{"id":"dry-run-1"}`, string(body))
+
+	req, _ = http.NewRequest("DELETE", client.URL("task/task1"), nil)
+	resp, err = client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"GET"}, methods, "only the read should reach the server")
+	assert.Equal(t, 2, client.DryRunCount())
+	assert.Equal(t, "[dry-run] PUT "+server.URL+"/api/v2/task/task1\n"+
+		"  {\n    \"name\": \"Renamed\"\n  }\n"+
+		"[dry-run] DELETE "+server.URL+"/api/v2/task/task1\n", out.String())
+}
+
+func TestEnableDryRun_NonJSONBody(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request", r.Method)
+	})
+	defer server.Close()
+
+	var out bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.EnableDryRun(&out)
+
+	req, _ := http.NewRequest("POST", client.URL("task/task1/attachment"), strings.NewReader("binary data"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Contains(t, out.String(), "[11 bytes of multipart/form-data; boundary=x]")
+}
+
+func TestEnableDryRun_PlaceholderShapes(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request", r.Method)
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	client.EnableDryRun(io.Discard)
+
+	post := func(path string) string {
+		t.Helper()
+		req, _ := http.NewRequest("POST", client.URL("%s", path), strings.NewReader(`{}`))
+		resp, err := client.DoRequest(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	// A checklist is created, then an item is added to it by the ID the
+	// placeholder gave it.
+	var created struct {
+		Checklist struct {
+			ID string `json:"id"`
+		} `json:"checklist"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(post("task/t1/checklist")), &created))
+	require.NotEmpty(t, created.Checklist.ID)
+	assert.JSONEq(t, `{"checklist":{"id":"`+created.Checklist.ID+`","items":[{"id":"dry-run-2"}]}}`,
+		post("checklist/"+created.Checklist.ID+"/checklist_item"))
+
+	assert.JSONEq(t, `{"id":3,"hist_id":"dry-run-3","date":0}`, post("task/t1/comment"))
+	assert.JSONEq(t, `{"data":{"id":"dry-run-4"}}`, post("team/1/time_entries"))
+	assert.JSONEq(t, `{"id":"dry-run-5"}`, post("list/l1/task"))
+}
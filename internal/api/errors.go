@@ -2,23 +2,80 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 )
 
+// Sentinel errors classifying an APIError. Match them with errors.Is:
+//
+//	if errors.Is(err, api.ErrNotFound) { ... }
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrPermission  = errors.New("permission denied")
+	ErrRateLimited = errors.New("rate limited")
+	ErrValidation  = errors.New("validation failed")
+	ErrServer      = errors.New("server error")
+)
+
 // APIError represents an error returned by the ClickUp API.
 type APIError struct {
 	StatusCode int
 	Message    string
 	Err        string
+	// Code is ClickUp's ECODE, e.g. "ITEM_015", when the response has one.
+	Code string
 }
 
 func (e *APIError) Error() string {
-	if e.Message != "" {
-		return fmt.Sprintf("ClickUp API error (HTTP %d): %s", e.StatusCode, e.Message)
+	msg := e.Message
+	if msg == "" {
+		msg = e.Err
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	return fmt.Sprintf("ClickUp API error (HTTP %d): %s", e.StatusCode, msg)
+}
+
+// Is reports whether target is the sentinel error that classifies e.
+func (e *APIError) Is(target error) bool {
+	return target != nil && e.Kind() == target
+}
+
+// Kind returns the sentinel error that classifies e, or nil if none does.
+// ClickUp reports some missing resources as 400 or 401 with an ECODE, so
+// known codes take precedence over the status.
+func (e *APIError) Kind() error {
+	if kind, ok := codeKinds[e.Code]; ok {
+		return kind
+	}
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusUnauthorized, e.StatusCode == http.StatusForbidden:
+		return ErrPermission
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode == http.StatusBadRequest, e.StatusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case e.StatusCode >= 500:
+		return ErrServer
 	}
-	return fmt.Sprintf("ClickUp API error (HTTP %d): %s", e.StatusCode, e.Err)
+	return nil
+}
+
+// codeKinds classifies ECODEs whose status alone is misleading.
+var codeKinds = map[string]error{
+	"ITEM_015":  ErrNotFound,   // Task not found
+	"LIST_001":  ErrNotFound,   // List not found
+	"DOC_000":   ErrNotFound,   // Doc not found
+	"OAUTH_023": ErrPermission, // Team not authorized
+	"OAUTH_027": ErrPermission, // Team not authorized
 }
 
 // AuthExpiredError indicates the API token is invalid, expired, or revoked.
@@ -34,6 +91,26 @@ func (e *AuthExpiredError) Error() string {
 	return "authentication expired or revoked. Run 'clickup auth login' to re-authenticate"
 }
 
+// errorBody is the error payload ClickUp returns, e.g.
+// {"err": "Task not found", "ECODE": "ITEM_015"}.
+type errorBody struct {
+	Err     string `json:"err"`
+	Message string `json:"message"`
+	ECODE   string `json:"ECODE"`
+}
+
+// parseErrorBody fills e from an error response body. Other bodies, such
+// as a proxy's HTML error page, are ignored and Error falls back to the
+// status text.
+func (e *APIError) parseErrorBody(body []byte) {
+	var eb errorBody
+	if json.Unmarshal(body, &eb) == nil {
+		e.Err = eb.Err
+		e.Message = eb.Message
+		e.Code = eb.ECODE
+	}
+}
+
 // HandleErrorResponse checks an HTTP response for errors and returns a user-friendly error.
 func HandleErrorResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
@@ -43,30 +120,21 @@ func HandleErrorResponse(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
 
 	apiErr := &APIError{StatusCode: resp.StatusCode}
-
-	var errorBody struct {
-		Err     string `json:"err"`
-		Message string `json:"message"`
-		ECODE   string `json:"ECODE"`
-	}
-	if json.Unmarshal(body, &errorBody) == nil {
-		apiErr.Err = errorBody.Err
-		apiErr.Message = errorBody.Message
-	}
+	apiErr.parseErrorBody(body)
 
 	switch resp.StatusCode {
 	case 401:
 		apiErr.Message = "Authentication failed. Run 'clickup auth login' to re-authenticate."
 	case 403:
-		if apiErr.Message == "" {
+		if apiErr.Message == "" && apiErr.Err == "" {
 			apiErr.Message = "You don't have permission to perform this action."
 		}
 	case 404:
-		if apiErr.Message == "" {
+		if apiErr.Message == "" && apiErr.Err == "" {
 			apiErr.Message = "Resource not found. Check the ID and try again."
 		}
 	case 429:
-		if apiErr.Message == "" {
+		if apiErr.Message == "" && apiErr.Err == "" {
 			apiErr.Message = "Rate limit exceeded. Please wait and try again."
 		}
 	}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -141,3 +142,42 @@ func TestAuthExpiredError_Error(t *testing.T) {
 		assert.Contains(t, err.Error(), "re-authenticate")
 	})
 }
+
+func TestHandleErrorResponse_ParsesECODE(t *testing.T) {
+	err := HandleErrorResponse(makeHTTPResponse(404, `{"err": "Task not found", "ECODE": "ITEM_015"}`))
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "ITEM_015", apiErr.Code)
+	assert.Equal(t, "ClickUp API error (HTTP 404): Task not found (ITEM_015)", err.Error())
+}
+
+func TestAPIError_Kind(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *APIError
+		want error
+	}{
+		{"404", &APIError{StatusCode: 404}, ErrNotFound},
+		{"403", &APIError{StatusCode: 403}, ErrPermission},
+		{"401 with ECODE", &APIError{StatusCode: 401, Code: "OAUTH_027"}, ErrPermission},
+		{"429", &APIError{StatusCode: 429}, ErrRateLimited},
+		{"400", &APIError{StatusCode: 400, Code: "INPUT_005"}, ErrValidation},
+		{"500", &APIError{StatusCode: 502}, ErrServer},
+		{"not-found ECODE on 400", &APIError{StatusCode: 400, Code: "ITEM_015"}, ErrNotFound},
+		{"unclassified", &APIError{StatusCode: 409}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.err.Kind())
+			if tt.want != nil {
+				assert.ErrorIs(t, fmt.Errorf("wrapped: %w", tt.err), tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError_ErrorWithoutBody(t *testing.T) {
+	err := HandleErrorResponse(makeHTTPResponse(502, `<html>Bad Gateway</html>`))
+	assert.Equal(t, "ClickUp API error (HTTP 502): Bad Gateway", err.Error())
+}
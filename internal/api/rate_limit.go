@@ -7,11 +7,25 @@ import (
 	"time"
 )
 
+// defaultRateLimit is ClickUp's per-token budget for most plans, used until
+// a response reports X-RateLimit-Limit.
+const defaultRateLimit = 100
+
+// rateLimitWindow is the length of ClickUp's rate limit window, used to
+// estimate the next reset before the server reports one.
+const rateLimitWindow = time.Minute
+
 // RateLimiter tracks ClickUp API rate limits and provides backoff.
+//
+// By default the budget is tracked in memory for one process. After
+// ShareState, the budget lives in a state file guarded by a file lock so
+// concurrent CLI invocations using the same token draw from one budget.
 type RateLimiter struct {
 	mu        sync.Mutex
 	remaining int
 	resetAt   time.Time
+
+	shared *sharedState
 }
 
 // NewRateLimiter creates a new rate limiter.
@@ -19,11 +33,28 @@ func NewRateLimiter() *RateLimiter {
 	return &RateLimiter{remaining: 100}
 }
 
+// ShareState makes the limiter keep its budget in the file at path, shared
+// with every other process pointing at the same file. The file's directory
+// is created on first use. If the file cannot be used the limiter falls
+// back to in-memory tracking.
+func (rl *RateLimiter) ShareState(path string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.shared = newSharedState(path)
+}
+
 // Update reads rate limit headers from a response.
 func (rl *RateLimiter) Update(resp *http.Response) {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
+	if rl.shared != nil {
+		if st, err := rl.shared.update(resp); err == nil {
+			rl.remaining, rl.resetAt = st.Remaining, st.reset()
+			return
+		}
+	}
+
 	if v := resp.Header.Get("X-RateLimit-Remaining"); v != "" {
 		if n, err := strconv.Atoi(v); err == nil {
 			rl.remaining = n
@@ -39,10 +70,18 @@ func (rl *RateLimiter) Update(resp *http.Response) {
 // Wait blocks until it's safe to make another request.
 func (rl *RateLimiter) Wait() {
 	rl.mu.Lock()
+	shared := rl.shared
 	remaining := rl.remaining
 	resetAt := rl.resetAt
 	rl.mu.Unlock()
 
+	if shared != nil {
+		if wait, err := shared.reserve(); err == nil {
+			shared.sleep(wait)
+			return
+		}
+	}
+
 	if remaining > 0 {
 		return
 	}
@@ -52,8 +91,3 @@ func (rl *RateLimiter) Wait() {
 		time.Sleep(waitDuration)
 	}
 }
-
-// ShouldRetry returns true if the response indicates rate limiting and the request should be retried.
-func (rl *RateLimiter) ShouldRetry(resp *http.Response) bool {
-	return resp.StatusCode == 429
-}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/filelock"
+)
+
+// rateState is the on-disk rate limit budget shared between processes.
+type rateState struct {
+	Remaining int   `json:"remaining"`
+	Limit     int   `json:"limit"`
+	ResetAt   int64 `json:"reset_at,omitempty"`
+	// Queued counts callers waiting for a future window. Each one owns a
+	// slot, so waiters are released in arrival order and spaced evenly
+	// rather than all bursting at the reset.
+	Queued int `json:"queued,omitempty"`
+}
+
+func (s *rateState) reset() time.Time {
+	if s.ResetAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(s.ResetAt, 0)
+}
+
+// advance rolls the budget into a new window once the reset time passes.
+// Queued callers are served first from the new window's budget.
+func (s *rateState) advance(now time.Time) {
+	reset := s.reset()
+	if reset.IsZero() || now.Before(reset) {
+		return
+	}
+	if now.Sub(reset) >= rateLimitWindow {
+		// Stale state from an earlier run; nobody can still be queued.
+		*s = rateState{Remaining: s.Limit, Limit: s.Limit}
+		return
+	}
+	served := min(s.Queued, s.Limit)
+	s.Queued -= served
+	s.Remaining = s.Limit - served
+	s.ResetAt = reset.Add(rateLimitWindow).Unix()
+}
+
+// slot returns how long the caller at queue position pos waits after now.
+// Positions are spread evenly across windows at the sustainable rate.
+func (s *rateState) slot(now time.Time, pos int) time.Duration {
+	spacing := rateLimitWindow / time.Duration(s.Limit)
+	return s.reset().Sub(now) + time.Duration(pos)*spacing
+}
+
+// sharedState coordinates a RateLimiter budget through a locked file.
+type sharedState struct {
+	path  string
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newSharedState(path string) *sharedState {
+	return &sharedState{path: path, now: time.Now, sleep: time.Sleep}
+}
+
+// reserve takes one request from the budget and returns how long the
+// caller must wait before sending it.
+func (s *sharedState) reserve() (time.Duration, error) {
+	var wait time.Duration
+	_, err := s.modify(func(st *rateState, now time.Time) {
+		if st.Remaining > 0 {
+			st.Remaining--
+			return
+		}
+		if !now.Before(st.reset()) {
+			st.ResetAt = now.Add(rateLimitWindow).Unix()
+		}
+		wait = st.slot(now, st.Queued)
+		st.Queued++
+	})
+	return wait, err
+}
+
+// update reconciles the shared budget with a response's rate limit headers.
+// The server's remaining count is authoritative only when it reports a new
+// window; within a window the lower of the two counts wins, since other
+// processes may hold reservations the server has not seen yet.
+func (s *sharedState) update(resp *http.Response) (rateState, error) {
+	return s.modify(func(st *rateState, now time.Time) {
+		if n, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit")); err == nil && n > 0 {
+			st.Limit = n
+		}
+
+		remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+		hasRemaining := err == nil
+		ts, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		hasReset := err == nil
+
+		newWindow := hasReset && st.ResetAt != 0 &&
+			time.Unix(ts, 0).Sub(st.reset()) >= rateLimitWindow/2
+		if hasReset {
+			st.ResetAt = ts
+		}
+		if !hasRemaining {
+			return
+		}
+		if newWindow {
+			served := min(st.Queued, remaining)
+			st.Queued -= served
+			st.Remaining = remaining - served
+			return
+		}
+		st.Remaining = min(st.Remaining, remaining)
+	})
+}
+
+// modify runs fn on the state file's contents while holding its lock.
+func (s *sharedState) modify(fn func(st *rateState, now time.Time)) (rateState, error) {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return rateState{}, err
+	}
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return rateState{}, err
+	}
+	defer f.Close()
+
+	if err := filelock.Lock(f); err != nil {
+		return rateState{}, err
+	}
+	defer filelock.Unlock(f)
+
+	var st rateState
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return rateState{}, err
+	}
+	if len(data) == 0 || json.Unmarshal(data, &st) != nil || st.Limit <= 0 {
+		st = rateState{Remaining: defaultRateLimit, Limit: defaultRateLimit}
+	}
+
+	now := s.now()
+	st.advance(now)
+	fn(&st, now)
+
+	data, err = json.Marshal(st)
+	if err != nil {
+		return rateState{}, err
+	}
+	if err := f.Truncate(0); err != nil {
+		return rateState{}, err
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		return rateState{}, err
+	}
+	return st, nil
+}
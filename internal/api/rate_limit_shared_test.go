@@ -0,0 +1,147 @@
+package api
+
+import (
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock drives a sharedState without real sleeping.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newSharedLimiter(path string, clock *fakeClock) (*RateLimiter, *[]time.Duration) {
+	var slept []time.Duration
+	rl := NewRateLimiter()
+	rl.ShareState(path)
+	rl.shared.now = clock.Now
+	rl.shared.sleep = func(d time.Duration) {
+		if d > 0 {
+			slept = append(slept, d)
+		}
+	}
+	return rl, &slept
+}
+
+func rateHeaders(limit, remaining int, reset time.Time) *http.Response {
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return &http.Response{StatusCode: 200, Header: h}
+}
+
+func TestSharedRateLimiter_BudgetSpansLimiters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "ratelimit.json")
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+
+	a, aSlept := newSharedLimiter(path, clock)
+	b, bSlept := newSharedLimiter(path, clock)
+
+	// Process A learns the budget is nearly spent.
+	a.Update(rateHeaders(4, 2, clock.Now().Add(30*time.Second)))
+
+	a.Wait()
+	b.Wait()
+	assert.Empty(t, *aSlept)
+	assert.Empty(t, *bSlept, "B shares A's remaining budget")
+
+	// The budget is exhausted: further callers queue behind the reset,
+	// spaced window/limit apart in arrival order.
+	b.Wait()
+	a.Wait()
+	b.Wait()
+	assert.Equal(t, []time.Duration{30 * time.Second, 60 * time.Second}, *bSlept)
+	assert.Equal(t, []time.Duration{45 * time.Second}, *aSlept)
+}
+
+func TestSharedRateLimiter_NewWindowServesQueueFirst(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	reset := clock.Now().Add(10 * time.Second)
+
+	rl, slept := newSharedLimiter(path, clock)
+	rl.Update(rateHeaders(2, 0, reset))
+
+	rl.Wait() // queued at position 0
+	rl.Wait() // queued at position 1
+	require.Len(t, *slept, 2)
+
+	// After the reset both queued callers are served from the new window,
+	// so a newcomer waits for the following one.
+	clock.Advance(11 * time.Second)
+	rl.Wait()
+	require.Len(t, *slept, 3)
+	assert.Equal(t, 59*time.Second, (*slept)[2])
+}
+
+func TestSharedRateLimiter_ServerReportsNewWindow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+
+	rl, _ := newSharedLimiter(path, clock)
+	rl.Update(rateHeaders(100, 5, clock.Now().Add(5*time.Second)))
+	assert.Equal(t, 5, rl.remaining)
+
+	// A lower count within the same window wins.
+	rl.Update(rateHeaders(100, 40, clock.Now().Add(5*time.Second)))
+	assert.Equal(t, 5, rl.remaining)
+
+	// A reset a full window later means the server started a new window.
+	rl.Update(rateHeaders(100, 99, clock.Now().Add(65*time.Second)))
+	assert.Equal(t, 99, rl.remaining)
+}
+
+func TestSharedRateLimiter_StaleStateResets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+
+	rl, slept := newSharedLimiter(path, clock)
+	rl.Update(rateHeaders(100, 0, clock.Now().Add(time.Second)))
+
+	clock.Advance(10 * time.Minute)
+	rl.Wait()
+	assert.Empty(t, *slept)
+}
+
+func TestSharedRateLimiter_ConcurrentReservations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rl := NewRateLimiter()
+			rl.ShareState(path)
+			rl.shared.now = clock.Now
+			rl.Wait()
+		}()
+	}
+	wg.Wait()
+
+	st, err := newSharedState(path).modify(func(*rateState, time.Time) {})
+	require.NoError(t, err)
+	assert.Equal(t, defaultRateLimit-40, st.Remaining)
+}
@@ -192,55 +192,3 @@ func TestWait_ZeroRemainingFutureReset(t *testing.T) {
 	// or up to ~1 second. We just check it didn't panic.)
 	_ = elapsed
 }
-
-func TestShouldRetry(t *testing.T) {
-	tests := []struct {
-		name       string
-		statusCode int
-		want       bool
-	}{
-		{
-			name:       "429 Too Many Requests",
-			statusCode: 429,
-			want:       true,
-		},
-		{
-			name:       "200 OK",
-			statusCode: 200,
-			want:       false,
-		},
-		{
-			name:       "500 Internal Server Error",
-			statusCode: 500,
-			want:       false,
-		},
-		{
-			name:       "403 Forbidden",
-			statusCode: 403,
-			want:       false,
-		},
-		{
-			name:       "401 Unauthorized",
-			statusCode: 401,
-			want:       false,
-		},
-		{
-			name:       "503 Service Unavailable",
-			statusCode: 503,
-			want:       false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			rl := NewRateLimiter()
-			resp := &http.Response{
-				StatusCode: tt.statusCode,
-			}
-			got := rl.ShouldRetry(resp)
-			if got != tt.want {
-				t.Errorf("ShouldRetry(status=%d) = %v, want %v", tt.statusCode, got, tt.want)
-			}
-		})
-	}
-}
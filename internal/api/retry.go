@@ -0,0 +1,199 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried by the client's
+// transport. Attempts are spaced with jittered exponential backoff, and the
+// server's Retry-After / X-RateLimit-Reset hints take precedence when present.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 1 are treated as 1 (no retries).
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry; it doubles on each
+	// subsequent retry.
+	BaseDelay time.Duration
+	// MaxDelay caps a single computed backoff. Server-provided delays are
+	// not capped by MaxDelay, only by MaxElapsed.
+	MaxDelay time.Duration
+	// MaxElapsed caps the total time spent on a request across all attempts.
+	// A retry that would end past this budget is not attempted. Zero means
+	// no cap.
+	MaxElapsed time.Duration
+	// AttemptTimeout bounds a single attempt, including reading the response
+	// body. Zero means no per-attempt timeout.
+	AttemptTimeout time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		BaseDelay:      500 * time.Millisecond,
+		MaxDelay:       30 * time.Second,
+		MaxElapsed:     2 * time.Minute,
+		AttemptTimeout: 30 * time.Second,
+	}
+}
+
+// String summarises the policy for debug output.
+func (p RetryPolicy) String() string {
+	return fmt.Sprintf("max_attempts=%d base_delay=%s max_delay=%s max_elapsed=%s attempt_timeout=%s",
+		p.MaxAttempts, p.BaseDelay, p.MaxDelay, p.MaxElapsed, p.AttemptTimeout)
+}
+
+// attempts returns the effective number of attempts (at least one).
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns the jittered exponential delay before retry number n
+// (1-based). The result lies in [d/2, d] where d = BaseDelay * 2^(n-1),
+// capped at MaxDelay.
+func (p RetryPolicy) backoff(n int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := 1; i < n; i++ {
+		d *= 2
+		if p.MaxDelay > 0 && d >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// isRetryableStatus reports whether a response status is worth retrying.
+// A POST is only retried on 429: after any other error the server may have
+// applied it, and replaying it could create duplicates.
+func isRetryableStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return method != http.MethodPost
+	}
+	return false
+}
+
+// isRetryableError reports whether a transport error is transient.
+// Cancellation by the caller is never retried, and a POST is only retried
+// when the request cannot have reached the server.
+func isRetryableError(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if method == http.MethodPost {
+		return isDialError(err)
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		// Our own per-attempt timeout fired; the caller's context is still live.
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// isDialError reports whether err happened while connecting, before any of
+// the request was sent.
+func isDialError(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// serverDelay extracts a server-requested delay from Retry-After (seconds or
+// HTTP date) or, for 429 responses, X-RateLimit-Reset (unix seconds).
+func serverDelay(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get("X-RateLimit-Reset"); v != "" {
+			if ts, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return max(time.Unix(ts, 0).Sub(now), 0), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// replayableBody makes sure req can be re-sent. Requests built from
+// bytes/strings readers already carry GetBody; anything else is buffered
+// once so POST and PUT bodies survive a retry.
+func replayableBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return fmt.Errorf("buffer request body: %w", err)
+	}
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+// sleepCtx waits for d or until ctx is done.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnClose releases a per-attempt context once the body is consumed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
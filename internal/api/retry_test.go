@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry_MultipleAttemptsOn502(t *testing.T) {
+	attempts := 0
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(200)
+		w.Write([]byte(`{"ok":true}`))
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	client.SetRetryPolicy(RetryPolicy{MaxAttempts: 4})
+
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	var log bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.HTTPClient.Transport.(*authTransport).debug = &log
+	client.SetRetryPolicy(RetryPolicy{MaxAttempts: 3})
+
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+	assert.Contains(t, log.String(), "policy max_attempts=3")
+	assert.Contains(t, log.String(), "attempt 2/3 failed (503 Service Unavailable)")
+	assert.Contains(t, log.String(), "giving up after 3 attempt(s)")
+}
+
+func TestRetry_ReplaysPostBody(t *testing.T) {
+	var bodies []string
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(200)
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+
+	// A plain io.Reader leaves GetBody unset, forcing the transport to buffer.
+	body := io.MultiReader(strings.NewReader(`{"name":`), strings.NewReader(`"task"}`))
+	req, _ := http.NewRequest("POST", server.URL+"/test", body)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{`{"name":"task"}`, `{"name":"task"}`}, bodies)
+}
+
+func TestRetry_PostNotRetriedOn5xx(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusGatewayTimeout} {
+		attempts := 0
+		server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(status)
+		})
+
+		client := NewTestClient(server.URL)
+		client.SetRetryPolicy(RetryPolicy{MaxAttempts: 4})
+
+		req, _ := http.NewRequest("POST", server.URL+"/test", strings.NewReader(`{}`))
+		resp, err := client.DoRequest(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, 1, attempts, "POST on %d", status)
+
+		attempts = 0
+		req, _ = http.NewRequest("PUT", server.URL+"/test", strings.NewReader(`{}`))
+		resp, err = client.DoRequest(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, 4, attempts, "PUT on %d", status)
+
+		server.Close()
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	ctx := context.Background()
+	dial := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	read := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+	assert.True(t, isRetryableError(ctx, "POST", dial))
+	assert.False(t, isRetryableError(ctx, "POST", read))
+	assert.False(t, isRetryableError(ctx, "POST", context.DeadlineExceeded))
+	assert.False(t, isRetryableError(ctx, "POST", io.ErrUnexpectedEOF))
+
+	assert.True(t, isRetryableError(ctx, "PUT", read))
+	assert.True(t, isRetryableError(ctx, "GET", context.DeadlineExceeded))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, isRetryableError(canceled, "GET", dial))
+}
+
+func TestRetry_HonoursRetryAfter(t *testing.T) {
+	var times []time.Time
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if len(times) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(200)
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Len(t, times, 2)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), 900*time.Millisecond)
+}
+
+func TestRetry_MaxElapsedStopsEarly(t *testing.T) {
+	attempts := 0
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	client.SetRetryPolicy(RetryPolicy{MaxAttempts: 5, MaxElapsed: time.Second})
+
+	start := time.Now()
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for i := 0; i < 20; i++ {
+		d := p.backoff(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+
+		d = p.backoff(3)
+		assert.GreaterOrEqual(t, d, 200*time.Millisecond)
+		assert.LessOrEqual(t, d, 400*time.Millisecond)
+
+		d = p.backoff(10)
+		assert.GreaterOrEqual(t, d, 500*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+	}
+}
+
+func TestServerDelay(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Reset", "1700000005")
+	d, ok := serverDelay(resp, now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	resp.Header.Set("Retry-After", now.Add(3*time.Second).UTC().Format(http.TimeFormat))
+	d, ok = serverDelay(resp, now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	resp = &http.Response{StatusCode: 503, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Reset", "1700000005")
+	_, ok = serverDelay(resp, now)
+	assert.False(t, ok, "reset header only applies to 429")
+}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -121,7 +124,9 @@ func TestAuthTransport_401WithECODE(t *testing.T) {
 	var apiErr *APIError
 	assert.ErrorAs(t, err, &apiErr, "401 with ECODE should be APIError, not AuthExpiredError")
 	assert.Equal(t, 401, apiErr.StatusCode)
-	assert.Contains(t, apiErr.Message, "ECODE")
+	assert.Equal(t, "OAUTH_025", apiErr.Code)
+	assert.Equal(t, "Token invalid", apiErr.Err)
+	assert.ErrorIs(t, err, ErrPermission)
 }
 
 // TestAuthTransport_401EmptyBody returns AuthExpiredError.
@@ -141,6 +146,81 @@ func TestAuthTransport_401EmptyBody(t *testing.T) {
 	assert.ErrorAs(t, err, &authErr, "401 with empty body should be AuthExpiredError")
 }
 
+// TestAuthTransport_RefreshesExpiredToken retries once with a refreshed token.
+func TestAuthTransport_RefreshesExpiredToken(t *testing.T) {
+	var bodies []string
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		if r.Header.Get("Authorization") != "fresh-token" {
+			w.WriteHeader(401)
+			w.Write([]byte(`{"err":"Token expired"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	refreshes := 0
+	client.SetTokenRefresher(func(ctx context.Context, expired string) (string, error) {
+		refreshes++
+		assert.Equal(t, "test-token", expired)
+		return "fresh-token", nil
+	})
+
+	req, _ := http.NewRequest("POST", server.URL+"/test", strings.NewReader(`{"name":"x"}`))
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 1, refreshes)
+	assert.Equal(t, []string{`{"name":"x"}`, `{"name":"x"}`}, bodies, "body should be replayed")
+	assert.Equal(t, "fresh-token", client.Token())
+}
+
+// TestAuthTransport_RefreshFailureKeepsAuthError surfaces the original 401.
+func TestAuthTransport_RefreshFailureKeepsAuthError(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	client.SetTokenRefresher(func(ctx context.Context, expired string) (string, error) {
+		return "", errors.New("refresh token revoked")
+	})
+
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	_, err := client.DoRequest(req)
+
+	var authErr *AuthExpiredError
+	assert.ErrorAs(t, err, &authErr)
+	assert.Equal(t, "test-token", client.Token())
+}
+
+// TestAuthTransport_NoRefreshOnPermissionError leaves ECODE 401s alone.
+func TestAuthTransport_NoRefreshOnPermissionError(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+		w.Write([]byte(`{"err": "Team not authorized", "ECODE": "OAUTH_027"}`))
+	})
+	defer server.Close()
+
+	client := NewTestClient(server.URL)
+	client.SetTokenRefresher(func(ctx context.Context, expired string) (string, error) {
+		t.Error("refresher should not be called for a permission error")
+		return "", nil
+	})
+
+	req, _ := http.NewRequest("GET", server.URL+"/test", nil)
+	_, err := client.DoRequest(req)
+
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+}
+
 // TestClient_URL verifies the URL helper builds correct paths.
 func TestClient_URL(t *testing.T) {
 	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {})
@@ -167,6 +247,15 @@ func TestClient_BaseURLV3(t *testing.T) {
 	assert.Equal(t, server.URL+"/api/v3", v3)
 }
 
+// TestClient_SetBaseURL verifies that a host override keeps the API paths.
+func TestClient_SetBaseURL(t *testing.T) {
+	client := NewClient("pk_test")
+	client.SetBaseURL("http://127.0.0.1:8787/")
+
+	assert.Equal(t, "http://127.0.0.1:8787/api/v2", client.BaseURL())
+	assert.Equal(t, "http://127.0.0.1:8787/api/v3", client.BaseURLV3())
+}
+
 func newTestServer(handler http.HandlerFunc) *httptest.Server {
 	return httptest.NewServer(handler)
 }
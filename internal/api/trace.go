@@ -0,0 +1,215 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxTraceBody caps how much of a body is printed.
+const maxTraceBody = 4096
+
+// tracedResponseHeaders are the response headers worth printing; the rest
+// are noise for debugging ClickUp calls.
+var tracedResponseHeaders = []string{
+	"Content-Type",
+	"Retry-After",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+}
+
+// sensitiveKeys are JSON and form keys whose values are never printed.
+var sensitiveKeys = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"client_secret": true,
+	"code":          true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+}
+
+// personalTokenRe matches ClickUp personal API tokens anywhere in text.
+var personalTokenRe = regexp.MustCompile(`pk_[A-Za-z0-9_]+`)
+
+// traceTransport logs each HTTP round trip. It sits beneath authTransport so
+// every retry attempt is traced individually.
+type traceTransport struct {
+	base   http.RoundTripper
+	bodies bool
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// EnableTrace logs every request and response made by the client to w:
+// method, URL, status, timing and rate-limit headers, plus redacted bodies
+// when bodies is true. The Authorization header is never printed. Retry
+// decisions are logged to w as well.
+func (c *Client) EnableTrace(w io.Writer, bodies bool) {
+	t, ok := c.transport()
+	if !ok {
+		return
+	}
+	t.debug = w
+	if _, traced := t.base.(*traceTransport); !traced {
+		t.base = &traceTransport{base: t.base, bodies: bodies, out: w}
+	}
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "> %s %s\n", req.Method, redactURL(req.URL))
+	for _, k := range sortedKeys(req.Header) {
+		if k == "Authorization" || k == "Cookie" {
+			continue
+		}
+		fmt.Fprintf(&b, "> %s: %s\n", k, strings.Join(req.Header[k], ", "))
+	}
+	if t.bodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			writeTraceBody(&b, "> ", req.Header.Get("Content-Type"), data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		fmt.Fprintf(&b, "! %s (%s)\n", err, elapsed)
+		t.write(b.String())
+		return nil, err
+	}
+
+	fmt.Fprintf(&b, "< %s (%s)\n", resp.Status, elapsed)
+	for _, k := range tracedResponseHeaders {
+		if v := resp.Header.Get(k); v != "" {
+			fmt.Fprintf(&b, "< %s: %s\n", k, v)
+		}
+	}
+	if t.bodies && resp.Body != nil {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			// Surface the read error to the caller on its own read.
+			resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), errReader{readErr}))
+		}
+		writeTraceBody(&b, "< ", resp.Header.Get("Content-Type"), data)
+	}
+
+	t.write(b.String())
+	return resp, nil
+}
+
+// write emits one request's trace as a single block so concurrent requests
+// don't interleave.
+func (t *traceTransport) write(s string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprint(t.out, s)
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func sortedKeys(h http.Header) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// writeTraceBody prints a redacted, truncated body. Only JSON, form and
+// text bodies are shown; anything else is summarised by size.
+func writeTraceBody(b *strings.Builder, prefix, contentType string, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	var text string
+	switch {
+	case strings.Contains(contentType, "json") || json.Valid(data):
+		text = redactJSON(data)
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		text = redactForm(string(data))
+	case strings.HasPrefix(contentType, "text/"):
+		text = string(data)
+	default:
+		fmt.Fprintf(b, "%s[%d bytes of %s]\n", prefix, len(data), contentType)
+		return
+	}
+
+	text = personalTokenRe.ReplaceAllString(text, "[REDACTED]")
+	if len(text) > maxTraceBody {
+		text = fmt.Sprintf("%s... [%d bytes truncated]", text[:maxTraceBody], len(text)-maxTraceBody)
+	}
+	fmt.Fprintf(b, "%s%s\n", prefix, text)
+}
+
+func redactJSON(data []byte) string {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return string(data)
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(data)
+	}
+	return string(out)
+}
+
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, inner := range val {
+			if sensitiveKeys[strings.ToLower(k)] {
+				val[k] = "[REDACTED]"
+				continue
+			}
+			val[k] = redactValue(inner)
+		}
+	case []any:
+		for i, inner := range val {
+			val[i] = redactValue(inner)
+		}
+	}
+	return v
+}
+
+func redactForm(s string) string {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return "[unparseable form body]"
+	}
+	for k := range values {
+		if sensitiveKeys[strings.ToLower(k)] {
+			values[k] = []string{"REDACTED"}
+		}
+	}
+	return values.Encode()
+}
+
+func redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	redacted := *u
+	redacted.RawQuery = redactForm(u.RawQuery)
+	return redacted.String()
+}
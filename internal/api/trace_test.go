@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace_LogsRequestAndResponse(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "97")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(201)
+		w.Write([]byte(`{"id":"t1","access_token":"oauth-secret"}`))
+	})
+	defer server.Close()
+
+	var log bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.EnableTrace(&log, true)
+
+	req, _ := http.NewRequest("POST", server.URL+"/api/v2/list/1/task?client_secret=xyz&page=2", strings.NewReader(`{"name":"Fix","token":"pk_123_ABC"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"id":"t1","access_token":"oauth-secret"}`, string(body), "caller still sees the full body")
+
+	out := log.String()
+	assert.Contains(t, out, "> POST "+server.URL+"/api/v2/list/1/task?client_secret=REDACTED&page=2")
+	assert.Contains(t, out, "> Content-Type: application/json")
+	assert.Contains(t, out, `> {"name":"Fix","token":"[REDACTED]"}`)
+	assert.Contains(t, out, "< 201 Created (")
+	assert.Contains(t, out, "< X-RateLimit-Remaining: 97")
+	assert.Contains(t, out, `< {"access_token":"[REDACTED]","id":"t1"}`)
+
+	assert.NotContains(t, out, "test-token")
+	assert.NotContains(t, out, "Authorization")
+	assert.NotContains(t, out, "oauth-secret")
+	assert.NotContains(t, out, "pk_123")
+	assert.NotContains(t, out, "session=abc")
+}
+
+func TestTrace_BodiesOffByDefault(t *testing.T) {
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"t1"}`))
+	})
+	defer server.Close()
+
+	var log bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.EnableTrace(&log, false)
+
+	req, _ := http.NewRequest("GET", server.URL+"/api/v2/task/t1", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Contains(t, log.String(), "> GET ")
+	assert.Contains(t, log.String(), "< 200 OK")
+	assert.NotContains(t, log.String(), `"id"`)
+}
+
+func TestTrace_EachRetryAttempt(t *testing.T) {
+	attempts := 0
+	server := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(200)
+	})
+	defer server.Close()
+
+	var log bytes.Buffer
+	client := NewTestClient(server.URL)
+	client.EnableTrace(&log, false)
+
+	req, _ := http.NewRequest("GET", server.URL+"/x", nil)
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	out := log.String()
+	assert.Equal(t, 2, strings.Count(out, "> GET "))
+	assert.Contains(t, out, "< 502 Bad Gateway")
+	assert.Contains(t, out, "retry: GET ")
+}
+
+func TestWriteTraceBody_BinarySummarised(t *testing.T) {
+	var b strings.Builder
+	writeTraceBody(&b, "> ", "multipart/form-data; boundary=x", []byte("\x00\x01binary"))
+	assert.Equal(t, "> [8 bytes of multipart/form-data; boundary=x]\n", b.String())
+}
@@ -78,8 +78,7 @@ func CreateTaskAttachment(ctx context.Context, client *api.Client, taskID, filen
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+		return nil, api.HandleErrorResponse(resp)
 	}
 
 	var result AttachmentResponse
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/triptechtravel/clickup-cli/internal/api"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 )
 
 // --- Query helpers ---
@@ -226,6 +229,26 @@ func GetSpacesLocal(ctx context.Context, client *api.Client, teamID string, arch
 	return resp.Spaces, nil
 }
 
+// GetSpaceLocal fetches a single space.
+func GetSpaceLocal(ctx context.Context, client *api.Client, spaceID string) (*clickup.Space, error) {
+	var space clickup.Space
+	path := fmt.Sprintf("space/%s", spaceID)
+	if err := do(ctx, client, "GET", path, nil, &space); err != nil {
+		return nil, err
+	}
+	return &space, nil
+}
+
+// GetFolderLocal fetches a single folder.
+func GetFolderLocal(ctx context.Context, client *api.Client, folderID string) (*clickup.Folder, error) {
+	var folder clickup.Folder
+	path := fmt.Sprintf("folder/%s", folderID)
+	if err := do(ctx, client, "GET", path, nil, &folder); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
 // GetFoldersLocal fetches folders for a space.
 func GetFoldersLocal(ctx context.Context, client *api.Client, spaceID string, archived bool) ([]clickup.Folder, error) {
 	var resp struct {
@@ -318,11 +341,7 @@ func DeleteDependencyLocal(ctx context.Context, client *api.Client, taskID, qs s
 // FetchTeamTasks fetches one page of tasks from the team endpoint with optional
 // extra query params. Used by inbox and view for paginated team task fetching.
 func FetchTeamTasks(ctx context.Context, client *api.Client, teamID string, page int, extraParams string) ([]clickup.Task, error) {
-	path := fmt.Sprintf("team/%s/task?include_closed=true&page=%d&order_by=updated&reverse=true",
-		teamID, page)
-	if extraParams != "" {
-		path += "&" + extraParams
-	}
+	path := teamTasksPath(teamID, extraParams) + fmt.Sprintf("&page=%d", page)
 	var resp struct {
 		Tasks []clickup.Task `json:"tasks"`
 	}
@@ -332,6 +351,47 @@ func FetchTeamTasks(ctx context.Context, client *api.Client, teamID string, page
 	return resp.Tasks, nil
 }
 
+// --- Pagination ---
+
+// TaskPages returns a pager.PageFunc over a page-based task endpoint such
+// as list/{id}/task, team/{id}/task or view/{id}/task. path may carry a
+// query string; the page parameter is set on it. T is usually
+// clickup.Task, or json.RawMessage to keep tasks as returned.
+func TaskPages[T any](client *api.Client, path string) pager.PageFunc[T] {
+	base, rawQuery, _ := strings.Cut(path, "?")
+	return func(ctx context.Context, page int) ([]T, bool, error) {
+		q, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return nil, false, err
+		}
+		q.Set("page", strconv.Itoa(page))
+		var resp struct {
+			Tasks    []T  `json:"tasks"`
+			LastPage bool `json:"last_page"`
+		}
+		if err := do(ctx, client, "GET", base+"?"+q.Encode(), nil, &resp); err != nil {
+			return nil, false, err
+		}
+		return resp.Tasks, resp.LastPage, nil
+	}
+}
+
+// TeamTaskPages returns a pager.PageFunc over the team task endpoint,
+// with the same ordering and extra params as FetchTeamTasks.
+func TeamTaskPages(client *api.Client, teamID, extraParams string) pager.PageFunc[clickup.Task] {
+	return TaskPages[clickup.Task](client, teamTasksPath(teamID, extraParams))
+}
+
+// teamTasksPath returns the team task endpoint, most recently updated
+// first, with closed tasks included.
+func teamTasksPath(teamID, extraParams string) string {
+	path := fmt.Sprintf("team/%s/task?include_closed=true&order_by=updated&reverse=true", teamID)
+	if extraParams != "" {
+		path += "&" + extraParams
+	}
+	return path
+}
+
 // --- User ---
 
 // UserInfo holds the current user's identity from the /user endpoint.
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -711,3 +712,28 @@ func TestFetchTeamTasks_NoExtraParams(t *testing.T) {
 	assert.Contains(t, capturedQuery, "page=0")
 	assert.NotContains(t, capturedQuery, "&&")
 }
+
+func TestTeamTaskPages(t *testing.T) {
+	var capturedQuery string
+
+	_, client := localTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		capturedQuery = r.URL.RawQuery
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tasks":[` + taskJSON + `],"last_page":true}`))
+	})
+
+	fetch := TeamTaskPages(client, "team1", "assignees%5B%5D=user1")
+	tasks, last, err := fetch(context.Background(), 2)
+
+	require.NoError(t, err)
+	assert.True(t, last)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "task123", tasks[0].ID)
+	q, err := url.ParseQuery(capturedQuery)
+	require.NoError(t, err)
+	assert.Equal(t, "2", q.Get("page"))
+	assert.Equal(t, "true", q.Get("include_closed"))
+	assert.Equal(t, "updated", q.Get("order_by"))
+	assert.Equal(t, "user1", q.Get("assignees[]"))
+}
@@ -55,3 +55,9 @@ func do(ctx context.Context, client *api.Client, method, path string, body any,
 
 	return nil
 }
+
+// Do is the exported form of do for endpoints without a generated
+// wrapper yet. path is relative to the v3 base.
+func Do(ctx context.Context, client *api.Client, method, path string, body any, result any) error {
+	return do(ctx, client, method, path, body, result)
+}
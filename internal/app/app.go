@@ -1,11 +1,17 @@
 package app
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"os/exec"
 
-	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/alias"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/root"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
@@ -17,22 +23,80 @@ func Run() int {
 
 	rootCmd := root.NewCmdRoot(f)
 
-	if err := rootCmd.Execute(); err != nil {
-		if cmdutil.IsSilentError(err) {
-			return 1
+	args := os.Args[1:]
+	f.CommandLine = cmdutil.CommandLine(args)
+	if len(args) > 0 && !alias.IsBuiltin(rootCmd, args[0]) {
+		// Aliases live in the top-level config, so read it directly rather
+		// than through the Factory, which must wait for --profile.
+		if cfg, err := config.Load(); err == nil {
+			expanded, isShell, err := alias.ExpandAlias(cfg.Aliases, args)
+			if err != nil {
+				fmt.Fprintln(ios.ErrOut, err.Error())
+				return cmdutil.ExitError
+			}
+			if isShell {
+				return runShellAlias(ios, expanded)
+			}
+			args = expanded
 		}
-		var authExpired *api.AuthExpiredError
-		if errors.As(err, &authExpired) {
-			fmt.Fprintln(ios.ErrOut, authExpired.Error())
-			return 4
-		}
-		if cmdutil.IsAuthError(err) {
-			fmt.Fprintln(ios.ErrOut, err.Error())
-			return 4
+	}
+	rootCmd.SetArgs(args)
+
+	cmd, err := rootCmd.ExecuteC()
+	if f.DryRun {
+		fmt.Fprintf(ios.ErrOut, "Dry run: %s not sent; nothing in ClickUp was changed.\n",
+			text.Pluralize(f.DryRunCount(), "request"))
+	}
+	if err == nil {
+		return cmdutil.ExitOK
+	}
+	return printError(ios, cmd, err)
+}
+
+// printError reports the error of cmd on stderr and returns the exit code
+// for it.
+func printError(ios *iostreams.IOStreams, cmd *cobra.Command, err error) int {
+	info := cmdutil.DescribeError(err)
+	if wantsJSON(cmd) {
+		// Scripts asking for JSON get the error as JSON too, on stderr so
+		// stdout stays empty.
+		data, _ := json.Marshal(map[string]cmdutil.ErrorInfo{"error": info})
+		fmt.Fprintln(ios.ErrOut, string(data))
+		return info.ExitCode
+	}
+	if !cmdutil.IsSilentError(err) {
+		fmt.Fprintln(ios.ErrOut, err.Error())
+	}
+	return info.ExitCode
+}
+
+// wantsJSON reports whether cmd was run with --json or --jq.
+func wantsJSON(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	if f := cmd.Flags().Lookup("json"); f != nil && f.Value.String() == "true" {
+		return true
+	}
+	if f := cmd.Flags().Lookup("jq"); f != nil && f.Value.String() != "" {
+		return true
+	}
+	return false
+}
+
+// runShellAlias runs an expanded "!" alias and returns its exit code.
+func runShellAlias(ios *iostreams.IOStreams, argv []string) int {
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stdin = ios.In
+	cmd.Stdout = ios.Out
+	cmd.Stderr = ios.ErrOut
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
 		}
 		fmt.Fprintln(ios.ErrOut, err.Error())
 		return 1
 	}
-
 	return 0
 }
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+func testIOStreams() (*iostreams.IOStreams, *bytes.Buffer, *bytes.Buffer) {
+	ios := iostreams.Test()
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	ios.Out, ios.ErrOut = stdout, stderr
+	return ios, stdout, stderr
+}
+
+func newJSONCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "view"}
+	var flags cmdutil.JSONFlags
+	cmdutil.AddJSONFlags(cmd, &flags)
+	require.NoError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func TestPrintError_Text(t *testing.T) {
+	ios, _, stderr := testIOStreams()
+	err := fmt.Errorf("failed to get task: %w", &api.APIError{StatusCode: 404, Err: "Task not found", Code: "ITEM_015"})
+
+	code := printError(ios, newJSONCmd(t), err)
+
+	assert.Equal(t, cmdutil.ExitNotFound, code)
+	assert.Equal(t, "failed to get task: ClickUp API error (HTTP 404): Task not found (ITEM_015)\n", stderr.String())
+}
+
+func TestPrintError_JSON(t *testing.T) {
+	ios, stdout, stderr := testIOStreams()
+	err := fmt.Errorf("failed to get task: %w", &api.APIError{StatusCode: 404, Err: "Task not found", Code: "ITEM_015"})
+
+	code := printError(ios, newJSONCmd(t, "--json"), err)
+
+	assert.Equal(t, cmdutil.ExitNotFound, code)
+	assert.Empty(t, stdout.String())
+	var got struct {
+		Error cmdutil.ErrorInfo `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(stderr.Bytes(), &got))
+	assert.Equal(t, cmdutil.ErrorInfo{
+		Kind:     "not_found",
+		Message:  "failed to get task: ClickUp API error (HTTP 404): Task not found (ITEM_015)",
+		ExitCode: cmdutil.ExitNotFound,
+		Status:   404,
+		Code:     "ITEM_015",
+	}, got.Error)
+}
+
+func TestPrintError_SilentError(t *testing.T) {
+	ios, _, stderr := testIOStreams()
+
+	code := printError(ios, newJSONCmd(t), &cmdutil.SilentError{Err: fmt.Errorf("no task ID found in branch")})
+
+	assert.Equal(t, cmdutil.ExitError, code)
+	assert.Empty(t, stderr.String())
+}
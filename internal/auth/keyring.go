@@ -1,7 +1,11 @@
 package auth
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/zalando/go-keyring"
@@ -11,66 +15,190 @@ const (
 	serviceName = "clickup-cli"
 	tokenKey    = "api_token"
 	methodKey   = "auth_method"
+	metaKey     = "token_meta"
 )
 
-// StoreToken saves the API token to the OS keyring, falling back to plaintext.
+// tokenMeta is the refresh metadata kept in the keyring next to the token.
+type tokenMeta struct {
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitzero"`
+	CreatedAt    time.Time `json:"created_at,omitzero"`
+}
+
+// keyFor scopes a keyring key to a profile. The default profile keeps the
+// original unscoped keys so existing logins carry over.
+func keyFor(key, profile string) string {
+	if profile == "" || profile == config.DefaultProfile {
+		return key
+	}
+	return key + ":" + profile
+}
+
+// StoreToken saves the API token of the default profile.
 func StoreToken(token, method string) error {
-	err := keyring.Set(serviceName, tokenKey, token)
+	return StoreProfileToken(config.DefaultProfile, token, method)
+}
+
+// GetToken retrieves the stored API token of the default profile.
+func GetToken() (string, error) {
+	return GetProfileToken(config.DefaultProfile)
+}
+
+// GetAuthMethod returns the authentication method of the default profile.
+func GetAuthMethod() string {
+	return GetProfileAuthMethod(config.DefaultProfile)
+}
+
+// ClearToken removes the stored credentials of the default profile.
+func ClearToken() error {
+	return ClearProfileToken(config.DefaultProfile)
+}
+
+// StoreProfileToken saves a profile's API token, recording when it was issued.
+func StoreProfileToken(profile, token, method string) error {
+	return StoreCredentials(profile, config.ProfileAuth{
+		Token:      token,
+		AuthMethod: method,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+// StoreCredentials saves a profile's token and refresh metadata to the OS keyring, falling back to plaintext.
+func StoreCredentials(profile string, creds config.ProfileAuth) error {
+	inFile, err := saveCredentials(profile, creds)
+	if err != nil {
+		return err
+	}
+	if inFile {
+		fmt.Println("Warning: Could not use OS keyring. Token stored in plain text at", config.AuthFile())
+	}
+	return nil
+}
+
+// saveCredentials stores creds and reports whether it had to fall back to
+// the plaintext auth file.
+func saveCredentials(profile string, creds config.ProfileAuth) (bool, error) {
+	err := keyring.Set(serviceName, keyFor(tokenKey, profile), creds.Token)
 	if err == nil {
-		_ = keyring.Set(serviceName, methodKey, method)
-		return nil
+		_ = keyring.Set(serviceName, keyFor(methodKey, profile), creds.AuthMethod)
+		meta, _ := json.Marshal(tokenMeta{
+			RefreshToken: creds.RefreshToken,
+			ExpiresAt:    creds.ExpiresAt,
+			CreatedAt:    creds.CreatedAt,
+		})
+		_ = keyring.Set(serviceName, keyFor(metaKey, profile), string(meta))
+		return false, nil
 	}
 
 	// Fallback to file-based storage
-	ac := &config.AuthConfig{
-		Token:      token,
-		AuthMethod: method,
+	ac, loadErr := config.LoadAuth()
+	if loadErr != nil {
+		ac = &config.AuthConfig{}
 	}
+	ac.SetProfile(profile, creds)
 	if saveErr := ac.Save(); saveErr != nil {
-		return fmt.Errorf("failed to store token: keyring error: %w, file error: %v", err, saveErr)
+		return false, fmt.Errorf("failed to store token: keyring error: %w, file error: %v", err, saveErr)
 	}
-	fmt.Println("Warning: Could not use OS keyring. Token stored in plain text at", config.AuthFile())
-	return nil
+	return true, nil
 }
 
-// GetToken retrieves the stored API token.
-func GetToken() (string, error) {
-	token, err := keyring.Get(serviceName, tokenKey)
+// TokenEnv is the environment variable that supplies a token directly,
+// taking precedence over the keyring and auth.yml for every profile.
+const TokenEnv = "CLICKUP_TOKEN"
+
+// CredentialSource says where a profile's token was found.
+type CredentialSource string
+
+const (
+	SourceEnv     CredentialSource = "env"
+	SourceKeyring CredentialSource = "keyring"
+	SourceFile    CredentialSource = "file"
+)
+
+// LoadCredentials returns a profile's token and refresh metadata.
+func LoadCredentials(profile string) (config.ProfileAuth, error) {
+	creds, _, err := LookupCredentials(profile)
+	return creds, err
+}
+
+// LookupCredentials returns a profile's token and where it came from:
+// CLICKUP_TOKEN, then the OS keyring, then auth.yml.
+func LookupCredentials(profile string) (config.ProfileAuth, CredentialSource, error) {
+	if token := os.Getenv(TokenEnv); token != "" {
+		// Personal API tokens start with pk_; anything else is an OAuth
+		// access token.
+		method := "token"
+		if !strings.HasPrefix(token, "pk_") {
+			method = "oauth"
+		}
+		return config.ProfileAuth{Token: token, AuthMethod: method}, SourceEnv, nil
+	}
+	return loadStored(profile)
+}
+
+// loadStored reads a profile's credentials from the keyring or auth.yml,
+// ignoring CLICKUP_TOKEN.
+func loadStored(profile string) (config.ProfileAuth, CredentialSource, error) {
+	token, err := keyring.Get(serviceName, keyFor(tokenKey, profile))
 	if err == nil && token != "" {
-		return token, nil
+		creds := config.ProfileAuth{Token: token}
+		creds.AuthMethod, _ = keyring.Get(serviceName, keyFor(methodKey, profile))
+		if data, err := keyring.Get(serviceName, keyFor(metaKey, profile)); err == nil {
+			var meta tokenMeta
+			if json.Unmarshal([]byte(data), &meta) == nil {
+				creds.RefreshToken = meta.RefreshToken
+				creds.ExpiresAt = meta.ExpiresAt
+				creds.CreatedAt = meta.CreatedAt
+			}
+		}
+		return creds, SourceKeyring, nil
 	}
 
 	// Fallback to file-based storage
 	ac, err := config.LoadAuth()
 	if err != nil {
-		return "", fmt.Errorf("no stored credentials found: %w", err)
+		return config.ProfileAuth{}, "", fmt.Errorf("no stored credentials found: %w", err)
 	}
-	if ac.Token == "" {
-		return "", fmt.Errorf("not authenticated. Run 'clickup auth login' to authenticate")
+	if creds := ac.ForProfile(profile); creds.Token != "" {
+		return creds, SourceFile, nil
 	}
-	return ac.Token, nil
+	if keyFor(tokenKey, profile) == tokenKey {
+		return config.ProfileAuth{}, "", fmt.Errorf("not authenticated. Run 'clickup auth login' or set %s to authenticate", TokenEnv)
+	}
+	return config.ProfileAuth{}, "", fmt.Errorf("not authenticated for profile %q. Run 'clickup auth login --profile %s' to authenticate", profile, profile)
 }
 
-// GetAuthMethod returns the authentication method used ("oauth" or "token").
-func GetAuthMethod() string {
-	method, err := keyring.Get(serviceName, methodKey)
-	if err == nil && method != "" {
-		return method
-	}
+// GetProfileToken retrieves a profile's stored API token.
+func GetProfileToken(profile string) (string, error) {
+	creds, err := LoadCredentials(profile)
+	return creds.Token, err
+}
 
-	ac, err := config.LoadAuth()
-	if err != nil || ac.AuthMethod == "" {
+// GetProfileAuthMethod returns the authentication method of a profile ("oauth" or "token").
+func GetProfileAuthMethod(profile string) string {
+	creds, err := LoadCredentials(profile)
+	if err != nil || creds.AuthMethod == "" {
 		return "unknown"
 	}
-	return ac.AuthMethod
+	return creds.AuthMethod
 }
 
-// ClearToken removes stored credentials from keyring and file.
-func ClearToken() error {
-	_ = keyring.Delete(serviceName, tokenKey)
-	_ = keyring.Delete(serviceName, methodKey)
+// ClearProfileToken removes a profile's stored credentials from keyring and file.
+func ClearProfileToken(profile string) error {
+	_ = keyring.Delete(serviceName, keyFor(tokenKey, profile))
+	_ = keyring.Delete(serviceName, keyFor(methodKey, profile))
+	_ = keyring.Delete(serviceName, keyFor(metaKey, profile))
 
-	ac := &config.AuthConfig{}
-	_ = ac.Clear()
-	return nil
+	ac, err := config.LoadAuth()
+	if err != nil {
+		return nil
+	}
+	ac.SetProfile(profile, config.ProfileAuth{})
+	if ac.Empty() {
+		if err := ac.Clear(); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return ac.Save()
 }
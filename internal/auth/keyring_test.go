@@ -165,3 +165,93 @@ func TestClearTokenFileFallback(t *testing.T) {
 		t.Fatal("GetToken() after ClearToken() should return an error")
 	}
 }
+
+func TestProfileTokensAreSeparate(t *testing.T) {
+	keyring.MockInit()
+	setConfigDir(t)
+
+	if err := StoreToken("pk_default", "token"); err != nil {
+		t.Fatalf("StoreToken() error: %v", err)
+	}
+	if err := StoreProfileToken("acme", "pk_acme", "oauth"); err != nil {
+		t.Fatalf("StoreProfileToken() error: %v", err)
+	}
+
+	if got, _ := GetToken(); got != "pk_default" {
+		t.Errorf("GetToken() = %q, want %q", got, "pk_default")
+	}
+	if got, _ := GetProfileToken("acme"); got != "pk_acme" {
+		t.Errorf("GetProfileToken(acme) = %q, want %q", got, "pk_acme")
+	}
+	if got := GetProfileAuthMethod("acme"); got != "oauth" {
+		t.Errorf("GetProfileAuthMethod(acme) = %q, want %q", got, "oauth")
+	}
+
+	if err := ClearProfileToken("acme"); err != nil {
+		t.Fatalf("ClearProfileToken() error: %v", err)
+	}
+	_, err := GetProfileToken("acme")
+	if err == nil || !strings.Contains(err.Error(), "--profile acme") {
+		t.Errorf("GetProfileToken(acme) after clear: err = %v, want login hint", err)
+	}
+	if got, _ := GetToken(); got != "pk_default" {
+		t.Errorf("GetToken() after clearing acme = %q, want %q", got, "pk_default")
+	}
+}
+
+func TestProfileTokensFileFallback(t *testing.T) {
+	keyring.MockInitWithError(fmt.Errorf("keyring unavailable"))
+	setConfigDir(t)
+
+	oldStdout := os.Stdout
+	_, w, _ := os.Pipe()
+	os.Stdout = w
+	errA := StoreToken("pk_default", "token")
+	errB := StoreProfileToken("acme", "pk_acme", "token")
+	w.Close()
+	os.Stdout = oldStdout
+	if errA != nil || errB != nil {
+		t.Fatalf("store errors: %v, %v", errA, errB)
+	}
+
+	if err := ClearToken(); err != nil {
+		t.Fatalf("ClearToken() error: %v", err)
+	}
+	if _, err := GetToken(); err == nil {
+		t.Error("GetToken() after ClearToken() should return an error")
+	}
+	if got, _ := GetProfileToken("acme"); got != "pk_acme" {
+		t.Errorf("GetProfileToken(acme) = %q, want %q", got, "pk_acme")
+	}
+
+	if err := ClearProfileToken("acme"); err != nil {
+		t.Fatalf("ClearProfileToken() error: %v", err)
+	}
+	if _, err := os.Stat(config.AuthFile()); !os.IsNotExist(err) {
+		t.Errorf("auth file should be removed once empty, stat err = %v", err)
+	}
+}
+
+func TestLookupCredentials_EnvTokenWins(t *testing.T) {
+	keyring.MockInit()
+	setConfigDir(t)
+
+	if err := StoreToken("pk_stored", "token"); err != nil {
+		t.Fatalf("StoreToken() error: %v", err)
+	}
+	creds, source, err := LookupCredentials(config.DefaultProfile)
+	if err != nil || creds.Token != "pk_stored" || source != SourceKeyring {
+		t.Fatalf("LookupCredentials() = %q from %q (err %v), want pk_stored from keyring", creds.Token, source, err)
+	}
+
+	t.Setenv(TokenEnv, "pk_from_env")
+	for _, profile := range []string{config.DefaultProfile, "work"} {
+		creds, source, err = LookupCredentials(profile)
+		if err != nil || creds.Token != "pk_from_env" || source != SourceEnv {
+			t.Errorf("LookupCredentials(%q) = %q from %q (err %v), want pk_from_env from env", profile, creds.Token, source, err)
+		}
+	}
+	if creds.AuthMethod != "token" {
+		t.Errorf("AuthMethod = %q, want token", creds.AuthMethod)
+	}
+}
@@ -9,35 +9,61 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/config"
 )
 
-const (
-	// Default ClickUp OAuth app credentials. These can be overridden via config.
+// Default ClickUp OAuth app credentials. Release builds set them with
+// -ldflags "-X github.com/triptechtravel/clickup-cli/internal/auth.DefaultClientID=...".
+var (
 	DefaultClientID     = ""
 	DefaultClientSecret = ""
+)
 
+const (
 	authorizeURL = "https://app.clickup.com/api"
-	tokenURL     = "https://api.clickup.com/api/v2/oauth/token"
 	redirectPath = "/callback"
 )
 
+// tokenURL is the OAuth token endpoint. Tests point it at a local server.
+var tokenURL = "https://api.clickup.com/api/v2/oauth/token"
+
+// OAuthToken is a token issued by the OAuth token endpoint. RefreshToken
+// and ExpiresAt are empty when the server does not issue expiring tokens.
+type OAuthToken struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// Credentials converts the token into the stored form for an OAuth login.
+func (t *OAuthToken) Credentials() config.ProfileAuth {
+	return config.ProfileAuth{
+		Token:        t.AccessToken,
+		AuthMethod:   "oauth",
+		RefreshToken: t.RefreshToken,
+		ExpiresAt:    t.ExpiresAt,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
 // OAuthResult holds the result of an OAuth flow.
 type OAuthResult struct {
-	AccessToken string
-	Error       error
+	Token *OAuthToken
+	Error error
 }
 
 // OAuthFlow performs the OAuth 2.0 authorization code flow using a local HTTP server.
-func OAuthFlow(clientID, clientSecret string) (string, error) {
+func OAuthFlow(clientID, clientSecret string) (*OAuthToken, error) {
 	if clientID == "" || clientSecret == "" {
-		return "", fmt.Errorf("OAuth client_id and client_secret are required.\n" +
+		return nil, fmt.Errorf("OAuth client_id and client_secret are required.\n" +
 			"Register an OAuth app at https://clickup.com/integrations and set them in config,\n" +
 			"or use 'clickup auth login --token' to authenticate with a personal API token instead")
 	}
 
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
-		return "", fmt.Errorf("failed to start local server: %w", err)
+		return nil, fmt.Errorf("failed to start local server: %w", err)
 	}
 	port := listener.Addr().(*net.TCPAddr).Port
 	redirectURI := fmt.Sprintf("http://localhost:%d%s", port, redirectPath)
@@ -64,7 +90,7 @@ func OAuthFlow(clientID, clientSecret string) (string, error) {
 			return
 		}
 
-		resultCh <- OAuthResult{AccessToken: token}
+		resultCh <- OAuthResult{Token: token}
 		fmt.Fprint(w, "<html><body><h2>✓ Authenticated with ClickUp!</h2><p>You can close this tab and return to the terminal.</p></body></html>")
 	})
 
@@ -86,14 +112,14 @@ func OAuthFlow(clientID, clientSecret string) (string, error) {
 		defer cancel()
 		_ = server.Shutdown(ctx)
 		if result.Error != nil {
-			return "", result.Error
+			return nil, result.Error
 		}
-		return result.AccessToken, nil
+		return result.Token, nil
 	case <-time.After(5 * time.Minute):
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		_ = server.Shutdown(ctx)
-		return "", fmt.Errorf("authorization timed out after 5 minutes")
+		return nil, fmt.Errorf("authorization timed out after 5 minutes")
 	}
 }
 
@@ -102,35 +128,75 @@ func GetAuthURL(clientID, redirectURI string) string {
 	return fmt.Sprintf("%s?client_id=%s&redirect_uri=%s", authorizeURL, url.QueryEscape(clientID), url.QueryEscape(redirectURI))
 }
 
-func exchangeCode(code, clientID, clientSecret, redirectURI string) (string, error) {
+func exchangeCode(code, clientID, clientSecret, redirectURI string) (*OAuthToken, error) {
 	data := url.Values{
 		"client_id":     {clientID},
 		"client_secret": {clientSecret},
 		"code":          {code},
 	}
+	return requestToken(context.Background(), data)
+}
 
-	resp, err := http.Post(tokenURL, "application/x-www-form-urlencoded", strings.NewReader(data.Encode()))
+// RefreshAccessToken exchanges a refresh token for a new access token.
+func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*OAuthToken, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("OAuth client_id and client_secret are required to refresh the token")
+	}
+	data := url.Values{
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}
+	token, err := requestToken(ctx, data)
 	if err != nil {
-		return "", fmt.Errorf("token exchange request failed: %w", err)
+		return nil, err
+	}
+	// Servers that don't rotate refresh tokens omit it from the response.
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+func requestToken(ctx context.Context, data url.Values) (*OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	var result struct {
-		AccessToken string `json:"access_token"`
-		Error       string `json:"error"`
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+		Error        string `json:"error"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("failed to decode token response: %w", err)
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
 	}
 
 	if result.Error != "" {
-		return "", fmt.Errorf("token exchange error: %s", result.Error)
+		return nil, fmt.Errorf("token exchange error: %s", result.Error)
 	}
 
 	if result.AccessToken == "" {
-		return "", fmt.Errorf("no access token in response")
+		return nil, fmt.Errorf("no access token in response")
 	}
 
-	return result.AccessToken, nil
+	token := &OAuthToken{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+	}
+	if result.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().UTC().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, nil
 }
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/config"
+)
+
+// ErrNoRefreshToken is returned when a token expired and there is nothing
+// to refresh it with, as with personal API tokens.
+var ErrNoRefreshToken = errors.New("no refresh token stored")
+
+// Expired reports whether creds carry an expiry that has passed.
+func Expired(creds config.ProfileAuth) bool {
+	return !creds.ExpiresAt.IsZero() && !time.Now().Before(creds.ExpiresAt)
+}
+
+// RefreshProfileToken exchanges a profile's refresh token for a new access
+// token and stores it. expired is the token the API rejected; if another
+// process has already replaced it, the stored token is returned as is.
+func RefreshProfileToken(ctx context.Context, profile, expired string) (string, error) {
+	creds, _, err := loadStored(profile)
+	if err != nil {
+		return "", err
+	}
+	if creds.Token != expired {
+		return creds.Token, nil
+	}
+	if creds.RefreshToken == "" {
+		return "", ErrNoRefreshToken
+	}
+
+	token, err := RefreshAccessToken(ctx, DefaultClientID, DefaultClientSecret, creds.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("refresh token: %w", err)
+	}
+	if _, err := saveCredentials(profile, token.Credentials()); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
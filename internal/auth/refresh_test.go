@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/zalando/go-keyring"
+)
+
+// fakeTokenEndpoint serves the OAuth token endpoint and counts refreshes.
+func fakeTokenEndpoint(t *testing.T, response string) *int {
+	t.Helper()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		r.ParseForm()
+		if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != "rt_old" {
+			t.Errorf("unexpected refresh request: %v", r.Form)
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	oldURL, oldID, oldSecret := tokenURL, DefaultClientID, DefaultClientSecret
+	tokenURL, DefaultClientID, DefaultClientSecret = srv.URL, "cid", "secret"
+	t.Cleanup(func() { tokenURL, DefaultClientID, DefaultClientSecret = oldURL, oldID, oldSecret })
+	return &calls
+}
+
+func TestRefreshProfileToken(t *testing.T) {
+	keyring.MockInit()
+	setConfigDir(t)
+	calls := fakeTokenEndpoint(t, `{"access_token":"at_new","refresh_token":"rt_new","expires_in":3600}`)
+
+	err := StoreCredentials("acme", config.ProfileAuth{Token: "at_old", AuthMethod: "oauth", RefreshToken: "rt_old"})
+	if err != nil {
+		t.Fatalf("StoreCredentials() error: %v", err)
+	}
+
+	got, err := RefreshProfileToken(context.Background(), "acme", "at_old")
+	if err != nil {
+		t.Fatalf("RefreshProfileToken() error: %v", err)
+	}
+	if got != "at_new" {
+		t.Errorf("RefreshProfileToken() = %q, want %q", got, "at_new")
+	}
+
+	creds, err := LoadCredentials("acme")
+	if err != nil {
+		t.Fatalf("LoadCredentials() error: %v", err)
+	}
+	if creds.Token != "at_new" || creds.RefreshToken != "rt_new" || creds.AuthMethod != "oauth" {
+		t.Errorf("stored credentials = %+v", creds)
+	}
+	if d := time.Until(creds.ExpiresAt); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("ExpiresAt in %v, want about 1h", d)
+	}
+	if creds.CreatedAt.IsZero() {
+		t.Error("CreatedAt not recorded")
+	}
+
+	// A second caller holding the old token gets the stored one without
+	// spending the rotated refresh token again.
+	got, err = RefreshProfileToken(context.Background(), "acme", "at_old")
+	if err != nil || got != "at_new" {
+		t.Errorf("second RefreshProfileToken() = %q, %v; want at_new", got, err)
+	}
+	if *calls != 1 {
+		t.Errorf("token endpoint called %d times, want 1", *calls)
+	}
+}
+
+func TestRefreshProfileToken_KeepsUnrotatedRefreshToken(t *testing.T) {
+	keyring.MockInitWithError(fmt.Errorf("keyring unavailable"))
+	setConfigDir(t)
+	fakeTokenEndpoint(t, `{"access_token":"at_new"}`)
+
+	ac := &config.AuthConfig{}
+	ac.SetProfile(config.DefaultProfile, config.ProfileAuth{Token: "at_old", AuthMethod: "oauth", RefreshToken: "rt_old"})
+	if err := ac.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	if _, err := RefreshProfileToken(context.Background(), config.DefaultProfile, "at_old"); err != nil {
+		t.Fatalf("RefreshProfileToken() error: %v", err)
+	}
+	creds, _ := LoadCredentials(config.DefaultProfile)
+	if creds.Token != "at_new" || creds.RefreshToken != "rt_old" {
+		t.Errorf("stored credentials = %+v, want at_new with rt_old kept", creds)
+	}
+	if !creds.ExpiresAt.IsZero() || Expired(creds) {
+		t.Errorf("token without expires_in should not expire, got %v", creds.ExpiresAt)
+	}
+}
+
+func TestRefreshProfileToken_NoRefreshToken(t *testing.T) {
+	keyring.MockInit()
+	setConfigDir(t)
+
+	if err := StoreToken("pk_personal", "token"); err != nil {
+		t.Fatalf("StoreToken() error: %v", err)
+	}
+	if _, err := RefreshProfileToken(context.Background(), config.DefaultProfile, "pk_personal"); err != ErrNoRefreshToken {
+		t.Errorf("RefreshProfileToken() error = %v, want ErrNoRefreshToken", err)
+	}
+}
@@ -0,0 +1,295 @@
+// Package cassette records ClickUp HTTP traffic to YAML fixture files and
+// replays it deterministically.
+//
+// Recording happens in the real CLI (CLICKUP_RECORD=path) so whole
+// workflows can be captured against a live workspace. Tokens and personal
+// data are scrubbed before anything is written. Replay serves the recorded
+// responses from an http.Handler, typically mounted on the httptest.Server
+// behind testutil.TestFactory.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cassette is an ordered list of recorded request/response pairs.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction is one recorded HTTP exchange.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+// Request identifies a recorded request. URI is the path and query only
+// (e.g. "/api/v2/task/abc?include_subtasks=true") so a cassette replays
+// against any host.
+type Request struct {
+	Method string `yaml:"method"`
+	URI    string `yaml:"uri"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status  int               `yaml:"status"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty"`
+}
+
+// keptHeaders are the response headers preserved in a cassette. Everything
+// else (cookies, tracing IDs, server details) is dropped.
+var keptHeaders = []string{
+	"Content-Type",
+	"Retry-After",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+}
+
+// Load reads a cassette file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Cassette
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse cassette %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to path, creating parent directories.
+func (c *Cassette) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Recorder is an http.RoundTripper that appends every exchange to a
+// cassette file. The file is rewritten after each exchange, so several
+// sequential CLI invocations can record into the same cassette.
+type Recorder struct {
+	Base http.RoundTripper
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewRecorder returns a Recorder that wraps base and writes to path.
+func NewRecorder(base http.RoundTripper, path string) *Recorder {
+	return &Recorder{Base: base, Path: path}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	resp, err := r.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return nil, err
+	}
+
+	in := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URI:    scrubURI(req.URL.RequestURI()),
+			Body:   Scrub(string(reqBody)),
+		},
+		Response: Response{
+			Status:  resp.StatusCode,
+			Headers: map[string]string{},
+			Body:    Scrub(string(respBody)),
+		},
+	}
+	for _, k := range keptHeaders {
+		if v := resp.Header.Get(k); v != "" {
+			in.Response.Headers[k] = v
+		}
+	}
+
+	if err := r.append(in); err != nil {
+		return nil, fmt.Errorf("record cassette: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Recorder) append(in Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, err := Load(r.Path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		c = &Cassette{}
+	}
+	c.Interactions = append(c.Interactions, in)
+	return c.Save(r.Path)
+}
+
+// Player serves a cassette's responses. Each request is matched to the
+// first unused interaction with the same method and URI, so concurrent
+// requests replay correctly regardless of arrival order.
+type Player struct {
+	mu   sync.Mutex
+	c    *Cassette
+	used []bool
+}
+
+// NewPlayer returns a Player for c.
+func NewPlayer(c *Cassette) *Player {
+	return &Player{c: c, used: make([]bool, len(c.Interactions))}
+}
+
+func (p *Player) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	in, ok := p.next(r.Method, r.URL.RequestURI())
+	if !ok {
+		http.Error(w, fmt.Sprintf(`{"err":"cassette: no recorded interaction for %s %s","ECODE":"CASSETTE_MISS"}`,
+			r.Method, r.URL.RequestURI()), http.StatusNotImplemented)
+		return
+	}
+	for k, v := range in.Response.Headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(in.Response.Status)
+	io.WriteString(w, in.Response.Body)
+}
+
+func (p *Player) next(method, uri string) (Interaction, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, in := range p.c.Interactions {
+		if !p.used[i] && in.Request.Method == method && sameURI(in.Request.URI, uri) {
+			p.used[i] = true
+			return in, true
+		}
+	}
+	return Interaction{}, false
+}
+
+// Unused lists the interactions that have not been replayed.
+func (p *Player) Unused() []Request {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []Request
+	for i, in := range p.c.Interactions {
+		if !p.used[i] {
+			out = append(out, in.Request)
+		}
+	}
+	return out
+}
+
+// sameURI compares URIs ignoring query parameter order.
+func sameURI(a, b string) bool {
+	pa, qa, _ := strings.Cut(a, "?")
+	pb, qb, _ := strings.Cut(b, "?")
+	if pa != pb {
+		return false
+	}
+	return canonicalQuery(qa) == canonicalQuery(qb)
+}
+
+func canonicalQuery(q string) string {
+	parts := strings.Split(q, "&")
+	slices.Sort(parts)
+	return strings.Join(parts, "&")
+}
+
+// scrubbedKeys maps JSON keys to the placeholder written in their place.
+var scrubbedKeys = map[string]any{
+	"access_token":    "REDACTED",
+	"refresh_token":   "REDACTED",
+	"client_secret":   "REDACTED",
+	"token":           "REDACTED",
+	"secret":          "REDACTED",
+	"password":        "REDACTED",
+	"email":           "user@example.com",
+	"username":        "user",
+	"initials":        "U",
+	"profilePicture":  nil,
+	"profile_picture": nil,
+}
+
+var (
+	personalTokenRe = regexp.MustCompile(`pk_[A-Za-z0-9_]+`)
+	emailRe         = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// Scrub removes tokens and personal data from a body. JSON bodies have
+// sensitive keys replaced; any remaining API tokens and email addresses
+// are masked in the text.
+func Scrub(body string) string {
+	if body == "" {
+		return ""
+	}
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if dec.Decode(&v) == nil && !dec.More() {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if enc.Encode(scrubValue(v)) == nil {
+			body = strings.TrimSuffix(buf.String(), "\n")
+		}
+	}
+	body = personalTokenRe.ReplaceAllString(body, "pk_REDACTED")
+	return emailRe.ReplaceAllString(body, "user@example.com")
+}
+
+func scrubValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, inner := range val {
+			if placeholder, ok := scrubbedKeys[k]; ok {
+				val[k] = placeholder
+				continue
+			}
+			val[k] = scrubValue(inner)
+		}
+	case []any:
+		for i, inner := range val {
+			val[i] = scrubValue(inner)
+		}
+	}
+	return v
+}
+
+func scrubURI(uri string) string {
+	return emailRe.ReplaceAllString(personalTokenRe.ReplaceAllString(uri, "pk_REDACTED"), "user@example.com")
+}
@@ -0,0 +1,119 @@
+package cassette
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.WriteHeader(200)
+		io.WriteString(w, `{"user":{"id":7,"username":"Jane Doe","email":"jane@corp.example","profilePicture":"https://x/y.png"},"note":"ping jane@corp.example"}`)
+	}))
+	defer live.Close()
+
+	path := filepath.Join(t.TempDir(), "session.yaml")
+	client := &http.Client{Transport: NewRecorder(http.DefaultTransport, path)}
+
+	req, _ := http.NewRequest("POST", live.URL+"/api/v2/user?b=2&a=1", strings.NewReader(`{"token":"pk_1_SECRET","name":"x"}`))
+	req.Header.Set("Authorization", "pk_1_SECRET")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "Jane Doe", "caller sees the unscrubbed response")
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, c.Interactions, 1)
+	in := c.Interactions[0]
+	assert.Equal(t, "POST", in.Request.Method)
+	assert.Equal(t, "/api/v2/user?b=2&a=1", in.Request.URI)
+	assert.Equal(t, `{"name":"x","token":"REDACTED"}`, in.Request.Body)
+	assert.Equal(t, map[string]string{"Content-Type": "application/json", "X-RateLimit-Remaining": "42"}, in.Response.Headers)
+	assert.Equal(t, `{"note":"ping user@example.com","user":{"email":"user@example.com","id":7,"profilePicture":null,"username":"user"}}`, in.Response.Body)
+
+	// Replay against a different host, with query parameters reordered.
+	player := NewPlayer(c)
+	replay := httptest.NewServer(player)
+	defer replay.Close()
+
+	resp, err = http.Post(replay.URL+"/api/v2/user?a=1&b=2", "application/json", nil)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "42", resp.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, in.Response.Body, string(body))
+	assert.Empty(t, player.Unused())
+
+	// Each interaction replays once.
+	resp, err = http.Post(replay.URL+"/api/v2/user?a=1&b=2", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
+
+func TestRecorder_AppendsAcrossRecorders(t *testing.T) {
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.URL.Path)
+	}))
+	defer live.Close()
+
+	path := filepath.Join(t.TempDir(), "nested", "session.yaml")
+	for _, p := range []string{"/one", "/two"} {
+		// A fresh recorder per call mimics separate CLI invocations.
+		client := &http.Client{Transport: NewRecorder(http.DefaultTransport, path)}
+		resp, err := client.Get(live.URL + p)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, c.Interactions, 2)
+	assert.Equal(t, "/one", c.Interactions[0].Request.URI)
+	assert.Equal(t, "/two", c.Interactions[1].Response.Body)
+}
+
+func TestScrub_PreservesLargeNumbers(t *testing.T) {
+	assert.Equal(t, `{"id":9007199254740993}`, Scrub(`{"id":9007199254740993}`))
+	assert.Equal(t, "plain pk_REDACTED text", Scrub("plain pk_9_abc text"))
+}
+
+// testdata/get_task.json has the shape of a real GET /task response, with
+// users nested as creator, assignees, watchers, checklist assignees and
+// custom field values, and a sharing token.
+func TestScrub_TaskPayload(t *testing.T) {
+	data, err := os.ReadFile("testdata/get_task.json")
+	require.NoError(t, err)
+
+	got := Scrub(string(data))
+	for _, leaked := range []string{"corp.example", "Jane Doe", "Sam Roe", `"JD"`, `"SR"`, "profilePictures", "a1b2c3d4e5"} {
+		assert.NotContains(t, got, leaked)
+	}
+
+	var task map[string]any
+	require.NoError(t, json.Unmarshal([]byte(got), &task))
+	assert.Equal(t, "86b1xyz2q", task["id"], "IDs are kept")
+	assert.Equal(t, "12345.00000000000000000000000000000000", task["orderindex"])
+	assert.Equal(t, "https://app.clickup.com/t/86b1xyz2q", task["url"])
+	assert.Equal(t, "Reported by user@example.com, see https://app.clickup.com/t/86b1xyz2q", task["text_content"])
+	creator := task["creator"].(map[string]any)
+	assert.Equal(t, map[string]any{
+		"id": 81942305.0, "username": "user", "color": "#0388d1", "email": "user@example.com", "profilePicture": nil,
+	}, creator)
+	assert.Equal(t, "U", task["watchers"].([]any)[0].(map[string]any)["initials"])
+}
@@ -3,14 +3,55 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // AuthConfig stores authentication state on disk as a fallback when the OS keyring is unavailable.
+// The inline fields belong to the default profile.
 type AuthConfig struct {
-	Token      string `yaml:"token,omitempty"`
-	AuthMethod string `yaml:"auth_method,omitempty"` // "oauth" or "token"
+	ProfileAuth `yaml:",inline"`
+	Profiles    map[string]ProfileAuth `yaml:"profiles,omitempty"`
+}
+
+// ProfileAuth is the stored authentication state of one profile.
+type ProfileAuth struct {
+	Token        string    `yaml:"token,omitempty"`
+	AuthMethod   string    `yaml:"auth_method,omitempty"` // "oauth" or "token"
+	RefreshToken string    `yaml:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `yaml:"expires_at,omitempty"`
+	CreatedAt    time.Time `yaml:"created_at,omitempty"`
+}
+
+// ForProfile returns the stored state of the named profile.
+func (a *AuthConfig) ForProfile(name string) ProfileAuth {
+	if name == "" || name == DefaultProfile {
+		return a.ProfileAuth
+	}
+	return a.Profiles[name]
+}
+
+// SetProfile replaces the stored state of the named profile. A zero
+// ProfileAuth removes it.
+func (a *AuthConfig) SetProfile(name string, pa ProfileAuth) {
+	if name == "" || name == DefaultProfile {
+		a.ProfileAuth = pa
+		return
+	}
+	if pa == (ProfileAuth{}) {
+		delete(a.Profiles, name)
+		return
+	}
+	if a.Profiles == nil {
+		a.Profiles = map[string]ProfileAuth{}
+	}
+	a.Profiles[name] = pa
+}
+
+// Empty reports whether no profile has stored credentials.
+func (a *AuthConfig) Empty() bool {
+	return a.ProfileAuth == (ProfileAuth{}) && len(a.Profiles) == 0
 }
 
 // AuthFile returns the path to the auth config file.
@@ -53,7 +94,7 @@ func (a *AuthConfig) Save() error {
 
 // Clear removes the stored auth config.
 func (a *AuthConfig) Clear() error {
-	a.Token = ""
-	a.AuthMethod = ""
+	a.ProfileAuth = ProfileAuth{}
+	a.Profiles = nil
 	return os.Remove(AuthFile())
 }
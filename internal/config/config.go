@@ -1,30 +1,72 @@
 package config
 
 import (
+	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 
 	"gopkg.in/yaml.v3"
 )
 
 // Config represents the user's CLI configuration.
 type Config struct {
-	Workspace         string                       `yaml:"workspace,omitempty"`
-	Space             string                       `yaml:"space,omitempty"`
-	Folder            string                       `yaml:"folder,omitempty"`
-	List              string                       `yaml:"list,omitempty"`
-	SprintFolder      string                       `yaml:"sprint_folder,omitempty"`
-	Editor            string                       `yaml:"editor,omitempty"`
-	Prompt            string                       `yaml:"prompt,omitempty"`
-	Aliases           map[string]string            `yaml:"aliases,omitempty"`
-	DirectoryDefaults map[string]DirectoryConfig   `yaml:"directory_defaults,omitempty"`
+	Workspace         string                     `yaml:"workspace,omitempty"`
+	Space             string                     `yaml:"space,omitempty"`
+	Folder            string                     `yaml:"folder,omitempty"`
+	List              string                     `yaml:"list,omitempty"`
+	SprintFolder      string                     `yaml:"sprint_folder,omitempty"`
+	Editor            string                     `yaml:"editor,omitempty"`
+	Prompt            string                     `yaml:"prompt,omitempty"`
+	Aliases           map[string]string          `yaml:"aliases,omitempty"`
+	Searches          map[string]string          `yaml:"searches,omitempty"`
+	Columns           map[string]string          `yaml:"columns,omitempty"`
+	DirectoryDefaults map[string]DirectoryConfig `yaml:"directory_defaults,omitempty"`
+	Retry             RetryConfig                `yaml:"retry,omitempty"`
+	ActiveProfile     string                     `yaml:"active_profile,omitempty"`
+	Profiles          map[string]Profile         `yaml:"profiles,omitempty"`
+
+	// profile is the named profile applied by UseProfile, and base holds
+	// the top-level values it shadows so Save can write both back.
+	profile string
+	base    Profile
+
+	// env holds the values applied by ApplyEnv, and shadowed the values
+	// they replaced, both keyed by config key name.
+	env      map[string]string
+	shadowed map[string]string
+}
+
+// DefaultProfile names the profile stored in the top-level config fields.
+const DefaultProfile = "default"
+
+// Profile holds the settings of a named auth profile. Its token is kept
+// in the keyring under the profile name.
+type Profile struct {
+	Workspace    string `yaml:"workspace,omitempty"`
+	Space        string `yaml:"space,omitempty"`
+	Folder       string `yaml:"folder,omitempty"`
+	List         string `yaml:"list,omitempty"`
+	SprintFolder string `yaml:"sprint_folder,omitempty"`
+}
+
+// RetryConfig overrides the API client's retry policy. Durations use Go
+// syntax ("500ms", "30s"); empty fields keep the built-in defaults.
+type RetryConfig struct {
+	MaxAttempts int    `yaml:"max_attempts,omitempty"`
+	BaseDelay   string `yaml:"base_delay,omitempty"`
+	MaxDelay    string `yaml:"max_delay,omitempty"`
+	MaxElapsed  string `yaml:"max_elapsed,omitempty"`
 }
 
 // DirectoryConfig holds per-directory overrides.
 type DirectoryConfig struct {
-	Space  string `yaml:"space,omitempty"`
-	Folder string `yaml:"folder,omitempty"`
-	List   string `yaml:"list,omitempty"`
+	Space   string `yaml:"space,omitempty"`
+	Folder  string `yaml:"folder,omitempty"`
+	List    string `yaml:"list,omitempty"`
+	Profile string `yaml:"profile,omitempty"`
 }
 
 // ConfigDir returns the path to the config directory (~/.config/clickup).
@@ -69,7 +111,24 @@ func (c *Config) Save() error {
 		return err
 	}
 
-	data, err := yaml.Marshal(c)
+	// Environment overrides are not persisted, unless the value has been
+	// changed since they were applied.
+	out := *c
+	for _, k := range Keys {
+		if v, ok := c.env[k.Name]; ok && k.get(&out) == v {
+			k.set(&out, c.shadowed[k.Name])
+		}
+	}
+	if c.profile != "" {
+		if c.Profiles == nil {
+			c.Profiles = map[string]Profile{}
+		}
+		c.Profiles[c.profile] = out.current()
+		out.Profiles = c.Profiles
+		out.apply(c.base)
+	}
+
+	data, err := yaml.Marshal(&out)
 	if err != nil {
 		return err
 	}
@@ -77,8 +136,143 @@ func (c *Config) Save() error {
 	return os.WriteFile(ConfigFile(), data, 0o644)
 }
 
+var profileNameRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// ValidateProfileName reports whether name can be used for a profile.
+func ValidateProfileName(name string) error {
+	if !profileNameRE.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use letters, digits, '-', '_' or '.'", name)
+	}
+	return nil
+}
+
+// UseProfile applies the named profile's settings over the top-level
+// fields. An empty name or DefaultProfile selects the top-level settings.
+// A profile that does not exist yet starts out empty and is created on the
+// next Save, which is how 'auth login --profile' adds one.
+func (c *Config) UseProfile(name string) error {
+	if name == DefaultProfile {
+		name = ""
+	}
+	if name == c.profile {
+		return nil
+	}
+	if name != "" {
+		if err := ValidateProfileName(name); err != nil {
+			return err
+		}
+	}
+	if len(c.env) > 0 {
+		return fmt.Errorf("cannot switch profile after applying environment overrides")
+	}
+	if c.profile != "" {
+		c.apply(c.base)
+	}
+	c.profile = name
+	if name != "" {
+		c.base = c.current()
+		c.apply(c.Profiles[name])
+	}
+	return nil
+}
+
+// Profile returns the name of the profile in effect.
+func (c *Config) Profile() string {
+	if c.profile == "" {
+		return DefaultProfile
+	}
+	return c.profile
+}
+
+// HasProfile reports whether name is the default or a saved profile.
+func (c *Config) HasProfile(name string) bool {
+	if name == DefaultProfile {
+		return true
+	}
+	_, ok := c.Profiles[name]
+	return ok
+}
+
+// ProfileNames returns the default profile followed by the saved
+// profiles in alphabetical order.
+func (c *Config) ProfileNames() []string {
+	return append([]string{DefaultProfile}, slices.Sorted(maps.Keys(c.Profiles))...)
+}
+
+// ProfileSettings returns the saved settings of the named profile.
+func (c *Config) ProfileSettings(name string) Profile {
+	switch {
+	case name == c.Profile():
+		return c.current()
+	case name == DefaultProfile:
+		return c.base
+	default:
+		return c.Profiles[name]
+	}
+}
+
+// ProfileForDir returns the profile bound to a specific directory, falling
+// back to the active profile.
+func (c *Config) ProfileForDir(dir string) string {
+	if dc, ok := c.DirectoryDefaults[dir]; ok && dc.Profile != "" {
+		return dc.Profile
+	}
+	return c.ActiveProfile
+}
+
+func (c *Config) current() Profile {
+	return Profile{
+		Workspace:    c.Workspace,
+		Space:        c.Space,
+		Folder:       c.Folder,
+		List:         c.List,
+		SprintFolder: c.SprintFolder,
+	}
+}
+
+func (c *Config) apply(p Profile) {
+	c.Workspace = p.Workspace
+	c.Space = p.Space
+	c.Folder = p.Folder
+	c.List = p.List
+	c.SprintFolder = p.SprintFolder
+}
+
+// ApplyEnv overrides settings with the environment variables that take
+// precedence over both config.yml and directory defaults, such as
+// CLICKUP_WORKSPACE and CLICKUP_LIST. It is applied after UseProfile, and
+// the overrides are not written back by Save.
+func (c *Config) ApplyEnv() {
+	for _, k := range Keys {
+		if !k.Profile || k.Env == "" {
+			continue
+		}
+		v := os.Getenv(k.Env)
+		if v == "" {
+			continue
+		}
+		if c.env == nil {
+			c.env, c.shadowed = map[string]string{}, map[string]string{}
+		}
+		if _, ok := c.shadowed[k.Name]; !ok {
+			c.shadowed[k.Name] = k.get(c)
+		}
+		c.env[k.Name] = v
+		k.set(c, v)
+	}
+}
+
+// envOverride returns the value ApplyEnv set for key, if any.
+func (c *Config) envOverride(key string) (string, bool) {
+	v, ok := c.env[key]
+	return v, ok
+}
+
 // SpaceForDir returns the space override for a specific directory, falling back to the global default.
 func (c *Config) SpaceForDir(dir string) string {
+	if v, ok := c.envOverride("space"); ok {
+		return v
+	}
 	if c.DirectoryDefaults != nil {
 		if dc, ok := c.DirectoryDefaults[dir]; ok && dc.Space != "" {
 			return dc.Space
@@ -89,6 +283,9 @@ func (c *Config) SpaceForDir(dir string) string {
 
 // FolderForDir returns the folder override for a specific directory, falling back to the global default.
 func (c *Config) FolderForDir(dir string) string {
+	if v, ok := c.envOverride("folder"); ok {
+		return v
+	}
 	if c.DirectoryDefaults != nil {
 		if dc, ok := c.DirectoryDefaults[dir]; ok && dc.Folder != "" {
 			return dc.Folder
@@ -99,6 +296,9 @@ func (c *Config) FolderForDir(dir string) string {
 
 // ListForDir returns the list override for a specific directory, falling back to the global default.
 func (c *Config) ListForDir(dir string) string {
+	if v, ok := c.envOverride("list"); ok {
+		return v
+	}
 	if c.DirectoryDefaults != nil {
 		if dc, ok := c.DirectoryDefaults[dir]; ok && dc.List != "" {
 			return dc.List
@@ -299,3 +299,153 @@ func TestSaveAndLoadWithDirectoryDefaults(t *testing.T) {
 		t.Errorf("SpaceForDir(/projects/other) = %q, want %q", got, "global-space")
 	}
 }
+
+func TestUseProfile_SaveKeepsTopLevel(t *testing.T) {
+	setConfigDir(t)
+
+	cfg := &Config{
+		Workspace: "team1",
+		Space:     "space1",
+		Profiles:  map[string]Profile{"acme": {Workspace: "team2"}},
+	}
+	if err := cfg.UseProfile("acme"); err != nil {
+		t.Fatalf("UseProfile() error: %v", err)
+	}
+	if cfg.Workspace != "team2" || cfg.Space != "" {
+		t.Fatalf("after UseProfile: workspace=%q space=%q, want team2 and empty", cfg.Workspace, cfg.Space)
+	}
+	if got := cfg.Profile(); got != "acme" {
+		t.Errorf("Profile() = %q, want %q", got, "acme")
+	}
+
+	cfg.Space = "acme-space"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if loaded.Workspace != "team1" || loaded.Space != "space1" {
+		t.Errorf("top-level = %q/%q, want team1/space1", loaded.Workspace, loaded.Space)
+	}
+	if got := loaded.Profiles["acme"]; got.Workspace != "team2" || got.Space != "acme-space" {
+		t.Errorf("Profiles[acme] = %+v, want workspace team2 and space acme-space", got)
+	}
+
+	// Switching back restores the top-level values.
+	if err := cfg.UseProfile(DefaultProfile); err != nil {
+		t.Fatalf("UseProfile(default) error: %v", err)
+	}
+	if cfg.Workspace != "team1" || cfg.Space != "space1" {
+		t.Errorf("after UseProfile(default): %q/%q, want team1/space1", cfg.Workspace, cfg.Space)
+	}
+}
+
+func TestUseProfile_NewProfileCreatedOnSave(t *testing.T) {
+	setConfigDir(t)
+
+	cfg := &Config{Workspace: "team1"}
+	if err := cfg.UseProfile("client-b"); err != nil {
+		t.Fatalf("UseProfile() error: %v", err)
+	}
+	if cfg.HasProfile("client-b") {
+		t.Error("HasProfile() = true before Save")
+	}
+	cfg.Workspace = "team3"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if !cfg.HasProfile("client-b") {
+		t.Error("HasProfile() = false after Save")
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	want := []string{DefaultProfile, "client-b"}
+	if got := loaded.ProfileNames(); len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("ProfileNames() = %v, want %v", got, want)
+	}
+	if got := loaded.ProfileSettings("client-b").Workspace; got != "team3" {
+		t.Errorf("ProfileSettings(client-b).Workspace = %q, want %q", got, "team3")
+	}
+	if got := loaded.ProfileSettings(DefaultProfile).Workspace; got != "team1" {
+		t.Errorf("ProfileSettings(default).Workspace = %q, want %q", got, "team1")
+	}
+}
+
+func TestUseProfile_InvalidName(t *testing.T) {
+	cfg := &Config{}
+	for _, name := range []string{"has space", "-flag", "a:b"} {
+		if err := cfg.UseProfile(name); err == nil {
+			t.Errorf("UseProfile(%q) should fail", name)
+		}
+	}
+}
+
+func TestProfileForDir(t *testing.T) {
+	cfg := &Config{ActiveProfile: "acme"}
+	cfg.SetDirectoryDefault("/projects/client-b", DirectoryConfig{Profile: "client-b"})
+	cfg.SetDirectoryDefault("/projects/app", DirectoryConfig{Space: "app-space"})
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{"/projects/client-b", "client-b"},
+		{"/projects/app", "acme"},
+		{"/elsewhere", "acme"},
+	}
+	for _, tt := range tests {
+		if got := cfg.ProfileForDir(tt.dir); got != tt.want {
+			t.Errorf("ProfileForDir(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestApplyEnv_OverridesWithoutSaving(t *testing.T) {
+	setConfigDir(t)
+	dir := "/home/user/project"
+	t.Setenv("CLICKUP_WORKSPACE", "env-ws")
+	t.Setenv("CLICKUP_LIST", "env-list")
+	t.Setenv("CLICKUP_SPACE", "")
+
+	cfg := &Config{
+		Workspace: "file-ws",
+		Space:     "file-space",
+		List:      "file-list",
+		DirectoryDefaults: map[string]DirectoryConfig{
+			dir: {List: "dir-list", Space: "dir-space"},
+		},
+	}
+	cfg.ApplyEnv()
+
+	if cfg.Workspace != "env-ws" {
+		t.Errorf("Workspace = %q, want env-ws", cfg.Workspace)
+	}
+	if got := cfg.ListForDir(dir); got != "env-list" {
+		t.Errorf("ListForDir() = %q, want env override to beat the directory default", got)
+	}
+	if got := cfg.SpaceForDir(dir); got != "dir-space" {
+		t.Errorf("SpaceForDir() = %q, want dir-space", got)
+	}
+
+	// A value changed after the override is saved; untouched overrides are not.
+	cfg.List = "picked-list"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if loaded.Workspace != "file-ws" {
+		t.Errorf("saved Workspace = %q, want file-ws", loaded.Workspace)
+	}
+	if loaded.List != "picked-list" {
+		t.Errorf("saved List = %q, want picked-list", loaded.List)
+	}
+}
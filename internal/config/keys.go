@@ -0,0 +1,271 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeyKind selects how a config value is validated.
+type KeyKind int
+
+const (
+	KindString KeyKind = iota
+	KindEnum
+	KindID
+	KindInt
+	KindDuration
+)
+
+// Key describes a setting that 'clickup config' can read and write.
+type Key struct {
+	Name        string
+	Description string
+	Kind        KeyKind
+	// Values lists the allowed values of a KindEnum key.
+	Values []string
+	// Resource names the ClickUp object a KindID key refers to:
+	// "workspace", "space", "folder" or "list".
+	Resource string
+	// Env overrides the configured value when set.
+	Env string
+	// FallbackEnv is used when the key is not configured.
+	FallbackEnv string
+	// Default is the value used when nothing else is set.
+	Default string
+	// Directory reports whether the key can be set per directory.
+	Directory bool
+	// Profile reports whether the key belongs to the auth profile.
+	Profile bool
+
+	get    func(*Config) string
+	set    func(*Config, string)
+	getDir func(DirectoryConfig) string
+	setDir func(*DirectoryConfig, string)
+}
+
+// Keys lists the settings 'clickup config' knows about. Aliases and
+// profiles have their own commands.
+var Keys = []Key{
+	{
+		Name: "workspace", Description: "Default workspace (team) ID", Kind: KindID, Resource: "workspace", Env: "CLICKUP_WORKSPACE", Profile: true,
+		get: func(c *Config) string { return c.Workspace }, set: func(c *Config, v string) { c.Workspace = v },
+	},
+	{
+		Name: "space", Description: "Default space ID", Kind: KindID, Resource: "space", Env: "CLICKUP_SPACE", Profile: true, Directory: true,
+		get: func(c *Config) string { return c.Space }, set: func(c *Config, v string) { c.Space = v },
+		getDir: func(d DirectoryConfig) string { return d.Space }, setDir: func(d *DirectoryConfig, v string) { d.Space = v },
+	},
+	{
+		Name: "folder", Description: "Default folder ID", Kind: KindID, Resource: "folder", Env: "CLICKUP_FOLDER", Profile: true, Directory: true,
+		get: func(c *Config) string { return c.Folder }, set: func(c *Config, v string) { c.Folder = v },
+		getDir: func(d DirectoryConfig) string { return d.Folder }, setDir: func(d *DirectoryConfig, v string) { d.Folder = v },
+	},
+	{
+		Name: "list", Description: "Default list ID", Kind: KindID, Resource: "list", Env: "CLICKUP_LIST", Profile: true, Directory: true,
+		get: func(c *Config) string { return c.List }, set: func(c *Config, v string) { c.List = v },
+		getDir: func(d DirectoryConfig) string { return d.List }, setDir: func(d *DirectoryConfig, v string) { d.List = v },
+	},
+	{
+		Name: "sprint_folder", Description: "Folder ID containing sprint lists", Kind: KindID, Resource: "folder", Env: "CLICKUP_SPRINT_FOLDER", Profile: true,
+		get: func(c *Config) string { return c.SprintFolder }, set: func(c *Config, v string) { c.SprintFolder = v },
+	},
+	{
+		Name: "editor", Description: "Editor command for descriptions and comments", Kind: KindString, FallbackEnv: "EDITOR",
+		get: func(c *Config) string { return c.Editor }, set: func(c *Config, v string) { c.Editor = v },
+	},
+	{
+		Name: "prompt", Description: "Interactive prompts", Kind: KindEnum, Values: []string{"enabled", "disabled"}, Default: "enabled",
+		get: func(c *Config) string { return c.Prompt }, set: func(c *Config, v string) { c.Prompt = v },
+	},
+	{
+		Name: "retry.max_attempts", Description: "Attempts per API request, including the first", Kind: KindInt, Env: "CLICKUP_RETRY_MAX_ATTEMPTS",
+		get: func(c *Config) string {
+			if c.Retry.MaxAttempts == 0 {
+				return ""
+			}
+			return strconv.Itoa(c.Retry.MaxAttempts)
+		},
+		set: func(c *Config, v string) { c.Retry.MaxAttempts, _ = strconv.Atoi(v) },
+	},
+	{
+		Name: "retry.base_delay", Description: "Backoff before the first retry", Kind: KindDuration, Env: "CLICKUP_RETRY_BASE_DELAY",
+		get: func(c *Config) string { return c.Retry.BaseDelay }, set: func(c *Config, v string) { c.Retry.BaseDelay = v },
+	},
+	{
+		Name: "retry.max_delay", Description: "Longest wait between retries", Kind: KindDuration, Env: "CLICKUP_RETRY_MAX_DELAY",
+		get: func(c *Config) string { return c.Retry.MaxDelay }, set: func(c *Config, v string) { c.Retry.MaxDelay = v },
+	},
+	{
+		Name: "retry.max_elapsed", Description: "Total time budget for retries", Kind: KindDuration, Env: "CLICKUP_RETRY_MAX_ELAPSED",
+		get: func(c *Config) string { return c.Retry.MaxElapsed }, set: func(c *Config, v string) { c.Retry.MaxElapsed = v },
+	},
+}
+
+// ColumnCommands lists the commands that take --columns, by the names
+// used in "columns.<command>" keys.
+var ColumnCommands = []string{"task-list", "task-search", "sprint-current", "view-tasks"}
+
+// ColumnsKey returns the key holding the default --columns of command.
+func ColumnsKey(command string) Key {
+	return Key{
+		Name:        "columns." + command,
+		Description: "Default --columns for " + strings.ReplaceAll(command, "-", " "),
+		Kind:        KindString,
+		get:         func(c *Config) string { return c.Columns[command] },
+		set: func(c *Config, v string) {
+			if v == "" {
+				delete(c.Columns, command)
+				return
+			}
+			if c.Columns == nil {
+				c.Columns = make(map[string]string)
+			}
+			c.Columns[command] = v
+		},
+	}
+}
+
+// ColumnKeys returns a columns key for every command that takes
+// --columns.
+func ColumnKeys() []Key {
+	keys := make([]Key, len(ColumnCommands))
+	for i, cmd := range ColumnCommands {
+		keys[i] = ColumnsKey(cmd)
+	}
+	return keys
+}
+
+// LookupKey returns the config key called name.
+func LookupKey(name string) (Key, error) {
+	for _, k := range Keys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+	if cmd, ok := strings.CutPrefix(name, "columns."); ok {
+		if !slices.Contains(ColumnCommands, cmd) {
+			return Key{}, fmt.Errorf("unknown config key %q. Columns can be set for: %s", name, strings.Join(ColumnCommands, ", "))
+		}
+		return ColumnsKey(cmd), nil
+	}
+	names := make([]string, len(Keys))
+	for i, k := range Keys {
+		names[i] = k.Name
+	}
+	return Key{}, fmt.Errorf("unknown config key %q. Valid keys: %s, columns.<command>", name, strings.Join(names, ", "))
+}
+
+// Validate checks value against the key's type. An empty value, which
+// unsets the key, is always valid.
+func (k Key) Validate(value string) error {
+	if value == "" {
+		return nil
+	}
+	switch k.Kind {
+	case KindEnum:
+		if !slices.Contains(k.Values, value) {
+			return fmt.Errorf("invalid value %q for %s: must be one of %s", value, k.Name, strings.Join(k.Values, ", "))
+		}
+	case KindID:
+		if strings.ContainsAny(value, " \t/") {
+			return fmt.Errorf("invalid %s ID %q", k.Resource, value)
+		}
+	case KindInt:
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("invalid value %q for %s: must be a positive integer", value, k.Name)
+		}
+	case KindDuration:
+		if d, err := time.ParseDuration(value); err != nil || d < 0 {
+			return fmt.Errorf("invalid value %q for %s: must be a duration such as 500ms or 30s", value, k.Name)
+		}
+	}
+	return nil
+}
+
+// Source says where a resolved config value came from.
+type Source string
+
+const (
+	SourceEnv       Source = "env"
+	SourceDirectory Source = "directory"
+	SourceProfile   Source = "profile"
+	SourceGlobal    Source = "global"
+	SourceDefault   Source = "default"
+	SourceUnset     Source = "unset"
+)
+
+// Resolved is the effective value of a key and where it came from. Detail
+// names the environment variable, directory or profile involved.
+type Resolved struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source Source `json:"source"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Resolve returns the effective value of k when working in dir.
+// Environment overrides win, then directory defaults, then the profile or
+// global config, then fallback environment variables and defaults.
+func (c *Config) Resolve(k Key, dir string) Resolved {
+	r := Resolved{Key: k.Name}
+	if k.Env != "" {
+		if v := os.Getenv(k.Env); v != "" {
+			r.Value, r.Source, r.Detail = v, SourceEnv, k.Env
+			return r
+		}
+	}
+	if k.Directory && dir != "" {
+		if v := k.getDir(c.DirectoryDefaults[dir]); v != "" {
+			r.Value, r.Source, r.Detail = v, SourceDirectory, dir
+			return r
+		}
+	}
+	if v := k.get(c); v != "" && v != k.Default {
+		r.Value, r.Source = v, SourceGlobal
+		if k.Profile && c.profile != "" {
+			r.Source, r.Detail = SourceProfile, c.profile
+		}
+		return r
+	}
+	if k.FallbackEnv != "" {
+		if v := os.Getenv(k.FallbackEnv); v != "" {
+			r.Value, r.Source, r.Detail = v, SourceEnv, k.FallbackEnv
+			return r
+		}
+	}
+	if k.Default != "" {
+		r.Value, r.Source = k.Default, SourceDefault
+		return r
+	}
+	r.Source = SourceUnset
+	return r
+}
+
+// SetKey sets k globally, or for dir when dir is not empty. Directory
+// entries left with no values are removed.
+func (c *Config) SetKey(k Key, value, dir string) error {
+	if err := k.Validate(value); err != nil {
+		return err
+	}
+	if dir == "" {
+		// An explicit value replaces any environment override for Save.
+		delete(c.env, k.Name)
+		k.set(c, value)
+		return nil
+	}
+	if !k.Directory {
+		return fmt.Errorf("%s cannot be set per directory", k.Name)
+	}
+	dc := c.DirectoryDefaults[dir]
+	k.setDir(&dc, value)
+	if dc == (DirectoryConfig{}) {
+		delete(c.DirectoryDefaults, dir)
+		return nil
+	}
+	c.SetDirectoryDefault(dir, dc)
+	return nil
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustKey(t *testing.T, name string) Key {
+	t.Helper()
+	k, err := LookupKey(name)
+	if err != nil {
+		t.Fatalf("LookupKey(%q): %v", name, err)
+	}
+	return k
+}
+
+func TestLookupKey_Unknown(t *testing.T) {
+	_, err := LookupKey("colour")
+	if err == nil || !strings.Contains(err.Error(), "prompt") {
+		t.Fatalf("expected error listing valid keys, got %v", err)
+	}
+}
+
+func TestKeyValidate(t *testing.T) {
+	tests := []struct {
+		key, value string
+		ok         bool
+	}{
+		{"prompt", "enabled", true},
+		{"prompt", "disabled", true},
+		{"prompt", "off", false},
+		{"prompt", "", true},
+		{"list", "901234", true},
+		{"list", "90 12", false},
+		{"retry.max_attempts", "3", true},
+		{"retry.max_attempts", "0", false},
+		{"retry.max_attempts", "many", false},
+		{"retry.max_delay", "10s", true},
+		{"retry.max_delay", "10", false},
+		{"editor", "code --wait", true},
+	}
+	for _, tt := range tests {
+		err := mustKey(t, tt.key).Validate(tt.value)
+		if (err == nil) != tt.ok {
+			t.Errorf("Validate(%s=%q) error = %v, want ok=%v", tt.key, tt.value, err, tt.ok)
+		}
+	}
+}
+
+func TestResolve_Precedence(t *testing.T) {
+	t.Setenv("CLICKUP_RETRY_MAX_DELAY", "")
+	t.Setenv("EDITOR", "vi")
+	dir := "/home/user/project"
+	cfg := &Config{
+		Space:  "global-space",
+		Prompt: "enabled",
+		Retry:  RetryConfig{MaxDelay: "5s"},
+		DirectoryDefaults: map[string]DirectoryConfig{
+			dir: {Space: "dir-space"},
+		},
+	}
+
+	check := func(key, d, wantValue string, wantSource Source) {
+		t.Helper()
+		r := cfg.Resolve(mustKey(t, key), d)
+		if r.Value != wantValue || r.Source != wantSource {
+			t.Errorf("Resolve(%s, %q) = %q from %s, want %q from %s", key, d, r.Value, r.Source, wantValue, wantSource)
+		}
+	}
+
+	check("space", dir, "dir-space", SourceDirectory)
+	check("space", "/elsewhere", "global-space", SourceGlobal)
+	check("prompt", dir, "enabled", SourceDefault)
+	check("editor", dir, "vi", SourceEnv)
+	check("folder", dir, "", SourceUnset)
+	check("retry.max_delay", dir, "5s", SourceGlobal)
+
+	t.Setenv("CLICKUP_RETRY_MAX_DELAY", "1s")
+	check("retry.max_delay", dir, "1s", SourceEnv)
+}
+
+func TestResolve_Profile(t *testing.T) {
+	cfg := &Config{
+		Workspace: "111",
+		Profiles:  map[string]Profile{"work": {Workspace: "222"}},
+	}
+	if err := cfg.UseProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	r := cfg.Resolve(mustKey(t, "workspace"), "")
+	if r.Value != "222" || r.Source != SourceProfile || r.Detail != "work" {
+		t.Errorf("Resolve(workspace) = %+v, want 222 from profile work", r)
+	}
+}
+
+func TestSetKey_Directory(t *testing.T) {
+	dir := "/home/user/project"
+	cfg := &Config{}
+	list := mustKey(t, "list")
+
+	if err := cfg.SetKey(list, "901", dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.DirectoryDefaults[dir].List; got != "901" {
+		t.Fatalf("directory list = %q, want 901", got)
+	}
+	if cfg.List != "" {
+		t.Errorf("global list = %q, want it untouched", cfg.List)
+	}
+
+	if err := cfg.SetKey(list, "", dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cfg.DirectoryDefaults[dir]; ok {
+		t.Error("expected empty directory entry to be removed")
+	}
+
+	if err := cfg.SetKey(mustKey(t, "prompt"), "disabled", dir); err == nil {
+		t.Error("expected error setting prompt per directory")
+	}
+}
+
+func TestColumnsKey(t *testing.T) {
+	k := mustKey(t, "columns.task-list")
+	c := &Config{}
+
+	if err := c.SetKey(k, "id,name,points", ""); err != nil {
+		t.Fatalf("SetKey: %v", err)
+	}
+	if got := c.Columns["task-list"]; got != "id,name,points" {
+		t.Fatalf("Columns[task-list] = %q", got)
+	}
+	if r := c.Resolve(k, ""); r.Value != "id,name,points" || r.Source != SourceGlobal {
+		t.Fatalf("Resolve = %+v", r)
+	}
+
+	if err := c.SetKey(k, "", ""); err != nil {
+		t.Fatalf("SetKey: %v", err)
+	}
+	if _, ok := c.Columns["task-list"]; ok {
+		t.Fatal("expected empty value to remove the entry")
+	}
+
+	if _, err := LookupKey("columns.task-view"); err == nil || !strings.Contains(err.Error(), "sprint-current") {
+		t.Fatalf("expected error listing column commands, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SearchesFile is the name of a project's saved searches file. It is found
+// by walking up from the working directory, so a repository can share its
+// searches by committing the file.
+const SearchesFile = ".clickup-searches.yml"
+
+type searchesFile struct {
+	Searches map[string]string `yaml:"searches"`
+}
+
+// FindSearchesFile returns the path of the nearest SearchesFile in dir or
+// one of its parents, or "" if there is none.
+func FindSearchesFile(dir string) string {
+	for {
+		path := filepath.Join(dir, SearchesFile)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// LoadSearches reads the saved searches in path. A missing file holds no
+// searches.
+func LoadSearches(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	var f searchesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	if f.Searches == nil {
+		f.Searches = map[string]string{}
+	}
+	return f.Searches, nil
+}
+
+// SaveSearches writes searches to path, replacing its contents.
+func SaveSearches(path string, searches map[string]string) error {
+	data, err := yaml.Marshal(&searchesFile{Searches: searches})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
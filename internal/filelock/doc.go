@@ -0,0 +1,3 @@
+// Package filelock provides exclusive advisory locks on files, for state
+// that several clickup processes may update at once.
+package filelock
@@ -0,0 +1,12 @@
+//go:build !unix && !windows
+
+package filelock
+
+import "os"
+
+// Lock is a no-op on platforms without file locking; state shared through
+// locked files is then best-effort.
+func Lock(f *os.File) error { return nil }
+
+// Unlock releases a lock taken by Lock.
+func Unlock(f *os.File) error { return nil }
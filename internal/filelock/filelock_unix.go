@@ -0,0 +1,23 @@
+//go:build unix
+
+package filelock
+
+import (
+	"os"
+	"syscall"
+)
+
+// Lock takes an exclusive advisory lock on f, blocking until it is free.
+func Lock(f *os.File) error {
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			return err
+		}
+	}
+}
+
+// Unlock releases a lock taken by Lock.
+func Unlock(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,288 @@
+// Package journal records what each command changed in ClickUp, so that
+// a mistaken edit can be listed with "clickup history" and reverted with
+// "clickup undo".
+//
+// A Recorder attached to an API client sees every modifying request. Before
+// sending one it reads the prior state of the fields the request touches,
+// and once the request succeeds it saves a Change holding those values and
+// the requests that would restore them. All the changes made by one command
+// form an Entry.
+package journal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/filelock"
+)
+
+const (
+	journalFilename = "journal.json"
+
+	// maxEntries is the number of entries kept; older ones are dropped.
+	maxEntries = 200
+)
+
+// ErrNotFound is returned for an entry ID the journal does not hold.
+var ErrNotFound = errors.New("journal entry not found")
+
+// Entry is the set of changes made by one command.
+type Entry struct {
+	ID      int       `json:"id"`
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Changes []Change  `json:"changes"`
+	// Undoes is the ID of the entry this one reverted, if it was made by
+	// clickup undo.
+	Undoes int `json:"undoes,omitempty"`
+	// UndoneBy is the ID of the entry that reverted this one.
+	UndoneBy int `json:"undone_by,omitempty"`
+}
+
+// Undoable reports whether the entry has changes that can be reverted and
+// has not been reverted already.
+func (e Entry) Undoable() bool {
+	if e.UndoneBy != 0 {
+		return false
+	}
+	for _, c := range e.Changes {
+		if len(c.Undo) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Change is one successful modifying request.
+type Change struct {
+	Method string `json:"method"`
+	// Path is the request path and query, e.g. "/api/v2/task/abc".
+	Path   string        `json:"path"`
+	TaskID string        `json:"task_id,omitempty"`
+	Fields []FieldChange `json:"fields,omitempty"`
+	// Undo holds the requests that restore the prior values, in order.
+	Undo []Request `json:"undo,omitempty"`
+	// Note explains why some or all of the change cannot be undone.
+	Note string `json:"note,omitempty"`
+}
+
+// FieldChange is the value of one field before and after a change.
+// Values are as shown to the user; "" means unset.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+	// Lost is set when the API offers no way to restore Before.
+	Lost bool `json:"lost,omitempty"`
+}
+
+// Request is an API request replayed by Revert.
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Journal is the on-disk list of entries. It is safe to use from several
+// processes at once.
+type Journal struct {
+	path string
+	now  func() time.Time
+
+	mu  sync.Mutex
+	rec *Recorder
+}
+
+// Open returns the journal stored at path. The file is created on the
+// first write.
+func Open(path string) *Journal {
+	return &Journal{path: path, now: time.Now}
+}
+
+// DefaultPath returns the journal file in the config directory.
+func DefaultPath() string {
+	return filepath.Join(config.ConfigDir(), journalFilename)
+}
+
+// Entries returns every entry, oldest first.
+func (j *Journal) Entries() ([]Entry, error) {
+	if _, err := os.Stat(j.path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	var entries []Entry
+	err := j.modify(func(e *[]Entry) bool {
+		entries = *e
+		return false
+	})
+	return entries, err
+}
+
+// Entry returns the entry with the given ID.
+func (j *Journal) Entry(id int) (Entry, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return Entry{}, err
+	}
+	for _, e := range entries {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return Entry{}, fmt.Errorf("%w: #%d", ErrNotFound, id)
+}
+
+// Latest returns the most recent entry that can be undone, skipping
+// entries made by clickup undo so that repeated undos step further back.
+func (j *Journal) Latest() (Entry, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return Entry{}, err
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		if e := entries[i]; e.Undoes == 0 && e.Undoable() {
+			return e, nil
+		}
+	}
+	return Entry{}, fmt.Errorf("%w: nothing to undo", ErrNotFound)
+}
+
+// add appends a new entry and returns its ID.
+func (j *Journal) add(e Entry) (int, error) {
+	err := j.modify(func(entries *[]Entry) bool {
+		e.ID = 1
+		if n := len(*entries); n > 0 {
+			e.ID = (*entries)[n-1].ID + 1
+		}
+		e.Time = j.now()
+		*entries = append(*entries, e)
+		if n := len(*entries); n > maxEntries {
+			*entries = (*entries)[n-maxEntries:]
+		}
+		return true
+	})
+	return e.ID, err
+}
+
+// update runs fn on the entry with the given ID, if it is still held.
+func (j *Journal) update(id int, fn func(*Entry)) error {
+	return j.modify(func(entries *[]Entry) bool {
+		for i := range *entries {
+			if (*entries)[i].ID == id {
+				fn(&(*entries)[i])
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// modify runs fn on the journal's entries while holding its lock, and
+// writes them back if fn returns true.
+func (j *Journal) modify(fn func(*[]Entry) bool) error {
+	if err := os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(j.path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := filelock.Lock(f); err != nil {
+		return err
+	}
+	defer filelock.Unlock(f)
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	var file struct {
+		Entries []Entry `json:"entries"`
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("read journal %s: %w", j.path, err)
+		}
+	}
+
+	if !fn(&file.Entries) {
+		return nil
+	}
+
+	data, err = json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	_, err = f.WriteAt(data, 0)
+	return err
+}
+
+// Revert sends the requests that undo e, last change first, through
+// client. If a recorder is attached to the journal, the requests are
+// recorded as a new entry and e is marked as undone by it. It returns the
+// number of requests sent.
+func (j *Journal) Revert(ctx context.Context, client *api.Client, e Entry) (int, error) {
+	if e.UndoneBy != 0 {
+		return 0, fmt.Errorf("entry #%d was already undone by #%d", e.ID, e.UndoneBy)
+	}
+	j.mu.Lock()
+	rec := j.rec
+	j.mu.Unlock()
+	if rec != nil {
+		rec.begin(e.ID)
+	}
+
+	host := strings.TrimSuffix(client.BaseURL(), "/api/v2")
+	sent := 0
+	for i := len(e.Changes) - 1; i >= 0; i-- {
+		for _, r := range e.Changes[i].Undo {
+			if err := send(ctx, client, host, r); err != nil {
+				return sent, fmt.Errorf("%s %s: %w", r.Method, r.Path, err)
+			}
+			sent++
+		}
+	}
+
+	if rec == nil || rec.EntryID() == 0 {
+		return sent, nil
+	}
+	return sent, j.update(e.ID, func(undone *Entry) {
+		undone.UndoneBy = rec.EntryID()
+	})
+}
+
+func send(ctx context.Context, client *api.Client, host string, r Request) error {
+	var body io.Reader
+	if len(r.Body) > 0 {
+		body = bytes.NewReader(r.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, host+r.Path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := client.DoRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return api.HandleErrorResponse(resp)
+}
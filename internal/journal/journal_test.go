@@ -0,0 +1,242 @@
+package journal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func openTemp(t *testing.T) *Journal {
+	t.Helper()
+	j := Open(filepath.Join(t.TempDir(), "journal.json"))
+	j.now = func() time.Time { return time.UnixMilli(1700000000000) }
+	return j
+}
+
+func TestJournal_AddKeepsNewest(t *testing.T) {
+	j := openTemp(t)
+	for range maxEntries + 5 {
+		_, err := j.add(Entry{Command: "clickup task edit"})
+		require.NoError(t, err)
+	}
+
+	entries, err := j.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, maxEntries)
+	assert.Equal(t, 6, entries[0].ID)
+	assert.Equal(t, maxEntries+5, entries[len(entries)-1].ID)
+}
+
+func TestJournal_Latest(t *testing.T) {
+	j := openTemp(t)
+	undoable := []Change{{Undo: []Request{{Method: "PUT", Path: "/api/v2/task/a"}}}}
+
+	_, err := j.Latest()
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	j.add(Entry{Command: "first", Changes: undoable})
+	j.add(Entry{Command: "second", Changes: undoable, UndoneBy: 3})
+	j.add(Entry{Command: "undo", Changes: undoable, Undoes: 2})
+	j.add(Entry{Command: "comment", Changes: []Change{{Note: "not tracked by the journal"}}})
+
+	e, err := j.Latest()
+	require.NoError(t, err)
+	assert.Equal(t, "first", e.Command)
+
+	_, err = j.Entry(9)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// newFake returns a fake ClickUp and a client for it with j attached.
+func newFake(t *testing.T, j *Journal) (*fakeclickup.Server, *api.Client, fakeclickup.List) {
+	t.Helper()
+	fake := fakeclickup.New()
+	ws := fake.AddWorkspace(fakeclickup.Team{ID: "1", Name: "Acme"})
+	space := fake.AddSpace(ws.ID, fakeclickup.Space{Name: "Eng"})
+	list := fake.AddList(space.ID, "", fakeclickup.List{Name: "Backlog"})
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	client := api.NewTestClient(srv.URL)
+	Attach(client, j, "clickup test")
+	return fake, client, list
+}
+
+func do(t *testing.T, client *api.Client, method, path, body string) {
+	t.Helper()
+	req, err := http.NewRequest(method, client.URL("%s", path), strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	require.Equal(t, 200, resp.StatusCode, string(data))
+}
+
+func TestRecorder_TaskUpdateRevert(t *testing.T) {
+	j := openTemp(t)
+	fake, client, list := newFake(t, j)
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login", Description: "Steps"})
+
+	do(t, client, "PUT", "task/"+task.ID, `{"name":"Fix logout","status":"complete","priority":1,"due_date":1700000000000,"markdown_content":"New"}`)
+
+	e, err := j.Latest()
+	require.NoError(t, err)
+	assert.Equal(t, "clickup test", e.Command)
+	require.Len(t, e.Changes, 1)
+	c := e.Changes[0]
+	assert.Equal(t, task.ID, c.TaskID)
+	assert.Contains(t, c.Fields, FieldChange{Field: "status", Before: "to do", After: "complete"})
+	assert.Contains(t, c.Fields, FieldChange{Field: "priority", Before: "", After: "urgent"})
+	assert.Contains(t, c.Fields, FieldChange{Field: "description", Before: "Steps", After: "New"})
+
+	got, _ := fake.Task(task.ID)
+	assert.Equal(t, "complete", got.Status.Status)
+
+	sent, err := j.Revert(context.Background(), client, e)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	got, _ = fake.Task(task.ID)
+	assert.Equal(t, "Fix login", got.Name)
+	assert.Equal(t, "to do", got.Status.Status)
+	assert.Empty(t, got.Priority.Priority)
+	assert.Empty(t, got.DueDate)
+	assert.Equal(t, "Steps", got.Description)
+
+	e, err = j.Entry(e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, e.UndoneBy)
+	undo, err := j.Entry(2)
+	require.NoError(t, err)
+	assert.Equal(t, e.ID, undo.Undoes)
+	assert.True(t, undo.Undoable(), "an undo can itself be undone")
+	_, err = j.Revert(context.Background(), client, e)
+	assert.ErrorContains(t, err, "already undone")
+}
+
+func TestRecorder_Tags(t *testing.T) {
+	j := openTemp(t)
+	fake, client, list := newFake(t, j)
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Deploy", Tags: []fakeclickup.Tag{{Name: "infra"}}})
+
+	do(t, client, "POST", "task/"+task.ID+"/tag/infra", "")
+	do(t, client, "POST", "task/"+task.ID+"/tag/needs%20review", "")
+	do(t, client, "DELETE", "task/"+task.ID+"/tag/infra", "")
+
+	e, err := j.Latest()
+	require.NoError(t, err)
+	require.Len(t, e.Changes, 3)
+	assert.Empty(t, e.Changes[0].Undo, "adding a tag the task has changes nothing")
+	assert.Equal(t, []FieldChange{{Field: "tags", Before: "infra", After: "infra, needs review"}}, e.Changes[1].Fields)
+
+	_, err = j.Revert(context.Background(), client, e)
+	require.NoError(t, err)
+	got, _ := fake.Task(task.ID)
+	require.Len(t, got.Tags, 1)
+	assert.Equal(t, "infra", got.Tags[0].Name)
+}
+
+func TestRecorder_CustomFieldAndCreate(t *testing.T) {
+	j := openTemp(t)
+	fake, client, list := newFake(t, j)
+	field := fake.AddField(list.ID, fakeclickup.CustomField{
+		Name: "Env",
+		Type: "drop_down",
+		TypeConfig: map[string]any{"options": []map[string]any{
+			{"id": "opt-a", "name": "staging", "orderindex": 0},
+			{"id": "opt-b", "name": "prod", "orderindex": 1},
+		}},
+	})
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Deploy"})
+	do(t, client, "POST", "task/"+task.ID+"/field/"+field.ID, `{"value":"opt-a"}`)
+	first, err := j.Latest()
+	require.NoError(t, err)
+	require.NoError(t, j.update(first.ID, func(e *Entry) { e.Command = "set staging" }))
+
+	// A second command gets its own entry.
+	Attach(client, j, "clickup second")
+	do(t, client, "POST", "task/"+task.ID+"/field/"+field.ID, `{"value":"opt-b"}`)
+	do(t, client, "POST", "list/"+list.ID+"/task", `{"name":"Follow-up"}`)
+
+	e, err := j.Latest()
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, e.ID)
+	assert.Equal(t, []FieldChange{{Field: "Env", Before: "staging", After: "prod"}}, e.Changes[0].Fields)
+	require.Len(t, fake.Tasks(), 2)
+
+	_, err = j.Revert(context.Background(), client, e)
+	require.NoError(t, err)
+	require.Len(t, fake.Tasks(), 1, "undoing a create deletes the task")
+	got, _ := fake.Task(task.ID)
+	require.Len(t, got.CustomFields, 1)
+	assert.EqualValues(t, 0, got.CustomFields[0].Value)
+}
+
+func TestRecorder_UntrackedAndFailed(t *testing.T) {
+	j := openTemp(t)
+	_, client, list := newFake(t, j)
+
+	req, _ := http.NewRequest("PUT", client.URL("task/%s", "missing"), strings.NewReader(`{"name":"x"}`))
+	resp, err := client.DoRequest(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	entries, _ := j.Entries()
+	assert.Empty(t, entries, "failed requests are not journaled")
+
+	do(t, client, "POST", "space/"+list.Space.ID+"/tag", `{"tag":{"name":"infra"}}`)
+	entries, _ = j.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "not tracked by the journal", entries[0].Changes[0].Note)
+	assert.False(t, entries[0].Undoable())
+}
+
+func TestRecorder_RetriesAndRepeatReads(t *testing.T) {
+	j := openTemp(t)
+	fake := fakeclickup.New()
+	ws := fake.AddWorkspace(fakeclickup.Team{ID: "1", Name: "Acme"})
+	space := fake.AddSpace(ws.ID, fakeclickup.Space{Name: "Eng"})
+	list := fake.AddList(space.ID, "", fakeclickup.List{Name: "Backlog"})
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login"})
+
+	// The first PUT is applied but answered with a 502, so it is retried.
+	var puts, gets int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			gets++
+		case "PUT":
+			puts++
+			if puts == 1 {
+				fake.ServeHTTP(httptest.NewRecorder(), r)
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	client := api.NewTestClient(srv.URL)
+	Attach(client, j, "clickup test")
+
+	do(t, client, "PUT", "task/"+task.ID, `{"name":"Fix logout"}`)
+	do(t, client, "POST", "task/"+task.ID+"/tag/infra", "")
+
+	assert.Equal(t, 2, puts)
+	assert.Equal(t, 1, gets, "the task is read once per command")
+	e, err := j.Latest()
+	require.NoError(t, err)
+	require.Len(t, e.Changes, 2)
+	assert.Equal(t, []FieldChange{{Field: "name", Before: "Fix login", After: "Fix logout"}}, e.Changes[0].Fields)
+}
@@ -0,0 +1,457 @@
+package journal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+const v2Prefix = "/api/v2/"
+
+// Recorder journals the modifying requests sent through an API client. It
+// sits above retries, so it sees each request once.
+type Recorder struct {
+	base    http.RoundTripper
+	client  *api.Client
+	journal *Journal
+	command string
+
+	mu      sync.Mutex
+	entryID int
+	undoes  int
+	// tasks caches each task as it was before the command changed it.
+	tasks map[string]*clickup.Task
+}
+
+// Attach records the changes made through client in j, as one entry for
+// command. Journaling never stops a request: when the prior state cannot be
+// read the change is recorded without a way to undo it, and failures to
+// write the journal are ignored.
+func Attach(client *api.Client, j *Journal, command string) *Recorder {
+	r := &Recorder{client: client, journal: j, command: command, tasks: map[string]*clickup.Task{}}
+	client.WrapClient(func(rt http.RoundTripper) http.RoundTripper {
+		if prev, ok := rt.(*Recorder); ok {
+			// A client records for one command at a time.
+			rt = prev.base
+		}
+		r.base = rt
+		return r
+	})
+	j.mu.Lock()
+	j.rec = r
+	j.mu.Unlock()
+	return r
+}
+
+// EntryID returns the ID of the entry the recorder writes to, or 0 if no
+// change has been recorded yet.
+func (r *Recorder) EntryID() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.entryID
+}
+
+// begin starts a new entry for the changes that undo entry undoes.
+func (r *Recorder) begin(undoes int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entryID = 0
+	r.undoes = undoes
+	r.tasks = map[string]*clickup.Task{}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return r.base.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		body = data
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	p := r.prepare(req, body)
+	resp, err := r.base.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, err
+	}
+	if p.created {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		p.finishCreate(req.URL, data)
+	}
+	r.record(p.Change)
+	return resp, nil
+}
+
+func (r *Recorder) record(c Change) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.entryID == 0 {
+		if id, err := r.journal.add(Entry{Command: r.command, Changes: []Change{c}, Undoes: r.undoes}); err == nil {
+			r.entryID = id
+		}
+		return
+	}
+	r.journal.update(r.entryID, func(e *Entry) {
+		e.Changes = append(e.Changes, c)
+	})
+}
+
+// pending is a change whose request has not been answered yet.
+type pending struct {
+	Change
+	// created is set for task creation, whose undo needs the new task's
+	// ID from the response.
+	created bool
+}
+
+// prepare reads the state a request is about to change and works out how
+// to restore it.
+func (r *Recorder) prepare(req *http.Request, body []byte) *pending {
+	p := &pending{Change: Change{Method: req.Method, Path: requestPath(req.URL)}}
+	seg := apiSegments(req.URL)
+	switch {
+	case len(seg) == 2 && seg[0] == "task" && req.Method == http.MethodPut:
+		p.TaskID = seg[1]
+		if prior := r.fetchTask(req, seg[1], &p.Change); prior != nil {
+			p.taskUpdate(prior, body)
+		}
+	case len(seg) == 2 && seg[0] == "task" && req.Method == http.MethodDelete:
+		p.TaskID = seg[1]
+		p.Fields = []FieldChange{{Field: "task", Before: seg[1], Lost: true}}
+		p.Note = "deleted tasks cannot be restored"
+	case len(seg) == 4 && seg[0] == "task" && seg[2] == "tag":
+		p.TaskID = seg[1]
+		if prior := r.fetchTask(req, seg[1], &p.Change); prior != nil {
+			p.tagChange(prior, seg[3])
+		}
+	case len(seg) == 4 && seg[0] == "task" && seg[2] == "field":
+		p.TaskID = seg[1]
+		if prior := r.fetchTask(req, seg[1], &p.Change); prior != nil {
+			p.fieldChange(prior, seg[3], body)
+		}
+	case len(seg) == 3 && seg[0] == "list" && seg[2] == "task" && req.Method == http.MethodPost:
+		p.created = true
+	default:
+		p.Note = "not tracked by the journal"
+	}
+	return p
+}
+
+// fetchTask reads a task before it is changed, noting on c if it cannot.
+// The first read of each task is kept until the next entry begins, so
+// later changes in the entry are recorded against the task as it was
+// before them all.
+func (r *Recorder) fetchTask(req *http.Request, id string, c *Change) *clickup.Task {
+	r.mu.Lock()
+	cached := r.tasks[id]
+	r.mu.Unlock()
+	if cached != nil {
+		return cached
+	}
+
+	q := url.Values{"include_markdown_description": {"true"}}
+	for _, key := range []string{"custom_task_ids", "team_id"} {
+		if v := req.URL.Query().Get(key); v != "" {
+			q.Set(key, v)
+		}
+	}
+	task, err := r.getTask(req.Context(), r.client.URL("task/%s", url.PathEscape(id))+"?"+q.Encode())
+	if err != nil {
+		c.Note = fmt.Sprintf("could not read the task before the change: %v", err)
+		return nil
+	}
+	r.mu.Lock()
+	r.tasks[id] = task
+	r.mu.Unlock()
+	return task
+}
+
+func (r *Recorder) getTask(ctx context.Context, url string) (*clickup.Task, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.DoRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := api.HandleErrorResponse(resp); err != nil {
+		return nil, err
+	}
+	var task clickup.Task
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("decode task: %w", err)
+	}
+	return &task, nil
+}
+
+// ignoredUpdateFields qualify other fields of a task update rather than
+// changing anything themselves.
+var ignoredUpdateFields = map[string]bool{
+	"due_date_time":                true,
+	"start_date_time":              true,
+	"notify_all":                   true,
+	"check_required_custom_fields": true,
+}
+
+// taskUpdate records a PUT /task body against the task's prior state.
+func (p *pending) taskUpdate(prior *clickup.Task, body []byte) {
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal(body, &req); err != nil {
+		p.Note = "request body not understood"
+		return
+	}
+	keys := make([]string, 0, len(req))
+	for key := range req {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	restore := map[string]any{}
+	var lost []string
+	for _, key := range keys {
+		raw := req[key]
+		switch key {
+		case "name":
+			p.field(key, prior.Name, showRaw(raw))
+			restore[key] = prior.Name
+		case "description", "markdown_description", "markdown_content":
+			before := prior.MarkdownDescription
+			if before == "" {
+				before = prior.Description
+			}
+			p.field("description", before, showRaw(raw))
+			restore["markdown_content"] = before
+		case "status":
+			p.field(key, prior.Status.Status, showRaw(raw))
+			restore[key] = prior.Status.Status
+		case "priority":
+			p.field(key, prior.Priority.Priority, showPriority(raw))
+			restore[key] = priorityNumber(prior.Priority.Priority)
+		case "due_date":
+			before := dateMillis(prior.DueDate)
+			p.field(key, showMillis(before), showDate(raw))
+			restore[key] = before
+		case "start_date":
+			var before *int64
+			if prior.StartDate != "" {
+				ms := parseMillis(prior.StartDate)
+				before = &ms
+			}
+			p.field(key, showMillis(before), showDate(raw))
+			restore[key] = before
+		case "time_estimate":
+			var before any
+			if prior.TimeEstimate != 0 {
+				before = prior.TimeEstimate
+			}
+			p.field(key, showAny(before), showRaw(raw))
+			restore[key] = before
+		case "points":
+			var before any
+			if prior.Points.IntVal != nil || prior.Points.FloatVal != nil {
+				before = prior.Points
+			}
+			p.field(key, showAny(before), showRaw(raw))
+			restore[key] = before
+		case "archived":
+			p.field(key, fmt.Sprint(prior.Archived), showRaw(raw))
+			restore[key] = prior.Archived
+		case "parent":
+			p.field(key, prior.Parent, showRaw(raw))
+			if prior.Parent == "" {
+				p.Fields[len(p.Fields)-1].Lost = true
+				lost = append(lost, key)
+			} else {
+				restore[key] = prior.Parent
+			}
+		case "assignees":
+			if inverse, ok := p.assignees(prior, raw); ok {
+				restore[key] = inverse
+			}
+		default:
+			if !ignoredUpdateFields[key] {
+				p.Fields = append(p.Fields, FieldChange{Field: key, After: showRaw(raw), Lost: true})
+				lost = append(lost, key)
+			}
+		}
+	}
+
+	if len(lost) > 0 {
+		p.Note = "cannot restore " + strings.Join(lost, ", ")
+	}
+	if len(restore) > 0 {
+		data, _ := json.Marshal(restore)
+		p.Undo = []Request{{Method: http.MethodPut, Path: p.Path, Body: data}}
+	}
+}
+
+// assignees records an assignee update and returns the update that
+// reverses it: IDs that were added are removed again, and IDs that were
+// removed are added back, ignoring those that made no difference.
+func (p *pending) assignees(prior *clickup.Task, raw json.RawMessage) (clickup.TaskAssigneeUpdateRequest, bool) {
+	var req clickup.TaskAssigneeUpdateRequest
+	if json.Unmarshal(raw, &req) != nil || len(req.Add)+len(req.Rem) == 0 {
+		return req, false
+	}
+	before := make(map[int]bool, len(prior.Assignees))
+	var names, after []string
+	for _, u := range prior.Assignees {
+		before[u.ID] = true
+		names = append(names, userName(u))
+		if !slices.Contains(req.Rem, u.ID) {
+			after = append(after, userName(u))
+		}
+	}
+
+	var inverse clickup.TaskAssigneeUpdateRequest
+	for _, id := range req.Add {
+		if !before[id] && !slices.Contains(inverse.Rem, id) {
+			inverse.Rem = append(inverse.Rem, id)
+			after = append(after, fmt.Sprint(id))
+		}
+	}
+	for _, id := range req.Rem {
+		if before[id] && !slices.Contains(inverse.Add, id) {
+			inverse.Add = append(inverse.Add, id)
+		}
+	}
+	if len(inverse.Add)+len(inverse.Rem) == 0 {
+		return inverse, false
+	}
+	p.field("assignees", strings.Join(names, ", "), strings.Join(after, ", "))
+	return inverse, true
+}
+
+// tagChange records adding or removing a tag. Adding a tag the task
+// already has, or removing one it lacks, changes nothing and is not
+// undone.
+func (p *pending) tagChange(prior *clickup.Task, name string) {
+	var before []string
+	has := false
+	for _, t := range prior.Tags {
+		before = append(before, t.Name)
+		has = has || strings.EqualFold(t.Name, name)
+	}
+
+	var after []string
+	undo := http.MethodDelete
+	switch {
+	case p.Method == http.MethodPost && !has:
+		after = append(slices.Clone(before), name)
+	case p.Method == http.MethodDelete && has:
+		after = slices.DeleteFunc(slices.Clone(before), func(t string) bool { return strings.EqualFold(t, name) })
+		undo = http.MethodPost
+	default:
+		return
+	}
+	p.field("tags", strings.Join(before, ", "), strings.Join(after, ", "))
+	p.Undo = []Request{{Method: undo, Path: p.Path}}
+}
+
+// fieldChange records setting or clearing a custom field value.
+func (p *pending) fieldChange(prior *clickup.Task, fieldID string, body []byte) {
+	i := slices.IndexFunc(prior.CustomFields, func(f clickup.CustomField) bool { return f.ID == fieldID })
+	if i < 0 {
+		p.Note = "custom field is not on the task"
+		return
+	}
+	f := prior.CustomFields[i]
+
+	after := ""
+	if p.Method == http.MethodPost {
+		var req struct {
+			Value any `json:"value"`
+		}
+		json.Unmarshal(body, &req)
+		after = showFieldValue(clickup.CustomField{Type: f.Type, TypeConfig: f.TypeConfig, Value: optionIndex(f, req.Value)})
+	}
+
+	if f.Value == nil {
+		if p.Method == http.MethodDelete {
+			return
+		}
+		p.field(f.Name, "", after)
+		p.Undo = []Request{{Method: http.MethodDelete, Path: p.Path}}
+		return
+	}
+
+	p.field(f.Name, showFieldValue(f), after)
+	value, ok := restoreValue(f)
+	if !ok {
+		p.Fields[len(p.Fields)-1].Lost = true
+		p.Note = fmt.Sprintf("cannot restore %s fields", f.Type)
+		return
+	}
+	data, _ := json.Marshal(map[string]any{"value": value})
+	p.Undo = []Request{{Method: http.MethodPost, Path: p.Path, Body: data}}
+}
+
+// finishCreate records a new task, whose undo deletes it.
+func (p *pending) finishCreate(u *url.URL, respBody []byte) {
+	var task struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if json.Unmarshal(respBody, &task) != nil || task.ID == "" {
+		p.Note = "created task ID not found in the response"
+		return
+	}
+	p.TaskID = task.ID
+	p.field("task", "", task.Name)
+	prefix, _, _ := strings.Cut(u.EscapedPath(), v2Prefix)
+	p.Undo = []Request{{Method: http.MethodDelete, Path: prefix + v2Prefix + "task/" + url.PathEscape(task.ID)}}
+}
+
+func (p *pending) field(name, before, after string) {
+	p.Fields = append(p.Fields, FieldChange{Field: name, Before: before, After: after})
+}
+
+// requestPath returns u's path and query, as kept in the journal.
+func requestPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.EscapedPath()
+	}
+	return u.EscapedPath() + "?" + u.RawQuery
+}
+
+// apiSegments splits a v2 API URL into its unescaped path segments after
+// /api/v2, e.g. ["task", "abc", "tag", "needs review"]. It returns nil for
+// other URLs.
+func apiSegments(u *url.URL) []string {
+	_, rest, ok := strings.Cut(u.EscapedPath(), v2Prefix)
+	if !ok {
+		return nil
+	}
+	seg := strings.Split(strings.Trim(rest, "/"), "/")
+	for i, s := range seg {
+		if v, err := url.PathUnescape(s); err == nil {
+			seg[i] = v
+		}
+	}
+	return seg
+}
@@ -0,0 +1,179 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+// priorities are ClickUp's priority names, indexed by their API number.
+var priorities = []string{"", "urgent", "high", "normal", "low"}
+
+// priorityNumber returns the API number for a priority name, or nil for
+// no priority.
+func priorityNumber(name string) any {
+	for i, p := range priorities {
+		if i > 0 && strings.EqualFold(p, name) {
+			return i
+		}
+	}
+	return nil
+}
+
+func showPriority(raw json.RawMessage) string {
+	var n int
+	if json.Unmarshal(raw, &n) == nil && n > 0 && n < len(priorities) {
+		return priorities[n]
+	}
+	return showRaw(raw)
+}
+
+// showRaw renders a JSON value for display: strings unquoted, null as "".
+func showRaw(raw json.RawMessage) string {
+	var v any
+	if json.Unmarshal(raw, &v) != nil {
+		return string(raw)
+	}
+	return showAny(v)
+}
+
+func showAny(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, int, int64:
+		return fmt.Sprint(v)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// showDate renders a date sent as Unix milliseconds.
+func showDate(raw json.RawMessage) string {
+	var d clickup.Date
+	if json.Unmarshal(raw, &d) != nil {
+		return showRaw(raw)
+	}
+	return showMillis(dateMillis(&d))
+}
+
+func showMillis(ms *int64) string {
+	if ms == nil {
+		return ""
+	}
+	return time.UnixMilli(*ms).Format("2006-01-02 15:04")
+}
+
+// dateMillis returns d as Unix milliseconds, or nil if it is unset.
+func dateMillis(d *clickup.Date) *int64 {
+	if d == nil || d.Time() == nil {
+		return nil
+	}
+	ms := d.Time().UnixMilli()
+	return &ms
+}
+
+func parseMillis(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+func userName(u clickup.User) string {
+	if u.Username != "" {
+		return u.Username
+	}
+	return strconv.Itoa(u.ID)
+}
+
+// fieldOption is a drop_down or labels option from a field's type_config.
+type fieldOption struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Label      string      `json:"label"`
+	Orderindex json.Number `json:"orderindex"`
+}
+
+func fieldOptions(f clickup.CustomField) []fieldOption {
+	data, _ := json.Marshal(f.TypeConfig)
+	var cfg struct {
+		Options []fieldOption `json:"options"`
+	}
+	json.Unmarshal(data, &cfg)
+	return cfg.Options
+}
+
+// optionIndex converts a drop_down option ID, as written, to the
+// orderindex ClickUp reads back. Other values are returned as they are.
+func optionIndex(f clickup.CustomField, v any) any {
+	id, ok := v.(string)
+	if f.Type != "drop_down" || !ok {
+		return v
+	}
+	for _, o := range fieldOptions(f) {
+		if o.ID == id {
+			if n, err := o.Orderindex.Float64(); err == nil {
+				return n
+			}
+		}
+	}
+	return v
+}
+
+// showFieldValue renders a custom field's value, naming drop_down and
+// labels options.
+func showFieldValue(f clickup.CustomField) string {
+	switch f.Type {
+	case "drop_down":
+		for _, o := range fieldOptions(f) {
+			if o.Orderindex.String() == showAny(f.Value) {
+				return o.Name
+			}
+		}
+	case "labels":
+		ids, _ := f.Value.([]any)
+		var names []string
+		for _, id := range ids {
+			name := showAny(id)
+			for _, o := range fieldOptions(f) {
+				if o.ID == name {
+					name = o.Label
+				}
+			}
+			names = append(names, name)
+		}
+		return strings.Join(names, ", ")
+	}
+	return showAny(f.Value)
+}
+
+// restoreValue returns the value to write to restore f, or false if the
+// API does not accept the value in the form it is read.
+func restoreValue(f clickup.CustomField) (any, bool) {
+	switch f.Type {
+	case "users", "tasks", "attachment", "automatic_progress", "formula", "rollup":
+		return nil, false
+	case "drop_down":
+		for _, o := range fieldOptions(f) {
+			if o.Orderindex.String() == showAny(f.Value) {
+				return o.ID, true
+			}
+		}
+		return nil, false
+	case "date":
+		if s, ok := f.Value.(string); ok {
+			return parseMillis(s), true
+		}
+	}
+	return f.Value, true
+}
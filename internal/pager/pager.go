@@ -0,0 +1,189 @@
+// Package pager iterates over paginated ClickUp endpoints.
+//
+// API v2 list endpoints are page-based: they take a 0-indexed page number
+// and return up to 100 items with a last_page flag. API v3 endpoints are
+// cursor-based: each response carries the cursor of the next page. Both
+// are exposed as iterators that fetch pages on demand, so callers can
+// stream items as they arrive and stop early by breaking out of the loop.
+package pager
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// Options controls an iteration.
+type Options struct {
+	// Limit stops the iteration after this many items. Zero means no
+	// limit.
+	Limit int
+	// MaxPages stops the iteration after this many pages. Zero means no
+	// limit.
+	MaxPages int
+	// Concurrency is the number of pages fetched at once by Pages, after
+	// the first page shows there are more. Pages are still yielded in
+	// order. Cursor-based iteration is always sequential, since each
+	// cursor comes from the previous page.
+	Concurrency int
+	// Start is the first page for Pages.
+	Start int
+	// Cursor is the first cursor for Cursor.
+	Cursor string
+}
+
+// PageFunc fetches a page of a page-based endpoint and reports whether it
+// is the last one.
+type PageFunc[T any] func(ctx context.Context, page int) (items []T, last bool, err error)
+
+// CursorFunc fetches the page at cursor and returns the cursor of the
+// next page, which is empty after the last page.
+type CursorFunc[T any] func(ctx context.Context, cursor string) (items []T, next string, err error)
+
+// Pages iterates over the items of a page-based endpoint. An empty page
+// ends the iteration as well as one flagged last. The first error is
+// yielded once and ends the iteration.
+func Pages[T any](ctx context.Context, opts Options, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// The first page is fetched alone, so a single page of results
+		// costs one request; later windows fetch Concurrency pages.
+		workers := max(opts.Concurrency, 1)
+		count := 0
+		for page, n := opts.Start, 1; ; page, n = page+n, workers {
+			if opts.MaxPages > 0 {
+				n = min(n, opts.Start+opts.MaxPages-page)
+			}
+			if n <= 0 {
+				return
+			}
+
+			results := fetchWindow(ctx, page, n, fetch)
+			for _, r := range results {
+				if r.err != nil {
+					var zero T
+					yield(zero, r.err)
+					return
+				}
+				for _, item := range r.items {
+					if !yield(item, nil) {
+						return
+					}
+					count++
+					if opts.Limit > 0 && count >= opts.Limit {
+						return
+					}
+				}
+				if r.last || len(r.items) == 0 {
+					return
+				}
+			}
+		}
+	}
+}
+
+type pageResult[T any] struct {
+	items []T
+	last  bool
+	err   error
+}
+
+// fetchWindow fetches pages first to first+n-1 at once.
+func fetchWindow[T any](ctx context.Context, first, n int, fetch PageFunc[T]) []pageResult[T] {
+	results := make([]pageResult[T], n)
+	if n == 1 {
+		r := &results[0]
+		r.items, r.last, r.err = fetch(ctx, first)
+		return results
+	}
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := &results[i]
+			r.items, r.last, r.err = fetch(ctx, first+i)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// Cursor iterates over the items of a cursor-based endpoint. The first
+// error is yielded once and ends the iteration.
+func Cursor[T any](ctx context.Context, opts Options, fetch CursorFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := opts.Cursor
+		count := 0
+		for pages := 0; opts.MaxPages <= 0 || pages < opts.MaxPages; pages++ {
+			items, next, err := fetch(ctx, cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if opts.Limit > 0 && count >= opts.Limit {
+					return
+				}
+			}
+			if next == "" || next == cursor || len(items) == 0 {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// Collect gathers the items of an iteration, stopping at the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var out []T
+	for item, err := range seq {
+		if err != nil {
+			return out, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// Filter yields the items of seq that keep accepts. Errors are passed
+// through.
+func Filter[T any](seq iter.Seq2[T, error], keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err == nil && !keep(item) {
+				continue
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// Limit stops seq after n items, so fetching stops too. Zero means no
+// limit.
+func Limit[T any](seq iter.Seq2[T, error], n int) iter.Seq2[T, error] {
+	if n <= 0 {
+		return seq
+	}
+	return func(yield func(T, error) bool) {
+		count := 0
+		for item, err := range seq {
+			if !yield(item, err) || err != nil {
+				return
+			}
+			count++
+			if count >= n {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package pager
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagesOf serves total items in pages of size, recording the pages asked
+// for.
+func pagesOf(total, size int, asked *[]int) PageFunc[int] {
+	var mu sync.Mutex
+	return func(ctx context.Context, page int) ([]int, bool, error) {
+		mu.Lock()
+		*asked = append(*asked, page)
+		mu.Unlock()
+		var items []int
+		for i := page * size; i < min((page+1)*size, total); i++ {
+			items = append(items, i)
+		}
+		return items, (page+1)*size >= total, nil
+	}
+}
+
+func TestPages_All(t *testing.T) {
+	var asked []int
+	items, err := Collect(Pages(context.Background(), Options{}, pagesOf(7, 3, &asked)))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, items)
+	assert.Equal(t, []int{0, 1, 2}, asked)
+}
+
+func TestPages_ConcurrentKeepsOrder(t *testing.T) {
+	var asked []int
+	items, err := Collect(Pages(context.Background(), Options{Concurrency: 4}, pagesOf(10, 2, &asked)))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, items)
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, asked, "page 0 alone, then pages 1-4 at once")
+}
+
+func TestPages_ConcurrentFetchesFirstPageAlone(t *testing.T) {
+	var asked []int
+	items, err := Collect(Pages(context.Background(), Options{Concurrency: 4}, pagesOf(2, 2, &asked)))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, items)
+	assert.Equal(t, []int{0}, asked)
+}
+
+func TestPages_LimitStopsFetching(t *testing.T) {
+	var asked []int
+	items, err := Collect(Pages(context.Background(), Options{Limit: 4}, pagesOf(100, 3, &asked)))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3}, items)
+	assert.Equal(t, []int{0, 1}, asked)
+}
+
+func TestPages_StartAndMaxPages(t *testing.T) {
+	var asked []int
+	items, err := Collect(Pages(context.Background(), Options{Start: 1, MaxPages: 2}, pagesOf(100, 2, &asked)))
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+	assert.Equal(t, []int{1, 2}, asked)
+}
+
+func TestPages_BreakStopsFetching(t *testing.T) {
+	var asked []int
+	for item, err := range Pages(context.Background(), Options{}, pagesOf(100, 5, &asked)) {
+		require.NoError(t, err)
+		if item == 6 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1}, asked)
+}
+
+func TestFilterLimit_StopsFetching(t *testing.T) {
+	var asked []int
+	even := func(i int) bool { return i%2 == 0 }
+	items, err := Collect(Limit(Filter(Pages(context.Background(), Options{}, pagesOf(100, 3, &asked)), even), 3))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 4}, items)
+	assert.Equal(t, []int{0, 1}, asked)
+}
+
+func TestPages_Error(t *testing.T) {
+	boom := errors.New("boom")
+	var calls atomic.Int32
+	fetch := func(ctx context.Context, page int) ([]int, bool, error) {
+		calls.Add(1)
+		if page == 1 {
+			return nil, false, boom
+		}
+		return []int{page}, false, nil
+	}
+	items, err := Collect(Pages(context.Background(), Options{}, fetch))
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, []int{0}, items)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestCursor(t *testing.T) {
+	var cursors []string
+	fetch := func(ctx context.Context, cursor string) ([]string, string, error) {
+		cursors = append(cursors, cursor)
+		n, _ := strconv.Atoi(cursor)
+		if n >= 4 {
+			return []string{"e"}, "", nil
+		}
+		return []string{string(rune('a' + n/2)), string(rune('a' + n/2))}, strconv.Itoa(n + 2), nil
+	}
+
+	items, err := Collect(Cursor(context.Background(), Options{}, fetch))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "a", "b", "b", "e"}, items)
+	assert.Equal(t, []string{"", "2", "4"}, cursors)
+
+	cursors = nil
+	items, err = Collect(Cursor(context.Background(), Options{Cursor: "2", Limit: 1}, fetch))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, items)
+	assert.Equal(t, []string{"2"}, cursors)
+}
@@ -9,7 +9,8 @@ import (
 
 // Prompter provides interactive prompts.
 type Prompter struct {
-	ios *iostreams.IOStreams
+	ios    *iostreams.IOStreams
+	editor string
 }
 
 // New creates a new Prompter.
@@ -17,6 +18,12 @@ func New(ios *iostreams.IOStreams) *Prompter {
 	return &Prompter{ios: ios}
 }
 
+// SetEditor sets the command Editor opens, such as "code --wait". When it
+// is empty, $VISUAL or $EDITOR is used.
+func (p *Prompter) SetEditor(cmd string) {
+	p.editor = cmd
+}
+
 // Input prompts for text input.
 func (p *Prompter) Input(message, defaultValue string) (string, error) {
 	if !p.ios.IsTerminal() {
@@ -74,14 +81,19 @@ func (p *Prompter) Editor(message, defaultValue, filename string) (string, error
 		return "", fmt.Errorf("cannot prompt in non-interactive mode")
 	}
 	var result string
-	err := survey.AskOne(&survey.Editor{
+	err := survey.AskOne(p.editorPrompt(message, defaultValue, filename), &result)
+	return result, err
+}
+
+func (p *Prompter) editorPrompt(message, defaultValue, filename string) *survey.Editor {
+	return &survey.Editor{
 		Message:       message,
 		Default:       defaultValue,
 		FileName:      filename,
 		HideDefault:   true,
 		AppendDefault: true,
-	}, &result)
-	return result, err
+		Editor:        p.editor,
+	}
 }
 
 // MultiSelect prompts the user to choose multiple items from a list.
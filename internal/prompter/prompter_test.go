@@ -0,0 +1,18 @@
+package prompter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+)
+
+func TestEditorPrompt_UsesConfiguredEditor(t *testing.T) {
+	p := New(iostreams.Test())
+	assert.Empty(t, p.editorPrompt("Body", "", "*.md").Editor, "$VISUAL or $EDITOR is used by default")
+
+	p.SetEditor("code --wait")
+	e := p.editorPrompt("Body", "text", "*.md")
+	assert.Equal(t, "code --wait", e.Editor)
+	assert.Equal(t, "text", e.Default)
+}
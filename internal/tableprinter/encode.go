@@ -0,0 +1,168 @@
+package tableprinter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by Encode, in addition to the default table.
+const (
+	FormatTable    = "table"
+	FormatCSV      = "csv"
+	FormatTSV      = "tsv"
+	FormatMarkdown = "markdown"
+	FormatYAML     = "yaml"
+	FormatNDJSON   = "ndjson"
+)
+
+// Formats lists the names accepted by --format.
+var Formats = []string{FormatTable, FormatCSV, FormatTSV, FormatMarkdown, FormatYAML, FormatNDJSON}
+
+// Encode writes t to w in the named format. FormatTable is not an
+// encoding; use Print for it.
+func Encode(w io.Writer, format string, t *Table) error {
+	t = t.visible()
+	switch format {
+	case FormatCSV:
+		return encodeCSV(w, t)
+	case FormatTSV:
+		return encodeTSV(w, t)
+	case FormatMarkdown:
+		return encodeMarkdown(w, t)
+	case FormatYAML:
+		return encodeYAML(w, t)
+	case FormatNDJSON:
+		return encodeNDJSON(w, t)
+	default:
+		return fmt.Errorf("unknown output format %q: valid formats are %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func (t *Table) headers() []string {
+	headers := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		headers[i] = c.Header
+	}
+	return headers
+}
+
+func encodeCSV(w io.Writer, t *Table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.headers()); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// tsvReplacer keeps each value on one line and in one column.
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+func encodeTSV(w io.Writer, t *Table) error {
+	write := func(fields []string) error {
+		clean := make([]string, len(fields))
+		for i, f := range fields {
+			clean[i] = tsvReplacer.Replace(f)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(clean, "\t"))
+		return err
+	}
+	if err := write(t.headers()); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		if err := write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var markdownReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func encodeMarkdown(w io.Writer, t *Table) error {
+	var b strings.Builder
+	writeRow := func(fields []string) {
+		b.WriteString("|")
+		for _, f := range fields {
+			b.WriteString(" ")
+			b.WriteString(markdownReplacer.Replace(f))
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(t.headers())
+	b.WriteString("|")
+	for range t.Columns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, row := range t.Rows {
+		writeRow(row)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// encodeYAML writes a sequence of mappings, keeping the column order.
+func encodeYAML(w io.Writer, t *Table) error {
+	seq := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range t.Rows {
+		m := &yaml.Node{Kind: yaml.MappingNode}
+		for i, c := range t.Columns {
+			m.Content = append(m.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: c.FieldKey()},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: row[i]},
+			)
+		}
+		seq.Content = append(seq.Content, m)
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(seq); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// encodeNDJSON writes one JSON object per row, keeping the column order.
+func encodeNDJSON(w io.Writer, t *Table) error {
+	keys := make([][]byte, len(t.Columns))
+	for i, c := range t.Columns {
+		k, err := json.Marshal(c.FieldKey())
+		if err != nil {
+			return err
+		}
+		keys[i] = k
+	}
+	var b bytes.Buffer
+	for _, row := range t.Rows {
+		b.Reset()
+		b.WriteByte('{')
+		for i, v := range row {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			val, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			b.Write(keys[i])
+			b.WriteByte(':')
+			b.Write(val)
+		}
+		b.WriteString("}\n")
+		if _, err := w.Write(b.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
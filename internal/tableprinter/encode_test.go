@@ -0,0 +1,67 @@
+package tableprinter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleTable() *Table {
+	tbl := NewTable(
+		Column{Header: "ID"},
+		Column{Header: "NAME"},
+		Column{Header: "TIME SPENT"},
+	)
+	tbl.AddRow("t1", "Fix | login, \"now\"", "1h")
+	tbl.AddRow("t2", "Two\nlines")
+	return tbl
+}
+
+func encode(t *testing.T, format string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, format, sampleTable()))
+	return buf.String()
+}
+
+func TestEncode_CSV(t *testing.T) {
+	assert.Equal(t, "ID,NAME,TIME SPENT\n"+
+		"t1,\"Fix | login, \"\"now\"\"\",1h\n"+
+		"t2,\"Two\nlines\",\n", encode(t, FormatCSV))
+}
+
+func TestEncode_TSV(t *testing.T) {
+	assert.Equal(t, "ID\tNAME\tTIME SPENT\n"+
+		"t1\tFix | login, \"now\"\t1h\n"+
+		"t2\tTwo lines\t\n", encode(t, FormatTSV))
+}
+
+func TestEncode_Markdown(t *testing.T) {
+	assert.Equal(t, "| ID | NAME | TIME SPENT |\n"+
+		"| --- | --- | --- |\n"+
+		"| t1 | Fix \\| login, \"now\" | 1h |\n"+
+		"| t2 | Two<br>lines |  |\n", encode(t, FormatMarkdown))
+}
+
+func TestEncode_YAML(t *testing.T) {
+	assert.Equal(t, "- id: t1\n"+
+		"  name: Fix | login, \"now\"\n"+
+		"  time_spent: 1h\n"+
+		"- id: t2\n"+
+		"  name: |-\n"+
+		"    Two\n"+
+		"    lines\n"+
+		"  time_spent: \"\"\n", encode(t, FormatYAML))
+}
+
+func TestEncode_NDJSON(t *testing.T) {
+	assert.Equal(t, `{"id":"t1","name":"Fix | login, \"now\"","time_spent":"1h"}`+"\n"+
+		`{"id":"t2","name":"Two\nlines","time_spent":""}`+"\n", encode(t, FormatNDJSON))
+}
+
+func TestEncode_Unknown(t *testing.T) {
+	err := Encode(&bytes.Buffer{}, "xml", sampleTable())
+	assert.ErrorContains(t, err, "valid formats are")
+}
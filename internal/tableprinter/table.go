@@ -0,0 +1,296 @@
+package tableprinter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+)
+
+// Column describes one column of a Table.
+type Column struct {
+	// Header is shown above the column in table, CSV, TSV and Markdown
+	// output.
+	Header string
+	// Key names the field in YAML and NDJSON records. It defaults to the
+	// header in snake_case.
+	Key string
+	// Truncate marks the column to be shortened when a terminal table is
+	// too wide.
+	Truncate bool
+	// Color styles a value in terminal tables. Other formats get the plain
+	// value.
+	Color func(string) string
+	// Aliases are extra names accepted by Select and Sort.
+	Aliases []string
+	// Hidden columns are kept for sorting but not rendered.
+	Hidden bool
+}
+
+// matches reports whether name refers to the column.
+func (c Column) matches(name string) bool {
+	if strings.EqualFold(name, c.FieldKey()) || strings.EqualFold(name, c.Header) {
+		return true
+	}
+	for _, a := range c.Aliases {
+		if strings.EqualFold(name, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldKey returns the record key for the column.
+func (c Column) FieldKey() string {
+	if c.Key != "" {
+		return c.Key
+	}
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(c.Header)), " ", "_")
+}
+
+// Table is the structured row model list commands hand to the output
+// layer, which renders it as an aligned table or one of the --format
+// encodings.
+type Table struct {
+	Columns []Column
+	Rows    [][]string
+
+	// sortKeys holds per-row values that Sort uses instead of the
+	// displayed ones, such as a timestamp behind a formatted date.
+	sortKeys [][]string
+}
+
+// NewTable returns an empty table with the given columns.
+func NewTable(columns ...Column) *Table {
+	return &Table{Columns: columns}
+}
+
+// AddRow appends a row. Missing trailing fields are left empty.
+func (t *Table) AddRow(fields ...string) {
+	row := make([]string, len(t.Columns))
+	copy(row, fields)
+	t.Rows = append(t.Rows, row)
+	t.sortKeys = append(t.sortKeys, nil)
+}
+
+// SetSortKey sets the value Sort compares for the named column of the
+// last added row.
+func (t *Table) SetSortKey(column, value string) {
+	i := t.columnIndex(column)
+	if i < 0 || len(t.Rows) == 0 {
+		return
+	}
+	last := len(t.Rows) - 1
+	if t.sortKeys[last] == nil {
+		t.sortKeys[last] = make([]string, len(t.Columns))
+	}
+	t.sortKeys[last][i] = value
+}
+
+func (t *Table) columnIndex(name string) int {
+	return slices.IndexFunc(t.Columns, func(c Column) bool { return c.matches(name) })
+}
+
+func (t *Table) unknownColumn(name string) error {
+	names := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.FieldKey()
+	}
+	return fmt.Errorf("unknown column %q: available columns are %s", name, strings.Join(names, ", "))
+}
+
+// Select shows only the named columns, in the given order. The rest are
+// hidden but can still be sorted on. An empty list leaves the table as is.
+func (t *Table) Select(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	var order []int
+	for _, name := range names {
+		i := t.columnIndex(name)
+		if i < 0 {
+			return t.unknownColumn(name)
+		}
+		if !slices.Contains(order, i) {
+			order = append(order, i)
+		}
+	}
+	shown := len(order)
+	for i := range t.Columns {
+		if !slices.Contains(order, i) {
+			order = append(order, i)
+		}
+	}
+
+	cols := make([]Column, len(order))
+	for n, i := range order {
+		cols[n] = t.Columns[i]
+		cols[n].Hidden = n >= shown
+	}
+	permute := func(row []string) []string {
+		if row == nil {
+			return nil
+		}
+		out := make([]string, len(order))
+		for n, i := range order {
+			out[n] = row[i]
+		}
+		return out
+	}
+	for r := range t.Rows {
+		t.Rows[r] = permute(t.Rows[r])
+		t.sortKeys[r] = permute(t.sortKeys[r])
+	}
+	t.Columns = cols
+	return nil
+}
+
+// Sort orders the rows by the named columns. A leading "-" sorts that
+// column in descending order. A column whose values all parse as numbers
+// compares numerically, any other case-insensitively, and empty values
+// always sort last.
+func (t *Table) Sort(specs []string) error {
+	type key struct {
+		col     int
+		desc    bool
+		numeric bool
+	}
+	var keys []key
+	for _, spec := range specs {
+		name, desc := strings.CutPrefix(spec, "-")
+		name = strings.TrimPrefix(name, "+")
+		i := t.columnIndex(name)
+		if i < 0 {
+			return t.unknownColumn(name)
+		}
+		keys = append(keys, key{col: i, desc: desc})
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	// Whether a column compares numerically is decided once for the whole
+	// column, so that mixed columns still sort consistently.
+	for n := range keys {
+		keys[n].numeric = t.numericColumn(keys[n].col)
+	}
+
+	idx := make([]int, len(t.Rows))
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortStableFunc(idx, func(a, b int) int {
+		for _, k := range keys {
+			va, vb := t.sortValue(a, k.col), t.sortValue(b, k.col)
+			switch {
+			case va == vb:
+				continue
+			case va == "":
+				return 1
+			case vb == "":
+				return -1
+			}
+			c := compareValues(va, vb, k.numeric)
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	rows := make([][]string, len(idx))
+	sortKeys := make([][]string, len(idx))
+	for n, i := range idx {
+		rows[n] = t.Rows[i]
+		sortKeys[n] = t.sortKeys[i]
+	}
+	t.Rows, t.sortKeys = rows, sortKeys
+	return nil
+}
+
+func (t *Table) sortValue(row, col int) string {
+	if keys := t.sortKeys[row]; keys != nil && keys[col] != "" {
+		return keys[col]
+	}
+	return t.Rows[row][col]
+}
+
+// numericColumn reports whether every non-empty value of column col parses
+// as a number.
+func (t *Table) numericColumn(col int) bool {
+	for r := range t.Rows {
+		if v := t.sortValue(r, col); v != "" {
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func compareValues(a, b string, numeric bool) int {
+	if numeric {
+		fa, _ := strconv.ParseFloat(a, 64)
+		fb, _ := strconv.ParseFloat(b, 64)
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// visible returns t without its hidden columns.
+func (t *Table) visible() *Table {
+	if !slices.ContainsFunc(t.Columns, func(c Column) bool { return c.Hidden }) {
+		return t
+	}
+	out := &Table{}
+	var keep []int
+	for i, c := range t.Columns {
+		if !c.Hidden {
+			keep = append(keep, i)
+			out.Columns = append(out.Columns, c)
+		}
+	}
+	for _, row := range t.Rows {
+		r := make([]string, len(keep))
+		for n, i := range keep {
+			r[n] = row[i]
+		}
+		out.Rows = append(out.Rows, r)
+	}
+	return out
+}
+
+// Print writes the table for humans: aligned on a terminal with a bold
+// header, colors and truncation, and tab-separated otherwise.
+func (t *Table) Print(ios *iostreams.IOStreams) error {
+	t = t.visible()
+	cs := ios.ColorScheme()
+	tp := New(ios)
+	for i, c := range t.Columns {
+		tp.AddField(cs.Bold(c.Header))
+		if c.Truncate {
+			tp.SetTruncateColumn(i)
+		}
+	}
+	tp.EndRow()
+	for _, row := range t.Rows {
+		for i, v := range row {
+			if color := t.Columns[i].Color; color != nil && v != "" {
+				v = color(v)
+			}
+			tp.AddField(v)
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,92 @@
+package tableprinter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func taskTable() *Table {
+	tbl := NewTable(
+		Column{Header: "ID"},
+		Column{Header: "NAME"},
+		Column{Header: "PRIORITY"},
+		Column{Header: "POINTS", Hidden: true},
+		Column{Header: "DUE"},
+		Column{Header: "STORY TYPE", Key: "story_type", Aliases: []string{"field:Story Type"}, Hidden: true},
+	)
+	tbl.AddRow("t1", "b", "low", "3", "Jan 10", "bug")
+	tbl.SetSortKey("priority", "1")
+	tbl.SetSortKey("due", "1736467200000")
+	tbl.AddRow("t2", "a", "urgent", "10", "", "feature")
+	tbl.SetSortKey("priority", "4")
+	tbl.AddRow("t3", "c", "urgent", "5", "Feb 01", "")
+	tbl.SetSortKey("priority", "4")
+	tbl.SetSortKey("due", "1738368000000")
+	return tbl
+}
+
+func ids(tbl *Table) []string {
+	var out []string
+	for _, row := range tbl.Rows {
+		out = append(out, row[0])
+	}
+	return out
+}
+
+func TestTable_SelectReordersAndHides(t *testing.T) {
+	tbl := taskTable()
+	require.NoError(t, tbl.Select([]string{"name", "id", "FIELD:story type"}))
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatCSV, tbl))
+	assert.Equal(t, "NAME,ID,STORY TYPE\nb,t1,bug\na,t2,feature\nc,t3,\n", buf.String())
+}
+
+func TestTable_SelectUnknownColumn(t *testing.T) {
+	err := taskTable().Select([]string{"id", "colour"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "colour"`)
+	assert.Contains(t, err.Error(), "id, name, priority, points, due, story_type")
+}
+
+func TestTable_SortUsesKeysAndPutsEmptyLast(t *testing.T) {
+	tbl := taskTable()
+	require.NoError(t, tbl.Sort([]string{"due"}))
+	assert.Equal(t, []string{"t1", "t3", "t2"}, ids(tbl))
+
+	require.NoError(t, tbl.Sort([]string{"-due"}))
+	assert.Equal(t, []string{"t3", "t1", "t2"}, ids(tbl))
+}
+
+func TestTable_SortMultipleKeys(t *testing.T) {
+	tbl := taskTable()
+	require.NoError(t, tbl.Sort([]string{"-priority", "name"}))
+	assert.Equal(t, []string{"t2", "t3", "t1"}, ids(tbl))
+}
+
+func TestTable_SortNumericOnHiddenColumn(t *testing.T) {
+	tbl := taskTable()
+	require.NoError(t, tbl.Select([]string{"id"}))
+	require.NoError(t, tbl.Sort([]string{"points"}))
+	assert.Equal(t, []string{"t1", "t3", "t2"}, ids(tbl))
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatTSV, tbl))
+	assert.Equal(t, "ID\nt1\nt3\nt2\n", buf.String())
+}
+
+func TestTable_SortMixedColumnAsText(t *testing.T) {
+	tbl := NewTable(Column{Header: "ID"}, Column{Header: "VALUE"})
+	tbl.AddRow("a", "9")
+	tbl.AddRow("b", "1a")
+	tbl.AddRow("c", "10")
+	tbl.AddRow("d", "")
+
+	// Comparing pair by pair would order 9 < 10 as numbers but 10 < 1a < 9
+	// as text; one non-number makes the whole column compare as text.
+	require.NoError(t, tbl.Sort([]string{"value"}))
+	assert.Equal(t, []string{"c", "b", "a", "d"}, ids(tbl))
+}
@@ -15,9 +15,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/cassette"
 	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/internal/iostreams"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
 )
 
 // TestFactory bundles everything needed for command-level tests.
@@ -114,6 +116,40 @@ func (tf *TestFactory) HandleFuncV3(path string, handler http.HandlerFunc) {
 	tf.Mux.HandleFunc(fullPath, handler)
 }
 
+// Replay serves every request not matched by a registered handler from the
+// cassette at path (see internal/cassette). The test fails if any recorded
+// interaction is left unreplayed, so cassettes double as a check that a
+// workflow still makes the same API calls.
+//
+// Record a cassette by running the real CLI with CLICKUP_RECORD=path.
+func (tf *TestFactory) Replay(t *testing.T, path string) {
+	t.Helper()
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatalf("load cassette: %v", err)
+	}
+	player := cassette.NewPlayer(c)
+	tf.Mux.Handle("/", player)
+	t.Cleanup(func() {
+		for _, req := range player.Unused() {
+			t.Errorf("cassette %s: interaction not replayed: %s %s", path, req.Method, req.URI)
+		}
+	})
+}
+
+// Fake serves every request not matched by a registered handler from a
+// stateful in-memory ClickUp (see pkg/fakeclickup), seeded with the test
+// config's workspace (12345) and space (67890). Use it for round-trip tests
+// where later commands must see earlier writes. Fake and Replay are
+// mutually exclusive.
+func (tf *TestFactory) Fake() *fakeclickup.Server {
+	fake := fakeclickup.New()
+	fake.AddWorkspace(fakeclickup.Team{ID: "12345", Name: "Test Workspace"})
+	fake.AddSpace("12345", fakeclickup.Space{ID: "67890", Name: "Test Space"})
+	tf.Mux.Handle("/", fake)
+	return fake
+}
+
 // RunCommand executes a cobra command with the given args and returns the error.
 func RunCommand(t *testing.T, cmd *cobra.Command, args ...string) error {
 	t.Helper()
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"io"
+	"slices"
+	"strings"
+)
+
+// Headless is a Terminal of fixed size for tests. It reads keys from a
+// queue and keeps the last frame drawn.
+type Headless struct {
+	width, height int
+	keys          []Key
+	frame         []string
+	frames        int
+}
+
+// NewHeadless returns a headless terminal of the given size whose input is
+// keys.
+func NewHeadless(width, height int, keys ...Key) *Headless {
+	return &Headless{width: width, height: height, keys: keys}
+}
+
+func (h *Headless) Size() (int, int) { return h.width, h.height }
+
+// ReadKey returns the next queued key, or io.EOF when none are left.
+func (h *Headless) ReadKey() (Key, error) {
+	if len(h.keys) == 0 {
+		return Key{}, io.EOF
+	}
+	k := h.keys[0]
+	h.keys = h.keys[1:]
+	return k, nil
+}
+
+func (h *Headless) Draw(lines []string) error {
+	h.frame = slices.Clone(lines)
+	h.frames++
+	return nil
+}
+
+// Press sends keys to m one at a time, redrawing after each as Run would.
+// It reports whether m asked to exit, and stops there if it did.
+func (h *Headless) Press(m Model, keys ...Key) bool {
+	for _, k := range keys {
+		if m.Update(k) {
+			return true
+		}
+		h.Draw(m.View(h.width, h.height))
+	}
+	return false
+}
+
+// Type is Press with keys decoded from s by Keys.
+func (h *Headless) Type(m Model, s string) bool {
+	return h.Press(m, Keys(s)...)
+}
+
+// Frames returns the number of frames drawn.
+func (h *Headless) Frames() int { return h.frames }
+
+// Screen returns the last frame as plain text, as it would appear on a
+// terminal: escape sequences removed, lines cut to the width and trailing
+// spaces trimmed.
+func (h *Headless) Screen() string {
+	lines := make([]string, 0, len(h.frame))
+	for i, l := range h.frame {
+		if i == h.height {
+			break
+		}
+		lines = append(lines, strings.TrimRight(Strip(Truncate(l, h.width)), " "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Width returns the number of columns s occupies, ignoring ANSI escape
+// sequences. Every rune counts as one column.
+func Width(s string) int {
+	n := 0
+	for i := 0; i < len(s); {
+		if j := escapeEnd(s, i); j > i {
+			i = j
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		n++
+	}
+	return n
+}
+
+// Truncate cuts s to at most width columns, keeping escape sequences
+// intact. If s had any, a reset is appended so colors do not run on.
+func Truncate(s string, width int) string {
+	var b strings.Builder
+	n, styled := 0, false
+	for i := 0; i < len(s); {
+		if j := escapeEnd(s, i); j > i {
+			b.WriteString(s[i:j])
+			i, styled = j, true
+			continue
+		}
+		if n == width {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		b.WriteString(s[i : i+size])
+		i += size
+		n++
+	}
+	if styled {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// Pad truncates or pads s with spaces to exactly width columns.
+func Pad(s string, width int) string {
+	s = Truncate(s, width)
+	if w := Width(s); w < width {
+		s += strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// Strip removes ANSI escape sequences from s.
+func Strip(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if j := escapeEnd(s, i); j > i {
+			i = j
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// escapeEnd returns the end of the CSI sequence starting at s[i], or i if
+// there is none.
+func escapeEnd(s string, i int) int {
+	if i+1 >= len(s) || s[i] != 0x1b || s[i+1] != '[' {
+		return i
+	}
+	for j := i + 2; j < len(s); j++ {
+		if s[j] >= 0x40 && s[j] <= 0x7e {
+			return j + 1
+		}
+	}
+	return i
+}
@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	exitAltScreen  = "\x1b[?25h\x1b[?1049l"
+)
+
+// TTY is a Terminal on a real terminal, in raw mode on the alternate
+// screen until closed.
+type TTY struct {
+	in    *os.File
+	out   *os.File
+	r     *bufio.Reader
+	state *term.State
+}
+
+// Open switches the terminal on in and out to raw mode and the alternate
+// screen. The caller must Close it to restore the terminal.
+func Open(in, out *os.File) (*TTY, error) {
+	if !term.IsTerminal(int(in.Fd())) || !term.IsTerminal(int(out.Fd())) {
+		return nil, fmt.Errorf("not a terminal")
+	}
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprint(out, enterAltScreen)
+	return &TTY{in: in, out: out, r: bufio.NewReader(in), state: state}, nil
+}
+
+// Close leaves the alternate screen and restores the terminal mode.
+func (t *TTY) Close() error {
+	fmt.Fprint(t.out, exitAltScreen)
+	return term.Restore(int(t.in.Fd()), t.state)
+}
+
+// Size returns the terminal size, or 80x24 if it cannot be read.
+func (t *TTY) Size() (int, int) {
+	w, h, err := term.GetSize(int(t.out.Fd()))
+	if err != nil || w <= 0 || h <= 0 {
+		return 80, 24
+	}
+	return w, h
+}
+
+func (t *TTY) ReadKey() (Key, error) {
+	return readKey(t.r)
+}
+
+// Draw repaints the whole screen in a single write to avoid flicker.
+func (t *TTY) Draw(lines []string) error {
+	w, h := t.Size()
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, l := range lines {
+		if i == h {
+			break
+		}
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(Truncate(l, w))
+		b.WriteString("\x1b[K")
+	}
+	b.WriteString("\x1b[J")
+	_, err := t.out.WriteString(b.String())
+	return err
+}
@@ -0,0 +1,181 @@
+// Package tui is a small toolkit for full-screen terminal interfaces. It
+// decodes raw terminal input into keys, draws whole frames, and provides a
+// headless terminal so an interface can be driven from tests.
+package tui
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// KeyCode identifies a key. Printable characters are KeyRune.
+type KeyCode int
+
+const (
+	KeyRune KeyCode = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPgUp
+	KeyPgDown
+	KeyEnter
+	KeyEsc
+	KeyBackspace
+	KeyDelete
+	KeyTab
+	KeyCtrlC
+)
+
+// Key is a single key press.
+type Key struct {
+	Code KeyCode
+	Rune rune
+}
+
+// Is reports whether k is the printable character r.
+func (k Key) Is(r rune) bool {
+	return k.Code == KeyRune && k.Rune == r
+}
+
+// Terminal is a screen an interface draws on and reads keys from.
+type Terminal interface {
+	// Size returns the screen size in columns and rows.
+	Size() (width, height int)
+	// ReadKey blocks until a key is pressed. It returns io.EOF when input
+	// ends.
+	ReadKey() (Key, error)
+	// Draw replaces the screen with lines.
+	Draw(lines []string) error
+}
+
+// Model is a full-screen interface.
+type Model interface {
+	// Update handles a key press and reports whether to exit.
+	Update(k Key) (quit bool)
+	// View renders the screen as at most height lines of width columns.
+	View(width, height int) []string
+}
+
+// Run draws m on t and feeds it keys until it exits or input ends.
+func Run(t Terminal, m Model) error {
+	for {
+		if err := t.Draw(m.View(t.Size())); err != nil {
+			return err
+		}
+		k, err := t.ReadKey()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if m.Update(k) {
+			return nil
+		}
+	}
+}
+
+// Keys decodes s as typed at a terminal, e.g. Keys("jj\r") or
+// Keys("\x1b[B"). It is meant for scripting a Headless terminal.
+func Keys(s string) []Key {
+	r := bufio.NewReader(strings.NewReader(s))
+	var keys []Key
+	for {
+		k, err := readKey(r)
+		if err != nil {
+			return keys
+		}
+		keys = append(keys, k)
+	}
+}
+
+// readKey decodes the next key from r. Escape sequences are assumed to
+// arrive in one read, so a lone ESC is the Escape key.
+func readKey(r *bufio.Reader) (Key, error) {
+	for {
+		c, _, err := r.ReadRune()
+		if err != nil {
+			return Key{}, err
+		}
+		switch c {
+		case '\r', '\n':
+			return Key{Code: KeyEnter}, nil
+		case '\t':
+			return Key{Code: KeyTab}, nil
+		case 0x7f, 0x08:
+			return Key{Code: KeyBackspace}, nil
+		case 0x03:
+			return Key{Code: KeyCtrlC}, nil
+		case 0x1b:
+			if k, ok := readEscape(r); ok {
+				return k, nil
+			}
+			continue
+		}
+		if c >= 0x20 {
+			return Key{Code: KeyRune, Rune: c}, nil
+		}
+		// Ignore other control characters.
+	}
+}
+
+// readEscape decodes the CSI or SS3 sequence following an ESC. It
+// returns false for sequences it does not know, which are skipped.
+func readEscape(r *bufio.Reader) (Key, bool) {
+	if r.Buffered() == 0 {
+		return Key{Code: KeyEsc}, true
+	}
+	if next, _ := r.Peek(1); next[0] != '[' && next[0] != 'O' {
+		return Key{Code: KeyEsc}, true
+	}
+	r.ReadByte()
+
+	// Parameters, then a final byte in 0x40-0x7e.
+	var params []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return Key{}, false
+		}
+		if b >= 0x40 && b <= 0x7e {
+			return sequenceKey(string(params), b)
+		}
+		params = append(params, b)
+	}
+}
+
+func sequenceKey(params string, final byte) (Key, bool) {
+	switch final {
+	case 'A':
+		return Key{Code: KeyUp}, true
+	case 'B':
+		return Key{Code: KeyDown}, true
+	case 'C':
+		return Key{Code: KeyRight}, true
+	case 'D':
+		return Key{Code: KeyLeft}, true
+	case 'H':
+		return Key{Code: KeyHome}, true
+	case 'F':
+		return Key{Code: KeyEnd}, true
+	case '~':
+		switch params {
+		case "1", "7":
+			return Key{Code: KeyHome}, true
+		case "4", "8":
+			return Key{Code: KeyEnd}, true
+		case "3":
+			return Key{Code: KeyDelete}, true
+		case "5":
+			return Key{Code: KeyPgUp}, true
+		case "6":
+			return Key{Code: KeyPgDown}, true
+		}
+	}
+	return Key{}, false
+}
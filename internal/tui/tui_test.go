@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys(t *testing.T) {
+	got := Keys("jé\r\x1b[A\x1b[B\x1b[5~\x1b[1;5C\x1bq\x7f\x03\x1b")
+	want := []Key{
+		{Code: KeyRune, Rune: 'j'},
+		{Code: KeyRune, Rune: 'é'},
+		{Code: KeyEnter},
+		{Code: KeyUp},
+		{Code: KeyDown},
+		{Code: KeyPgUp},
+		{Code: KeyRight},
+		{Code: KeyEsc},
+		{Code: KeyRune, Rune: 'q'},
+		{Code: KeyBackspace},
+		{Code: KeyCtrlC},
+		{Code: KeyEsc},
+	}
+	assert.Equal(t, want, got)
+	assert.Empty(t, Keys("\x1b[99z"), "unknown sequences are skipped")
+}
+
+func TestTruncateAndPad(t *testing.T) {
+	red := "\x1b[31mhello\x1b[0m world"
+	assert.Equal(t, 11, Width(red))
+	assert.Equal(t, "\x1b[31mhel\x1b[0m", Truncate(red, 3))
+	assert.Equal(t, "hello world", Strip(red))
+	assert.Equal(t, "héllo", Truncate("héllo wörld", 5))
+	assert.Equal(t, "ab   ", Pad("ab", 5))
+	assert.Equal(t, "abc", Pad("abcdef", 3))
+}
+
+// counter counts up on "+" and exits on "q".
+type counter struct{ n int }
+
+func (c *counter) Update(k Key) bool {
+	if k.Is('+') {
+		c.n++
+	}
+	return k.Is('q')
+}
+
+func (c *counter) View(width, height int) []string {
+	return []string{"count: " + string(rune('0'+c.n)), "a line far too long for the screen"}
+}
+
+func TestRun(t *testing.T) {
+	term := NewHeadless(12, 5, Keys("++q+")...)
+	c := &counter{}
+	require.NoError(t, Run(term, c))
+	assert.Equal(t, 2, c.n, "keys after q are not read")
+	assert.Equal(t, "count: 2\na line far t", term.Screen())
+	assert.Equal(t, 3, term.Frames())
+
+	// Run returns when input ends.
+	term = NewHeadless(12, 5, Keys("+")...)
+	require.NoError(t, Run(term, &counter{}))
+	assert.Equal(t, 2, term.Frames())
+}
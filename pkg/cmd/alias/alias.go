@@ -0,0 +1,25 @@
+package alias
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdAlias returns the "alias" parent command.
+func NewCmdAlias(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias <command>",
+		Short: "Create command shortcuts",
+		Long: `Aliases expand to full clickup commands before they run.
+
+Use $1, $2, ... in an expansion to place arguments; any other arguments are
+appended. Aliases starting with "!" run through sh instead, with the
+arguments available as $1, $2, ... and "$@".`,
+	}
+
+	cmd.AddCommand(NewCmdSet(f))
+	cmd.AddCommand(NewCmdList(f))
+	cmd.AddCommand(NewCmdDelete(f))
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package alias
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+// newAliasRoot builds a root with a few built-in commands next to alias, so
+// collision checks have something to collide with.
+func newAliasRoot(t *testing.T) (*testutil.TestFactory, *config.Config, *cobra.Command) {
+	t.Setenv("CLICKUP_CONFIG_DIR", t.TempDir())
+	tf := testutil.NewTestFactory(t)
+	cfg := &config.Config{Aliases: map[string]string{"tv": "task view"}}
+	tf.Factory.SetConfig(cfg)
+
+	root := &cobra.Command{Use: "clickup", SilenceErrors: true, SilenceUsage: true}
+	root.AddCommand(&cobra.Command{Use: "task", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(&cobra.Command{Use: "status", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(NewCmdAlias(tf.Factory))
+	root.SetOut(tf.OutBuf)
+	root.SetErr(tf.ErrBuf)
+	return tf, cfg, root
+}
+
+func runAlias(root *cobra.Command, args ...string) error {
+	root.SetArgs(append([]string{"alias"}, args...))
+	return root.Execute()
+}
+
+func TestAliasSet(t *testing.T) {
+	tf, cfg, root := newAliasRoot(t)
+
+	require.NoError(t, runAlias(root, "set", "close", "status set complete $1"))
+	assert.Equal(t, "status set complete $1", cfg.Aliases["close"])
+	assert.Contains(t, tf.OutBuf.String(), "Added alias close")
+
+	require.NoError(t, runAlias(root, "set", "--shell", "standup", "clickup task recent"))
+	assert.Equal(t, "!clickup task recent", cfg.Aliases["standup"])
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Aliases, loaded.Aliases)
+}
+
+func TestAliasSet_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"built-in name", []string{"task", "status list"}, "already a clickup command"},
+		{"help", []string{"help", "task list"}, "already a clickup command"},
+		{"alias command itself", []string{"alias", "task list"}, "already a clickup command"},
+		{"unknown command", []string{"x", "nope list"}, "does not start with a clickup command"},
+		{"existing alias", []string{"tv", "task list"}, "--clobber"},
+		{"bad name", []string{"two words", "task list"}, "invalid alias name"},
+		{"unterminated quote", []string{"x", `task "view`}, "could not parse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, cfg, root := newAliasRoot(t)
+			err := runAlias(root, append([]string{"set"}, tt.args...)...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, map[string]string{"tv": "task view"}, cfg.Aliases)
+		})
+	}
+}
+
+func TestAliasSet_Clobber(t *testing.T) {
+	tf, cfg, root := newAliasRoot(t)
+
+	require.NoError(t, runAlias(root, "set", "tv", "task view --json", "--clobber"))
+	assert.Equal(t, "task view --json", cfg.Aliases["tv"])
+	assert.Contains(t, tf.OutBuf.String(), "Changed alias tv")
+}
+
+func TestAliasListAndDelete(t *testing.T) {
+	tf, cfg, root := newAliasRoot(t)
+	cfg.Aliases["close"] = "status set complete $1"
+
+	require.NoError(t, runAlias(root, "list"))
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "close")
+	assert.Contains(t, out, "task view")
+	assert.Less(t, strings.Index(out, "close"), strings.Index(out, "tv"), "aliases should be sorted")
+
+	tf.OutBuf.Reset()
+	require.NoError(t, runAlias(root, "delete", "close"))
+	assert.NotContains(t, cfg.Aliases, "close")
+
+	err := runAlias(root, "delete", "close")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no such alias")
+}
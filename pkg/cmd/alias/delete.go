@@ -0,0 +1,44 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdDelete returns the "alias delete" command.
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a command alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteRun(f, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func deleteRun(f *cmdutil.Factory, name string) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	expansion, ok := cfg.Aliases[name]
+	if !ok {
+		return fmt.Errorf("no such alias %q", name)
+	}
+
+	delete(cfg.Aliases, name)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Fprintf(ios.Out, "%s Deleted alias %s; it expanded to: %s\n", cs.Green("!"), cs.Bold(name), expansion)
+	return nil
+}
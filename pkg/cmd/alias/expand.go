@@ -0,0 +1,158 @@
+package alias
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ExpandAlias expands args, the command line without the program name, when
+// args[0] names an alias. For a shell alias ("!" prefix) isShell is true and
+// expanded is an sh invocation to run instead of the CLI. Args that are not
+// an alias are returned unchanged.
+func ExpandAlias(aliases map[string]string, args []string) (expanded []string, isShell bool, err error) {
+	return expandAlias(aliases, args, func() (string, error) {
+		return exec.LookPath("sh")
+	})
+}
+
+var placeholderRE = regexp.MustCompile(`\$(\d+)`)
+
+func expandAlias(aliases map[string]string, args []string, findSh func() (string, error)) ([]string, bool, error) {
+	if len(args) == 0 {
+		return args, false, nil
+	}
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args, false, nil
+	}
+	rest := args[1:]
+
+	if script, ok := strings.CutPrefix(expansion, "!"); ok {
+		sh, err := findSh()
+		if err != nil {
+			return nil, false, fmt.Errorf("shell alias %q needs sh on PATH: %w", args[0], err)
+		}
+		// sh -c takes the next argument as $0, so "--" shifts ours to $1.
+		expanded := []string{sh, "-c", script}
+		if len(rest) > 0 {
+			expanded = append(expanded, "--")
+			expanded = append(expanded, rest...)
+		}
+		return expanded, true, nil
+	}
+
+	words, err := splitArgs(expansion)
+	if err != nil {
+		return nil, false, fmt.Errorf("alias %q: %w", args[0], err)
+	}
+
+	used := map[int]bool{}
+	for i, w := range words {
+		var missing string
+		words[i] = placeholderRE.ReplaceAllStringFunc(w, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 || n > len(rest) {
+				missing = m
+				return m
+			}
+			used[n] = true
+			return rest[n-1]
+		})
+		if missing != "" {
+			return nil, false, fmt.Errorf("not enough arguments for alias %q: %s", args[0], expansion)
+		}
+	}
+	for i, a := range rest {
+		if !used[i+1] {
+			words = append(words, a)
+		}
+	}
+	return words, false, nil
+}
+
+// IsBuiltin reports whether name is a command or command alias of root.
+func IsBuiltin(root *cobra.Command, name string) bool {
+	if name == "help" {
+		return true
+	}
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedNames returns the alias names in alphabetical order.
+func sortedNames(aliases map[string]string) []string {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitArgs splits s into words the way a POSIX shell would, honouring
+// single quotes, double quotes and backslash escapes. It does not expand
+// variables or globs.
+func splitArgs(s string) ([]string, error) {
+	var words []string
+	var cur strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case quote == '"':
+			switch {
+			case r == '"':
+				quote = 0
+			case r == '\\' && i+1 < len(runes) && strings.ContainsRune(`"\$`+"`", runes[i+1]):
+				i++
+				cur.WriteRune(runes[i])
+			default:
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				cur.WriteRune(runes[i])
+			}
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words, nil
+}
@@ -0,0 +1,91 @@
+package alias
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAlias(t *testing.T) {
+	aliases := map[string]string{
+		"tv":    "task view",
+		"close": "status set complete $1",
+		"mv":    "task move $2 --list $1",
+		"note":  `comment add $1 "from $2"`,
+		"st":    "!clickup task recent | head -n $1",
+	}
+	findSh := func() (string, error) { return "/bin/sh", nil }
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		isShell bool
+		wantErr string
+	}{
+		{"not an alias", []string{"task", "view"}, []string{"task", "view"}, false, ""},
+		{"no args", []string{}, []string{}, false, ""},
+		{"extra args appended", []string{"tv", "86abc", "--json"}, []string{"task", "view", "86abc", "--json"}, false, ""},
+		{"placeholder", []string{"close", "86abc"}, []string{"status", "set", "complete", "86abc"}, false, ""},
+		{"placeholders reordered", []string{"mv", "L1", "T1", "--json"}, []string{"task", "move", "T1", "--list", "L1", "--json"}, false, ""},
+		{"arg with spaces stays one word", []string{"note", "86abc", "Jane Doe"}, []string{"comment", "add", "86abc", "from Jane Doe"}, false, ""},
+		{"missing placeholder arg", []string{"close"}, nil, false, "not enough arguments"},
+		{"shell", []string{"st", "5"}, []string{"/bin/sh", "-c", "clickup task recent | head -n $1", "--", "5"}, true, ""},
+		{"shell without args", []string{"st"}, []string{"/bin/sh", "-c", "clickup task recent | head -n $1"}, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, isShell, err := expandAlias(aliases, tt.args, findSh)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.isShell, isShell)
+		})
+	}
+}
+
+func TestExpandAlias_NoShell(t *testing.T) {
+	_, _, err := expandAlias(map[string]string{"x": "!echo hi"}, []string{"x"}, func() (string, error) {
+		return "", errors.New("not found")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "needs sh")
+}
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"task list --assignee me", []string{"task", "list", "--assignee", "me"}},
+		{`  spaced   out  `, []string{"spaced", "out"}},
+		{`a 'b c' "d e"`, []string{"a", "b c", "d e"}},
+		{`"say \"hi\"" 'it''s'`, []string{`say "hi"`, "its"}},
+		{`a\ b ""`, []string{"a b", ""}},
+	}
+	for _, tt := range tests {
+		got, err := splitArgs(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+
+	_, err := splitArgs(`task "view`)
+	assert.Error(t, err)
+}
+
+func TestIsBuiltin(t *testing.T) {
+	root := &cobra.Command{Use: "clickup"}
+	root.AddCommand(&cobra.Command{Use: "task", Aliases: []string{"tasks"}})
+
+	assert.True(t, IsBuiltin(root, "task"))
+	assert.True(t, IsBuiltin(root, "tasks"))
+	assert.True(t, IsBuiltin(root, "help"))
+	assert.False(t, IsBuiltin(root, "tv"))
+}
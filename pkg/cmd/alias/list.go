@@ -0,0 +1,71 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type listOptions struct {
+	jsonFlags cmdutil.JSONFlags
+}
+
+// NewCmdList returns the "alias list" command.
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List command aliases",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listRun(f, opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func listRun(f *cmdutil.Factory, opts *listOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	names := sortedNames(cfg.Aliases)
+
+	if opts.jsonFlags.WantsJSON() {
+		type aliasJSON struct {
+			Name      string `json:"name"`
+			Expansion string `json:"expansion"`
+		}
+		data := make([]aliasJSON, len(names))
+		for i, name := range names {
+			data[i] = aliasJSON{Name: name, Expansion: cfg.Aliases[name]}
+		}
+		return opts.jsonFlags.OutputJSON(ios.Out, data)
+	}
+
+	if len(names) == 0 {
+		fmt.Fprintln(ios.Out, "No aliases configured. Add one with 'clickup alias set <name> <expansion>'")
+		return nil
+	}
+
+	tp := tableprinter.New(ios)
+	tp.AddField(cs.Bold("NAME"))
+	tp.AddField(cs.Bold("EXPANSION"))
+	tp.EndRow()
+	for _, name := range names {
+		tp.AddField(name)
+		tp.AddField(cfg.Aliases[name])
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,113 @@
+package alias
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type setOptions struct {
+	name      string
+	expansion string
+	shell     bool
+	clobber   bool
+}
+
+// NewCmdSet returns the "alias set" command.
+func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
+	opts := &setOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Create a shortcut for a clickup command",
+		Long: `Define a word that expands to a full clickup command when invoked.
+
+The expansion may use $1, $2, ... to place arguments; arguments that no
+placeholder uses are appended. Quote the expansion so your shell passes it
+as one argument.
+
+With --shell, or an expansion starting with "!", the alias is run by sh
+instead. Shell aliases can chain several commands and use pipes; arguments
+are available as $1, $2, ... and "$@".
+
+Alias names cannot shadow built-in commands.`,
+		Example: `  # clickup tv 86abc123 → clickup task view 86abc123
+  clickup alias set tv 'task view'
+
+  # clickup mine → clickup task list --assignee me
+  clickup alias set mine 'task list --assignee me'
+
+  # Placeholders: clickup close 86abc123 → clickup status set complete 86abc123
+  clickup alias set close 'status set complete $1'
+
+  # Shell alias combining commands
+  clickup alias set --shell standup 'clickup task recent && clickup inbox'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.expansion = args[1]
+			return setRun(f, cmd.Root(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.shell, "shell", "s", false, "Run the expansion through sh")
+	cmd.Flags().BoolVar(&opts.clobber, "clobber", false, "Overwrite an existing alias of the same name")
+
+	return cmd
+}
+
+func setRun(f *cmdutil.Factory, root *cobra.Command, opts *setOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	name := opts.name
+	if name == "" || strings.ContainsAny(name, " \t\n") || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid alias name %q", name)
+	}
+	if IsBuiltin(root, name) {
+		return fmt.Errorf("could not create alias: %q is already a clickup command", name)
+	}
+
+	expansion := strings.TrimSpace(opts.expansion)
+	if opts.shell && !strings.HasPrefix(expansion, "!") {
+		expansion = "!" + expansion
+	}
+	if expansion == "" || expansion == "!" {
+		return fmt.Errorf("alias expansion cannot be empty")
+	}
+	if !strings.HasPrefix(expansion, "!") {
+		words, err := splitArgs(expansion)
+		if err != nil {
+			return fmt.Errorf("could not parse expansion: %w", err)
+		}
+		if len(words) == 0 || !IsBuiltin(root, words[0]) {
+			return fmt.Errorf("could not create alias: %q does not start with a clickup command", expansion)
+		}
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	existing, exists := cfg.Aliases[name]
+	if exists && !opts.clobber {
+		return fmt.Errorf("alias %q already exists (%s). Use --clobber to overwrite it", name, existing)
+	}
+
+	if cfg.Aliases == nil {
+		cfg.Aliases = map[string]string{}
+	}
+	cfg.Aliases[name] = expansion
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	verb := "Added"
+	if exists {
+		verb = "Changed"
+	}
+	fmt.Fprintf(ios.Out, "%s %s alias %s: %s\n", cs.Green("!"), verb, cs.Bold(name), expansion)
+	return nil
+}
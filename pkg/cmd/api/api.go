@@ -0,0 +1,437 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	clickupapi "github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type apiOptions struct {
+	method     string
+	rawFields  []string
+	typedField []string
+	headers    []string
+	input      string
+	version    string
+	paginate   bool
+	jsonFlags  cmdutil.JSONFlags
+}
+
+// NewCmdAPI returns the api command for making raw authenticated requests.
+func NewCmdAPI(f *cmdutil.Factory) *cobra.Command {
+	opts := &apiOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "api <path>",
+		Short: "Make an authenticated ClickUp API request",
+		Long: `Make an authenticated HTTP request to the ClickUp API and print the response.
+
+The path is relative to the API root, e.g. "team/{workspace}/guest/123" for
+v2 or "workspaces/{workspace}/docs" for v3. Use --api-version to choose
+between v2 (the default) and v3.
+
+Placeholders in the path are replaced from your configuration:
+  {workspace}  The configured workspace (team) ID
+  {space}      The space for the current directory, or the default space
+
+Fields are passed as query parameters for GET requests and as a JSON body
+otherwise. Adding fields or --input switches the default method to POST.
+  -f key=value   Add a string field
+  -F key=value   Add a typed field: true, false, null and numbers are
+                 converted, and "@file" reads the value from a file
+A key ending in "[]" builds an array (e.g. -F assignees[]=123).
+
+With --paginate, pages are fetched until the response reports "last_page"
+or returns no "next_cursor", and array fields are merged across pages.`,
+		Example: `  # Get the authorized user
+  clickup api user
+
+  # Get a guest of the configured workspace
+  clickup api team/{workspace}/guest/123
+
+  # Create a tag in the current space
+  clickup api space/{space}/tag -f tag[name]=urgent
+
+  # Post a JSON body from a file
+  clickup api list/12345/task -X POST --input task.json
+
+  # Use the v3 API and filter with jq
+  clickup api workspaces/{workspace}/docs --api-version v3 --jq '.docs[].name'
+
+  # Fetch every page of tasks in a list
+  clickup api list/12345/task --paginate --jq '.tasks | length'`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("method") && (len(opts.rawFields) > 0 || len(opts.typedField) > 0 || opts.input != "") {
+				opts.method = http.MethodPost
+			}
+			return runAPI(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.method, "method", "X", http.MethodGet, "HTTP method")
+	cmd.Flags().StringArrayVarP(&opts.rawFields, "raw-field", "f", nil, "Add a string field (key=value)")
+	cmd.Flags().StringArrayVarP(&opts.typedField, "field", "F", nil, "Add a typed field (key=value)")
+	cmd.Flags().StringArrayVarP(&opts.headers, "header", "H", nil, "Add a request header (key:value)")
+	cmd.Flags().StringVar(&opts.input, "input", "", "File to use as the request body (\"-\" for stdin)")
+	cmd.Flags().StringVar(&opts.version, "api-version", "v2", "API version: v2 or v3")
+	cmd.Flags().BoolVar(&opts.paginate, "paginate", false, "Fetch all pages of results")
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func runAPI(f *cmdutil.Factory, opts *apiOptions, path string) error {
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	var base string
+	switch opts.version {
+	case "v2":
+		base = client.BaseURL()
+	case "v3":
+		base = client.BaseURLV3()
+	default:
+		return fmt.Errorf("invalid --api-version %q: must be v2 or v3", opts.version)
+	}
+
+	path, err = expandPlaceholders(f, path)
+	if err != nil {
+		return err
+	}
+
+	method := strings.ToUpper(opts.method)
+	fields, err := parseFields(opts.rawFields, opts.typedField)
+	if err != nil {
+		return err
+	}
+	if opts.input != "" && len(fields) > 0 && method != http.MethodGet {
+		return fmt.Errorf("--input cannot be combined with fields for %s requests", method)
+	}
+
+	reqURL, err := url.Parse(base + "/" + strings.TrimLeft(path, "/"))
+	if err != nil {
+		return fmt.Errorf("invalid path: %w", err)
+	}
+
+	var body []byte
+	switch {
+	case opts.input == "-":
+		if body, err = io.ReadAll(f.IOStreams.In); err != nil {
+			return fmt.Errorf("failed to read stdin: %w", err)
+		}
+	case opts.input != "":
+		if body, err = os.ReadFile(opts.input); err != nil {
+			return fmt.Errorf("failed to read input file: %w", err)
+		}
+	}
+
+	if len(fields) > 0 {
+		if method == http.MethodGet {
+			q := reqURL.Query()
+			addQuery(q, fields)
+			reqURL.RawQuery = q.Encode()
+		} else {
+			if body, err = json.Marshal(fieldsBody(fields)); err != nil {
+				return fmt.Errorf("failed to encode fields: %w", err)
+			}
+		}
+	}
+
+	headers := http.Header{}
+	for _, h := range opts.headers {
+		k, v, ok := strings.Cut(h, ":")
+		if !ok {
+			return fmt.Errorf("invalid header %q: expected key:value", h)
+		}
+		headers.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if body != nil && headers.Get("Content-Type") == "" {
+		headers.Set("Content-Type", "application/json")
+	}
+
+	ctx := context.Background()
+	var pages [][]byte
+	for {
+		data, err := doRequest(ctx, client, method, reqURL.String(), headers, body)
+		if err != nil {
+			return err
+		}
+		pages = append(pages, data)
+
+		if !opts.paginate {
+			break
+		}
+		next, ok := nextPage(reqURL, data)
+		if !ok {
+			break
+		}
+		reqURL = next
+	}
+
+	out := pages[0]
+	if len(pages) > 1 {
+		if out, err = mergePages(pages); err != nil {
+			return err
+		}
+	}
+	return writeResponse(f, &opts.jsonFlags, out)
+}
+
+func doRequest(ctx context.Context, client *clickupapi.Client, method, rawURL string, headers http.Header, body []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	for k, vs := range headers {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+
+	resp, err := client.DoRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := clickupapi.HandleErrorResponse(resp); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	return data, nil
+}
+
+// expandPlaceholders replaces {workspace} and {space} in path from config.
+func expandPlaceholders(f *cmdutil.Factory, path string) (string, error) {
+	if !strings.Contains(path, "{") {
+		return path, nil
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(path, "{workspace}") {
+		if cfg.Workspace == "" {
+			return "", fmt.Errorf("no workspace configured. Run 'clickup auth login' first")
+		}
+		path = strings.ReplaceAll(path, "{workspace}", cfg.Workspace)
+	}
+
+	if strings.Contains(path, "{space}") {
+		dir, _ := os.Getwd()
+		space := cfg.SpaceForDir(dir)
+		if space == "" {
+			return "", fmt.Errorf("no space configured. Run 'clickup space select' first")
+		}
+		path = strings.ReplaceAll(path, "{space}", space)
+	}
+
+	return path, nil
+}
+
+type field struct {
+	key   string
+	value any
+}
+
+// parseFields parses -f (string) and -F (typed) key=value pairs, preserving
+// the order in which they were given within each flag.
+func parseFields(raw, typed []string) ([]field, error) {
+	var fields []field
+	for _, kv := range raw {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid field %q: expected key=value", kv)
+		}
+		fields = append(fields, field{key: k, value: v})
+	}
+	for _, kv := range typed {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid field %q: expected key=value", kv)
+		}
+		value, err := typedValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", k, err)
+		}
+		fields = append(fields, field{key: k, value: value})
+	}
+	return fields, nil
+}
+
+func typedValue(v string) (any, error) {
+	switch v {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if strings.HasPrefix(v, "@") {
+		data, err := os.ReadFile(v[1:])
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return n, nil
+	}
+	if n, err := strconv.ParseFloat(v, 64); err == nil {
+		return n, nil
+	}
+	return v, nil
+}
+
+// fieldsBody builds a JSON object from fields. "key[]" appends to an array
+// and "key[sub]" sets a key in a nested object.
+func fieldsBody(fields []field) map[string]any {
+	body := map[string]any{}
+	for _, fld := range fields {
+		key, sub, nested := strings.Cut(fld.key, "[")
+		if !nested {
+			body[key] = fld.value
+			continue
+		}
+		sub = strings.TrimSuffix(sub, "]")
+		if sub == "" {
+			arr, _ := body[key].([]any)
+			body[key] = append(arr, fld.value)
+			continue
+		}
+		obj, ok := body[key].(map[string]any)
+		if !ok {
+			obj = map[string]any{}
+			body[key] = obj
+		}
+		obj[sub] = fld.value
+	}
+	return body
+}
+
+func addQuery(q url.Values, fields []field) {
+	for _, fld := range fields {
+		v := ""
+		if fld.value != nil {
+			v = fmt.Sprint(fld.value)
+		}
+		q.Add(fld.key, v)
+	}
+}
+
+// nextPage returns the URL of the page after data, using ClickUp's v2
+// "last_page" flag with a "page" parameter or v3's "next_cursor".
+func nextPage(current *url.URL, data []byte) (*url.URL, bool) {
+	var meta struct {
+		LastPage   *bool  `json:"last_page"`
+		NextCursor string `json:"next_cursor"`
+	}
+	if json.Unmarshal(data, &meta) != nil {
+		return nil, false
+	}
+
+	next := *current
+	q := next.Query()
+	switch {
+	case meta.LastPage != nil && !*meta.LastPage:
+		page, _ := strconv.Atoi(q.Get("page"))
+		q.Set("page", strconv.Itoa(page+1))
+	case meta.NextCursor != "":
+		q.Set("cursor", meta.NextCursor)
+	default:
+		return nil, false
+	}
+	next.RawQuery = q.Encode()
+	return &next, true
+}
+
+// mergePages concatenates the top-level array fields of paginated responses
+// into the first page and drops the pagination markers.
+func mergePages(pages [][]byte) ([]byte, error) {
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(pages[0], &merged); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	for _, page := range pages[1:] {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(page, &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		for k, v := range obj {
+			var next []json.RawMessage
+			if json.Unmarshal(v, &next) != nil {
+				continue
+			}
+			var prev []json.RawMessage
+			if json.Unmarshal(merged[k], &prev) != nil {
+				continue
+			}
+			combined, err := json.Marshal(append(prev, next...))
+			if err != nil {
+				return nil, err
+			}
+			merged[k] = combined
+		}
+	}
+
+	delete(merged, "last_page")
+	delete(merged, "next_cursor")
+	return json.Marshal(merged)
+}
+
+func writeResponse(f *cmdutil.Factory, jsonFlags *cmdutil.JSONFlags, data []byte) error {
+	out := f.IOStreams.Out
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	if !json.Valid(data) {
+		_, err := out.Write(data)
+		return err
+	}
+
+	if jsonFlags.JQ != "" || jsonFlags.Template != "" {
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+		return jsonFlags.OutputJSON(out, v)
+	}
+
+	// Indent the raw bytes so the server's key order is preserved.
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(out)
+	return err
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+func TestNewCmdAPI_Flags(t *testing.T) {
+	cmd := NewCmdAPI(nil)
+	assert.Equal(t, "api <path>", cmd.Use)
+	for _, name := range []string{"method", "raw-field", "field", "header", "input", "api-version", "paginate", "jq", "template"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), name)
+	}
+	assert.NotNil(t, cmd.Flags().ShorthandLookup("X"))
+	assert.NotNil(t, cmd.Flags().ShorthandLookup("f"))
+	assert.NotNil(t, cmd.Flags().ShorthandLookup("F"))
+}
+
+func TestAPI_GetWithPlaceholderAndQuery(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleFunc("team/12345/guest/9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "true", r.URL.Query().Get("include_shared"))
+		w.Write([]byte(`{"guest":{"user":{"id":9}}}`))
+	})
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "team/{workspace}/guest/9", "-X", "GET", "-F", "include_shared=true")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"guest":{"user":{"id":9}}}`, tf.OutBuf.String())
+}
+
+func TestAPI_FieldsBecomeJSONBody(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleFunc("space/67890/tag", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"tag":{"name":"urgent"},"ids":[1,2],"enabled":false,"note":"42"}`, string(body))
+		w.Write([]byte(`{}`))
+	})
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "space/{space}/tag",
+		"-f", "tag[name]=urgent", "-F", "ids[]=1", "-F", "ids[]=2", "-F", "enabled=false", "-f", "note=42")
+	require.NoError(t, err)
+}
+
+func TestAPI_InputFile(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleFunc("list/1/task", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"from file"}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	path := filepath.Join(t.TempDir(), "body.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"name":"from file"}`), 0o600))
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "list/1/task", "-X", "put", "--input", path)
+	require.NoError(t, err)
+	assert.Empty(t, tf.OutBuf.String())
+}
+
+func TestAPI_V3WithJQ(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleV3("GET", "workspaces/12345/docs", 200, `{"docs":[{"name":"Spec"},{"name":"Notes"}]}`)
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "workspaces/{workspace}/docs", "--api-version", "v3", "--jq", ".docs[].name", "-r")
+	require.NoError(t, err)
+	assert.Equal(t, "Spec\nNotes\n", tf.OutBuf.String())
+}
+
+func TestAPI_PaginateV2Pages(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleFunc("list/1/task", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"tasks":[{"id":"a"}],"last_page":false}`))
+		case "1":
+			w.Write([]byte(`{"tasks":[{"id":"b"}],"last_page":false}`))
+		default:
+			w.Write([]byte(`{"tasks":[{"id":"c"}],"last_page":true}`))
+		}
+	})
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "list/1/task", "--paginate")
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &out))
+	assert.Len(t, out["tasks"], 3)
+	assert.NotContains(t, out, "last_page")
+}
+
+func TestAPI_PaginateCursor(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.HandleFuncV3("workspaces/12345/docs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"docs":[{"id":"1"}],"next_cursor":"abc"}`))
+			return
+		}
+		assert.Equal(t, "abc", r.URL.Query().Get("cursor"))
+		w.Write([]byte(`{"docs":[{"id":"2"}],"next_cursor":""}`))
+	})
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "workspaces/{workspace}/docs", "--api-version", "v3", "--paginate", "--jq", "[.docs[].id]")
+	require.NoError(t, err)
+	assert.JSONEq(t, `["1","2"]`, tf.OutBuf.String())
+}
+
+func TestAPI_ErrorResponse(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Handle("GET", "task/missing", 404, `{"err":"Task not found","ECODE":"ITEM_013"}`)
+
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "task/missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP 404")
+}
+
+func TestAPI_InvalidVersion(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	cmd := NewCmdAPI(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "user", "--api-version", "v4")
+	assert.ErrorContains(t, err, "must be v2 or v3")
+}
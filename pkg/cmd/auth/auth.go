@@ -5,7 +5,7 @@ import (
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
-// NewCmdAuth returns the top-level "auth" command that groups login, logout, and status.
+// NewCmdAuth returns the top-level "auth" command that groups login, logout, status, and switch.
 func NewCmdAuth(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "auth <command>",
@@ -16,6 +16,7 @@ func NewCmdAuth(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(NewCmdLogin(f))
 	cmd.AddCommand(NewCmdLogout(f))
 	cmd.AddCommand(NewCmdStatus(f))
+	cmd.AddCommand(NewCmdSwitch(f))
 
 	return cmd
 }
@@ -7,10 +7,10 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/triptechtravel/clickup-cli/internal/api"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/auth"
 	"github.com/triptechtravel/clickup-cli/internal/browser"
+	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/internal/prompter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
@@ -39,7 +39,11 @@ In non-interactive environments (CI), pipe a token via stdin:
   echo "pk_12345" | clickup auth login --with-token
 
 To use OAuth instead (requires a registered OAuth app):
-  clickup auth login --oauth`,
+  clickup auth login --oauth
+
+Pass the global --profile flag to log in to a named profile. Each profile
+keeps its own token, workspace and space/folder/list defaults; switch
+between them with 'clickup auth switch'.`,
 		Example: `  # Interactive token entry (default)
   clickup auth login
 
@@ -47,7 +51,10 @@ To use OAuth instead (requires a registered OAuth app):
   echo "pk_12345" | clickup auth login --with-token
 
   # Use OAuth browser flow
-  clickup auth login --oauth`,
+  clickup auth login --oauth
+
+  # Add a profile for a client workspace
+  clickup auth login --profile acme`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return loginRun(opts)
 		},
@@ -63,8 +70,16 @@ func loginRun(opts *loginOptions) error {
 	ios := opts.factory.IOStreams
 	cs := ios.ColorScheme()
 
+	cfg, err := opts.factory.Config()
+	if err != nil {
+		return err
+	}
+	profile := cfg.Profile()
+
 	var token string
 	var method string
+	// oauthToken carries the refresh metadata of an OAuth login.
+	var oauthToken *auth.OAuthToken
 
 	switch {
 	case opts.withToken:
@@ -81,11 +96,6 @@ func loginRun(opts *loginOptions) error {
 
 	case opts.oauth:
 		// OAuth browser flow.
-		cfg, err := opts.factory.Config()
-		if err != nil {
-			return err
-		}
-
 		clientID := auth.DefaultClientID
 		clientSecret := auth.DefaultClientSecret
 
@@ -97,10 +107,11 @@ func loginRun(opts *loginOptions) error {
 		_ = browser.Open(authURL)
 
 		var oauthErr error
-		token, oauthErr = auth.OAuthFlow(clientID, clientSecret)
+		oauthToken, oauthErr = auth.OAuthFlow(clientID, clientSecret)
 		if oauthErr != nil {
 			return fmt.Errorf("OAuth flow failed: %w", oauthErr)
 		}
+		token = oauthToken.AccessToken
 		method = "oauth"
 
 	default:
@@ -128,16 +139,34 @@ func loginRun(opts *loginOptions) error {
 		return fmt.Errorf("token validation failed: %w", err)
 	}
 
-	// Store the token.
-	if err := auth.StoreToken(token, method); err != nil {
+	// Store the token, with its refresh token and expiry for OAuth.
+	if oauthToken != nil {
+		err = auth.StoreCredentials(profile, oauthToken.Credentials())
+	} else {
+		err = auth.StoreProfileToken(profile, token, method)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to store credentials: %w", err)
 	}
 
-	fmt.Fprintf(ios.Out, "%s Logged in as %s (%s)\n",
-		cs.Green("!"),
-		cs.Bold(user.Username),
-		user.Email,
-	)
+	if profile == config.DefaultProfile {
+		fmt.Fprintf(ios.Out, "%s Logged in as %s (%s)\n",
+			cs.Green("!"),
+			cs.Bold(user.Username),
+			user.Email,
+		)
+	} else {
+		// Save right away so the profile exists even if no workspace is chosen.
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		fmt.Fprintf(ios.Out, "%s Logged in as %s (%s) in profile %s\n",
+			cs.Green("!"),
+			cs.Bold(user.Username),
+			user.Email,
+			cs.Bold(profile),
+		)
+	}
 
 	// Select a workspace.
 	if err := selectWorkspace(opts, token); err != nil {
@@ -163,7 +192,10 @@ func selectWorkspace(opts *loginOptions, token string) error {
 
 	// Create a fresh API client with the newly obtained token. We cannot use
 	// the Factory's cached client because it was initialised before authentication.
-	client := api.NewClient(token)
+	client, err := opts.factory.NewAPIClient(token)
+	if err != nil {
+		return err
+	}
 
 	teams, err := apiv2.GetTeamsLocal(context.Background(), client)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/auth"
+	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -13,7 +14,9 @@ func NewCmdLogout(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logout",
 		Short: "Log out of ClickUp",
-		Long:  "Remove stored authentication credentials for the ClickUp CLI.",
+		Long: `Remove stored authentication credentials for the ClickUp CLI.
+
+Only the current profile is logged out; its workspace and defaults are kept.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return logoutRun(f)
 		},
@@ -26,10 +29,20 @@ func logoutRun(f *cmdutil.Factory) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
 
-	if err := auth.ClearToken(); err != nil {
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	profile := cfg.Profile()
+
+	if err := auth.ClearProfileToken(profile); err != nil {
 		return fmt.Errorf("failed to clear credentials: %w", err)
 	}
 
-	fmt.Fprintf(ios.Out, "%s Logged out of ClickUp\n", cs.Green("!"))
+	if profile == config.DefaultProfile {
+		fmt.Fprintf(ios.Out, "%s Logged out of ClickUp\n", cs.Green("!"))
+	} else {
+		fmt.Fprintf(ios.Out, "%s Logged out of ClickUp profile %s\n", cs.Green("!"), cs.Bold(profile))
+	}
 	return nil
 }
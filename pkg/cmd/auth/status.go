@@ -1,10 +1,15 @@
 package auth
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/auth"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/text"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -27,35 +32,95 @@ func statusRun(f *cmdutil.Factory) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
 
-	// Retrieve and validate the stored token.
-	token, err := auth.GetToken()
+	cfg, err := f.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	profile := cfg.Profile()
+
+	// Retrieve and validate the token.
+	creds, source, err := auth.LookupCredentials(profile)
 	if err != nil {
 		return fmt.Errorf("not authenticated: %w", err)
 	}
 
-	user, err := auth.ValidateToken(token)
+	user, err := auth.ValidateToken(creds.Token)
+	if err != nil && creds.RefreshToken != "" {
+		// An expired OAuth token can be recovered with its refresh token.
+		if _, refreshErr := auth.RefreshProfileToken(context.Background(), profile, creds.Token); refreshErr == nil {
+			creds, _ = auth.LoadCredentials(profile)
+			user, err = auth.ValidateToken(creds.Token)
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("token validation failed: %w", err)
 	}
 
-	method := auth.GetAuthMethod()
-
-	cfg, err := f.Config()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	method := creds.AuthMethod
+	if method == "" {
+		method = "unknown"
 	}
 
-	workspace := cfg.Workspace
-	if workspace == "" {
-		workspace = "(none)"
+	workspace := "(none)"
+	if key, err := config.LookupKey("workspace"); err == nil {
+		dir, _ := os.Getwd()
+		if r := cfg.Resolve(key, dir); r.Value != "" {
+			workspace = r.Value + " " + cs.Gray("("+string(r.Source)+sourceDetail(r.Detail)+")")
+		}
 	}
 
 	fmt.Fprintf(ios.Out, "%s Logged in to ClickUp\n", cs.Green("!"))
+	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Profile:"), profile)
 	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Username:"), user.Username)
 	fmt.Fprintf(ios.Out, "  %-16s %d\n", cs.Bold("User ID:"), user.ID)
 	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Email:"), user.Email)
 	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Auth method:"), method)
+	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Token source:"), tokenSource(source))
+	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Token age:"), tokenAge(creds.CreatedAt))
+	if !creds.ExpiresAt.IsZero() {
+		fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Expires:"), tokenExpiry(creds))
+	}
 	fmt.Fprintf(ios.Out, "  %-16s %s\n", cs.Bold("Workspace:"), workspace)
 
 	return nil
 }
+
+// tokenSource describes where the token was read from.
+func tokenSource(source auth.CredentialSource) string {
+	switch source {
+	case auth.SourceEnv:
+		return "environment (" + auth.TokenEnv + ")"
+	case auth.SourceKeyring:
+		return "system keyring"
+	default:
+		return config.AuthFile()
+	}
+}
+
+func sourceDetail(detail string) string {
+	if detail == "" {
+		return ""
+	}
+	return " " + detail
+}
+
+// tokenAge describes how long ago a token was issued. Tokens stored before
+// the issue time was recorded report "unknown".
+func tokenAge(created time.Time) string {
+	if created.IsZero() {
+		return "unknown"
+	}
+	return fmt.Sprintf("issued %s (%s)", text.RelativeTime(created), created.Local().Format("2006-01-02"))
+}
+
+func tokenExpiry(creds config.ProfileAuth) string {
+	at := creds.ExpiresAt.Local().Format("2006-01-02 15:04")
+	switch {
+	case creds.RefreshToken != "":
+		return at + " (refreshed automatically)"
+	case auth.Expired(creds):
+		return at + " (expired, run 'clickup auth login')"
+	default:
+		return at
+	}
+}
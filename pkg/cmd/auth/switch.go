@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/prompter"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type switchOptions struct {
+	factory *cmdutil.Factory
+	name    string
+	dir     bool
+}
+
+// NewCmdSwitch returns the "auth switch" command.
+func NewCmdSwitch(f *cmdutil.Factory) *cobra.Command {
+	opts := &switchOptions{
+		factory: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "switch [<profile>]",
+		Short: "Switch the active auth profile",
+		Long: `Switch between named auth profiles.
+
+Each profile has its own token, workspace and space/folder/list defaults.
+Create one with 'clickup auth login --profile <name>'. The profile called
+"default" uses the top-level config settings.
+
+The profile in effect is chosen in this order: the --profile flag, the
+CLICKUP_PROFILE environment variable, the profile bound to the current
+directory, then the active profile.
+
+With --dir, the profile is bound to the current directory instead of
+becoming the active profile.`,
+		Example: `  # Pick a profile interactively
+  clickup auth switch
+
+  # Make the acme profile active
+  clickup auth switch acme
+
+  # Always use the acme profile in this directory
+  clickup auth switch acme --dir`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+			return switchRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.dir, "dir", false, "Bind the profile to the current directory")
+
+	return cmd
+}
+
+func switchRun(opts *switchOptions) error {
+	ios := opts.factory.IOStreams
+	cs := ios.ColorScheme()
+
+	cfg, err := opts.factory.Config()
+	if err != nil {
+		return err
+	}
+
+	name := opts.name
+	if name == "" {
+		names := cfg.ProfileNames()
+		options := make([]string, len(names))
+		for i, n := range names {
+			options[i] = n
+			if ws := cfg.ProfileSettings(n).Workspace; ws != "" {
+				options[i] = fmt.Sprintf("%s (workspace %s)", n, ws)
+			}
+		}
+		idx, err := prompter.New(ios).Select("Choose a profile:", options)
+		if err != nil {
+			return err
+		}
+		name = names[idx]
+	}
+
+	if !cfg.HasProfile(name) {
+		return fmt.Errorf("unknown profile %q. Run 'clickup auth login --profile %s' to create it", name, name)
+	}
+
+	if opts.dir {
+		dir, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		dc := cfg.DirectoryDefaults[dir]
+		dc.Profile = name
+		cfg.SetDirectoryDefault(dir, dc)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		fmt.Fprintf(ios.Out, "%s Profile for %s set to %s\n", cs.Green("!"), dir, cs.Bold(name))
+		return nil
+	}
+
+	cfg.ActiveProfile = name
+	if name == config.DefaultProfile {
+		cfg.ActiveProfile = ""
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Fprintf(ios.Out, "%s Switched to profile %s", cs.Green("!"), cs.Bold(name))
+	if ws := cfg.ProfileSettings(name).Workspace; ws != "" {
+		fmt.Fprintf(ios.Out, " (workspace %s)", ws)
+	}
+	fmt.Fprintln(ios.Out)
+
+	// Say so when the new active profile is shadowed here.
+	dir, _ := os.Getwd()
+	switch {
+	case opts.factory.Profile != "":
+	case os.Getenv("CLICKUP_PROFILE") != "":
+		fmt.Fprintf(ios.ErrOut, "Note: CLICKUP_PROFILE=%s overrides the active profile\n", os.Getenv("CLICKUP_PROFILE"))
+	case cfg.DirectoryDefaults[dir].Profile != "":
+		fmt.Fprintf(ios.ErrOut, "Note: %s is bound to profile %s\n", dir, cfg.DirectoryDefaults[dir].Profile)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+func newSwitchFactory(t *testing.T) (*testutil.TestFactory, *config.Config) {
+	t.Setenv("CLICKUP_CONFIG_DIR", t.TempDir())
+	t.Setenv("CLICKUP_PROFILE", "")
+	tf := testutil.NewTestFactory(t)
+	cfg := &config.Config{
+		Workspace: "12345",
+		Profiles:  map[string]config.Profile{"acme": {Workspace: "777"}},
+	}
+	tf.Factory.SetConfig(cfg)
+	return tf, cfg
+}
+
+func TestSwitch_SetsActiveProfile(t *testing.T) {
+	tf, cfg := newSwitchFactory(t)
+
+	err := testutil.RunCommand(t, NewCmdSwitch(tf.Factory), "acme")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", cfg.ActiveProfile)
+	assert.Contains(t, tf.OutBuf.String(), "Switched to profile acme (workspace 777)")
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "acme", loaded.ActiveProfile)
+
+	err = testutil.RunCommand(t, NewCmdSwitch(tf.Factory), "default")
+	require.NoError(t, err)
+	assert.Empty(t, cfg.ActiveProfile)
+}
+
+func TestSwitch_UnknownProfile(t *testing.T) {
+	tf, cfg := newSwitchFactory(t)
+
+	err := testutil.RunCommand(t, NewCmdSwitch(tf.Factory), "nope")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "clickup auth login --profile nope")
+	assert.Empty(t, cfg.ActiveProfile)
+}
+
+func TestSwitch_BindsDirectory(t *testing.T) {
+	tf, cfg := newSwitchFactory(t)
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	cfg.SetDirectoryDefault(dir, config.DirectoryConfig{Space: "s1"})
+
+	err = testutil.RunCommand(t, NewCmdSwitch(tf.Factory), "acme", "--dir")
+	require.NoError(t, err)
+	assert.Empty(t, cfg.ActiveProfile)
+	assert.Equal(t, config.DirectoryConfig{Space: "s1", Profile: "acme"}, cfg.DirectoryDefaults[dir])
+	assert.Equal(t, "acme", cfg.ProfileForDir(dir))
+}
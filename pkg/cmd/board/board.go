@@ -0,0 +1,497 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/browser"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/internal/tui"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+const (
+	// minColumnWidth is the narrowest a status column is drawn. Boards
+	// with more columns than fit side by side wrap onto further rows.
+	minColumnWidth = 20
+	columnGap      = 2
+)
+
+type boardOptions struct {
+	listID        string
+	sprint        bool
+	viewID        string
+	includeClosed bool
+	interactive   bool
+	jsonFlags     cmdutil.JSONFlags
+
+	// terminal is set by tests; nil opens the controlling terminal.
+	terminal tui.Terminal
+	openURL  func(string) error
+}
+
+// NewCmdBoard returns the "board" command.
+func NewCmdBoard(f *cmdutil.Factory) *cobra.Command {
+	opts := &boardOptions{openURL: browser.Open}
+
+	cmd := &cobra.Command{
+		Use:   "board",
+		Short: "Show a list, sprint or view as a kanban board",
+		Long: `Show tasks as a kanban board, one column per status.
+
+Columns follow the list's status order, and each header shows the number
+of tasks in the column and their total points. The board is fitted to the
+terminal width; when the columns do not fit side by side they wrap onto
+further rows.
+
+The board shows the list given with --list, the current sprint with
+--sprint, or a view with --view. Without any of them it shows the default
+list set with 'clickup list select'. Closed tasks are left out unless
+--include-closed is given.
+
+With --interactive the board is full-screen and cards can be moved
+between columns, which sets the task's status:
+  ←/→ or h/l    choose column     ↑/↓ or j/k    choose card
+  < or H        move card left    > or L        move card right
+  o             open in browser   r             reload
+  q             quit`,
+		Example: `  # Board of the default list
+  clickup board
+
+  # Board of the current sprint, including closed tasks
+  clickup board --sprint --include-closed
+
+  # Move cards between columns
+  clickup board --list 901234 --interactive
+
+  # Columns and cards as JSON
+  clickup board --view 3v-abc123 --json`,
+		Args:    cobra.NoArgs,
+		PreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.interactive && opts.jsonFlags.WantsJSON() {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--interactive cannot be used with --json")}
+			}
+			return boardRun(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listID, "list", "", "List ID")
+	cmd.Flags().BoolVar(&opts.sprint, "sprint", false, "Show the current sprint")
+	cmd.Flags().StringVar(&opts.viewID, "view", "", "View ID")
+	cmd.Flags().BoolVarP(&opts.includeClosed, "include-closed", "c", false, "Include closed tasks")
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Full-screen board where cards can be moved")
+	cmd.MarkFlagsMutuallyExclusive("list", "sprint", "view")
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+// board is a set of tasks grouped into status columns.
+type board struct {
+	name    string
+	columns []*column
+}
+
+type column struct {
+	status string
+	// hidden is set for closed statuses when closed tasks were not loaded,
+	// so the column's count says nothing.
+	hidden bool
+	tasks  []clickup.Task
+}
+
+func (c *column) points() float64 {
+	var sum float64
+	for _, t := range c.tasks {
+		sum += taskPoints(t)
+	}
+	return sum
+}
+
+func taskPoints(t clickup.Task) float64 {
+	p, _ := strconv.ParseFloat(t.Points.Value.String(), 64)
+	return p
+}
+
+// boardStatus is a status column to create, in order.
+type boardStatus struct {
+	name       string
+	statusType string
+}
+
+func boardRun(f *cmdutil.Factory, opts *boardOptions) error {
+	ios := f.IOStreams
+
+	in, inOK := ios.In.(*os.File)
+	out, outOK := ios.Out.(*os.File)
+	if opts.interactive && opts.terminal == nil && (!ios.IsTerminal() || !inOK || !outOK) {
+		return fmt.Errorf("--interactive needs an interactive terminal")
+	}
+
+	load, err := boardLoader(f, opts)
+	if err != nil {
+		return err
+	}
+	b, err := load()
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonFlags.WantsJSON() {
+		return opts.jsonFlags.OutputJSON(ios.Out, boardJSON(b))
+	}
+	if !opts.interactive {
+		renderBoard(ios.Out, ios.ColorScheme(), b, ios.TerminalWidth())
+		return nil
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	m := &boardModel{
+		ctx:     context.Background(),
+		client:  client,
+		cs:      ios.ColorScheme(),
+		board:   b,
+		load:    load,
+		openURL: opts.openURL,
+	}
+	term := opts.terminal
+	if term == nil {
+		tty, err := tui.Open(in, out)
+		if err != nil {
+			return err
+		}
+		defer tty.Close()
+		term = tty
+	}
+	return tui.Run(term, m)
+}
+
+// boardLoader resolves the board's source from opts and returns a
+// function that fetches it, so that the interactive board can reload.
+func boardLoader(f *cmdutil.Factory, opts *boardOptions) (func() (*board, error), error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	client, err := f.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+
+	if opts.viewID != "" {
+		return func() (*board, error) { return loadView(ctx, client, opts.viewID) }, nil
+	}
+
+	listID := opts.listID
+	if opts.sprint {
+		if cfg.SprintFolder == "" {
+			return nil, fmt.Errorf("no sprint folder configured. Run 'clickup sprint current' first or use --list")
+		}
+		listID, err = cmdutil.ResolveCurrentSprintListID(ctx, client, cfg.SprintFolder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve current sprint: %w", err)
+		}
+		if listID == "" {
+			return nil, fmt.Errorf("no active sprint found in folder %s. Use --list to specify a list", cfg.SprintFolder)
+		}
+	}
+	if listID == "" {
+		listID = cfg.List
+	}
+	if listID == "" {
+		return nil, fmt.Errorf("no list specified. Use --list, --sprint or --view, or run 'clickup list select' to set a default")
+	}
+	return func() (*board, error) { return loadList(ctx, client, listID, opts.includeClosed) }, nil
+}
+
+func loadList(ctx context.Context, client *api.Client, listID string, includeClosed bool) (*board, error) {
+	list, err := apiv2.GetListLocal(ctx, client, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch list: %w", err)
+	}
+
+	var statuses []boardStatus
+	for _, s := range list.Statuses {
+		statuses = append(statuses, boardStatus{s.Status, s.Type})
+	}
+	if len(statuses) == 0 && list.Space.ID != "" {
+		space, err := apiv2.GetSpaceLocal(ctx, client, list.Space.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch space statuses: %w", err)
+		}
+		for _, s := range space.Statuses {
+			statuses = append(statuses, boardStatus{s.Status, s.Type})
+		}
+	}
+
+	q := url.Values{}
+	if includeClosed {
+		q.Set("include_closed", "true")
+	}
+	tasks, err := pager.Collect(pager.Pages(ctx, pager.Options{},
+		apiv2.TaskPages[clickup.Task](client, "list/"+listID+"/task?"+q.Encode())))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	b := group(list.Name, statuses, tasks)
+	if !includeClosed {
+		for _, c := range b.columns {
+			for _, s := range statuses {
+				if s.statusType == "closed" && strings.EqualFold(s.name, c.status) && len(c.tasks) == 0 {
+					c.hidden = true
+				}
+			}
+		}
+	}
+	return b, nil
+}
+
+func loadView(ctx context.Context, client *api.Client, viewID string) (*board, error) {
+	tasks, err := pager.Collect(pager.Pages(ctx, pager.Options{},
+		apiv2.TaskPages[clickup.Task](client, "view/"+viewID+"/task")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch view tasks: %w", err)
+	}
+
+	name := "View " + viewID
+	var resp struct {
+		View struct {
+			Name string `json:"name"`
+		} `json:"view"`
+	}
+	if err := apiv2.Do(ctx, client, "GET", "view/"+viewID, nil, &resp); err == nil && resp.View.Name != "" {
+		name = resp.View.Name
+	}
+
+	// A view can span lists, so take the statuses from its tasks.
+	sorted := slices.Clone(tasks)
+	slices.SortStableFunc(sorted, func(a, b clickup.Task) int {
+		x, _ := a.Status.Orderindex.Int64()
+		y, _ := b.Status.Orderindex.Int64()
+		return int(x - y)
+	})
+	var statuses []boardStatus
+	for _, t := range sorted {
+		statuses = append(statuses, boardStatus{t.Status.Status, t.Status.Type})
+	}
+	return group(name, statuses, tasks), nil
+}
+
+// group sorts tasks into a column per status, in the order given. Tasks in
+// a status not listed get a column of their own at the end.
+func group(name string, statuses []boardStatus, tasks []clickup.Task) *board {
+	b := &board{name: name}
+	find := func(status string) *column {
+		for _, c := range b.columns {
+			if strings.EqualFold(c.status, status) {
+				return c
+			}
+		}
+		return nil
+	}
+	for _, s := range statuses {
+		if find(s.name) == nil {
+			b.columns = append(b.columns, &column{status: s.name})
+		}
+	}
+	for _, t := range tasks {
+		c := find(t.Status.Status)
+		if c == nil {
+			c = &column{status: t.Status.Status}
+			b.columns = append(b.columns, c)
+		}
+		c.tasks = append(c.tasks, t)
+	}
+	return b
+}
+
+// renderBoard writes the board fitted to width.
+func renderBoard(w io.Writer, cs *iostreams.ColorScheme, b *board, width int) {
+	fmt.Fprintf(w, "%s  %s\n", cs.Bold(b.name), cs.Gray(b.summary()))
+
+	perRow := columnsPerRow(width, len(b.columns))
+	colWidth := columnWidth(width, perRow)
+	for start := 0; start < len(b.columns); start += perRow {
+		var blocks [][]string
+		for _, c := range b.columns[start:min(start+perRow, len(b.columns))] {
+			blocks = append(blocks, columnLines(cs, c, colWidth, -1, 0, -1))
+		}
+		fmt.Fprintln(w)
+		for _, line := range joinColumns(blocks, colWidth) {
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+// summary is the board's task and point totals, e.g. "12 tasks · 21 pts".
+func (b *board) summary() string {
+	n, pts := 0, 0.0
+	for _, c := range b.columns {
+		n += len(c.tasks)
+		pts += c.points()
+	}
+	s := text.Pluralize(n, "task")
+	if pts > 0 {
+		s += " · " + formatPoints(pts) + " pts"
+	}
+	return s
+}
+
+func columnsPerRow(width, n int) int {
+	return max(1, min(n, (width+columnGap)/(minColumnWidth+columnGap)))
+}
+
+func columnWidth(width, perRow int) int {
+	return max(minColumnWidth, (width-columnGap*(perRow-1))/perRow)
+}
+
+// columnLines renders a column: its header, a rule, and two lines per
+// card. Cards from offset are drawn, at most maxCards of them (all if
+// negative), with a marker on the selected one.
+func columnLines(cs *iostreams.ColorScheme, c *column, width, selected, offset, maxCards int) []string {
+	header := cs.StatusColor(strings.ToLower(c.status))(strings.ToUpper(c.status))
+	switch {
+	case c.hidden:
+		header += " " + cs.Gray("closed hidden")
+	case c.points() > 0:
+		header += " " + cs.Gray(fmt.Sprintf("%d · %s pts", len(c.tasks), formatPoints(c.points())))
+	default:
+		header += " " + cs.Gray(strconv.Itoa(len(c.tasks)))
+	}
+	lines := []string{tui.Truncate(header, width), cs.Gray(strings.Repeat("─", width))}
+
+	end := len(c.tasks)
+	if maxCards >= 0 {
+		end = min(end, offset+maxCards)
+	}
+	if offset > 0 {
+		lines = append(lines, cs.Gray(fmt.Sprintf("↑ %d more", offset)))
+	}
+	for i := offset; i < end; i++ {
+		t := c.tasks[i]
+		name := t.Name
+		if i == selected {
+			name = cs.Cyan("> ") + cs.Bold(name)
+		}
+		lines = append(lines, fit(name, width), cs.Gray(fit(cardMeta(t), width)))
+	}
+	if end < len(c.tasks) {
+		lines = append(lines, cs.Gray(fmt.Sprintf("↓ %d more", len(c.tasks)-end)))
+	}
+	return lines
+}
+
+// cardMeta is the line under a card's name: ID, assignees and points.
+func cardMeta(t clickup.Task) string {
+	parts := []string{"#" + taskID(t)}
+	for _, a := range t.Assignees {
+		parts = append(parts, "@"+a.Username)
+	}
+	if p := taskPoints(t); p > 0 {
+		parts = append(parts, formatPoints(p)+"pt")
+	}
+	return strings.Join(parts, " ")
+}
+
+func taskID(t clickup.Task) string {
+	if t.CustomID != "" {
+		return t.CustomID
+	}
+	return t.ID
+}
+
+func formatPoints(p float64) string {
+	return strconv.FormatFloat(p, 'f', -1, 64)
+}
+
+// fit truncates s to width, marking the cut with an ellipsis.
+func fit(s string, width int) string {
+	if tui.Width(s) <= width {
+		return s
+	}
+	return tui.Truncate(s, width-1) + "…"
+}
+
+// joinColumns lays blocks of lines side by side.
+func joinColumns(blocks [][]string, width int) []string {
+	rows := 0
+	for _, b := range blocks {
+		rows = max(rows, len(b))
+	}
+	lines := make([]string, rows)
+	for i := range lines {
+		var sb strings.Builder
+		for j, b := range blocks {
+			cell := ""
+			if i < len(b) {
+				cell = b[i]
+			}
+			if j < len(blocks)-1 {
+				cell = tui.Pad(cell, width) + strings.Repeat(" ", columnGap)
+			}
+			sb.WriteString(cell)
+		}
+		lines[i] = strings.TrimRight(sb.String(), " ")
+	}
+	return lines
+}
+
+type boardJSONColumn struct {
+	Status string          `json:"status"`
+	Count  int             `json:"count"`
+	Points float64         `json:"points"`
+	Tasks  []boardJSONCard `json:"tasks"`
+}
+
+type boardJSONCard struct {
+	ID        string   `json:"id"`
+	CustomID  string   `json:"custom_id,omitempty"`
+	Name      string   `json:"name"`
+	Assignees []string `json:"assignees"`
+	Points    float64  `json:"points,omitempty"`
+	URL       string   `json:"url"`
+}
+
+func boardJSON(b *board) any {
+	columns := make([]boardJSONColumn, 0, len(b.columns))
+	for _, c := range b.columns {
+		jc := boardJSONColumn{Status: c.status, Count: len(c.tasks), Points: c.points(), Tasks: []boardJSONCard{}}
+		for _, t := range c.tasks {
+			assignees := []string{}
+			for _, a := range t.Assignees {
+				assignees = append(assignees, a.Username)
+			}
+			jc.Tasks = append(jc.Tasks, boardJSONCard{
+				ID:        t.ID,
+				CustomID:  t.CustomID,
+				Name:      t.Name,
+				Assignees: assignees,
+				Points:    taskPoints(t),
+				URL:       t.URL,
+			})
+		}
+		columns = append(columns, jc)
+	}
+	return struct {
+		Name    string            `json:"name"`
+		Columns []boardJSONColumn `json:"columns"`
+	}{b.name, columns}
+}
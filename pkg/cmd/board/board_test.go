@@ -0,0 +1,140 @@
+package board
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/internal/tui"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func seedBoard(t *testing.T, tf *testutil.TestFactory) (*fakeclickup.Server, fakeclickup.List) {
+	t.Helper()
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login", Points: "3"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Write docs", Points: "2"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Ship the release notes for the spring update", Status: fakeclickup.Status{Status: "in progress"}})
+	return fake, list
+}
+
+func TestBoard_ColumnsSideBySide(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	_, list := seedBoard(t, tf)
+
+	cmd := NewCmdBoard(tf.Factory)
+	require.NoError(t, testutil.RunCommand(t, cmd, "--list", list.ID))
+
+	out := tf.OutBuf.String()
+	lines := strings.Split(out, "\n")
+	assert.Equal(t, "Backlog  3 tasks · 5 pts", lines[0])
+	assert.Regexp(t, `^TO DO 2 · 5 pts\s+IN PROGRESS 1\s+COMPLETE closed hidden$`, lines[2])
+	assert.Regexp(t, `^Fix login\s+Ship the release notes f…$`, lines[4], "cards are fitted to the column")
+	assert.Regexp(t, `^#\w+ 3pt\s+#\w+$`, lines[5])
+	assert.Contains(t, lines[6], "Write docs")
+	for _, l := range lines {
+		assert.LessOrEqual(t, tui.Width(l), 80, "fits the terminal width")
+	}
+}
+
+func TestBoard_WrapsColumnsThatDoNotFit(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Pipeline"})
+	for _, s := range []string{"review", "qa", "staging"} {
+		fake.AddTask(list.ID, fakeclickup.Task{Name: "In " + s, Status: fakeclickup.Status{Status: s}})
+	}
+
+	cmd := NewCmdBoard(tf.Factory)
+	require.NoError(t, testutil.RunCommand(t, cmd, "--list", list.ID, "--include-closed"))
+
+	out := tf.OutBuf.String()
+	assert.Regexp(t, `(?m)^TO DO 0\s+IN PROGRESS 0\s+COMPLETE 0$`, out)
+	assert.Regexp(t, `(?m)^REVIEW 1\s+QA 1\s+STAGING 1$`, out, "statuses outside the list's come last")
+}
+
+func TestBoard_JSON(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	_, list := seedBoard(t, tf)
+
+	cmd := NewCmdBoard(tf.Factory)
+	require.NoError(t, testutil.RunCommand(t, cmd, "--list", list.ID, "--json"))
+
+	var got struct {
+		Name    string `json:"name"`
+		Columns []struct {
+			Status string  `json:"status"`
+			Count  int     `json:"count"`
+			Points float64 `json:"points"`
+			Tasks  []struct {
+				Name string `json:"name"`
+			} `json:"tasks"`
+		} `json:"columns"`
+	}
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &got))
+	assert.Equal(t, "Backlog", got.Name)
+	require.Len(t, got.Columns, 3)
+	assert.Equal(t, "to do", got.Columns[0].Status)
+	assert.Equal(t, 2, got.Columns[0].Count)
+	assert.Equal(t, 5.0, got.Columns[0].Points)
+	assert.Equal(t, "Fix login", got.Columns[0].Tasks[0].Name)
+	assert.Empty(t, got.Columns[2].Tasks)
+}
+
+func TestBoard_View(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Handle("GET", "view/3v-1", 200, `{"view": {"id": "3v-1", "name": "Team board"}}`)
+	tf.Handle("GET", "view/3v-1/task", 200, `{"last_page": true, "tasks": [
+		{"id": "a", "name": "Deploy", "status": {"status": "done", "orderindex": 2}},
+		{"id": "b", "name": "Plan", "status": {"status": "open", "orderindex": 0}}
+	]}`)
+
+	cmd := NewCmdBoard(tf.Factory)
+	require.NoError(t, testutil.RunCommand(t, cmd, "--view", "3v-1"))
+
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "Team board  2 tasks")
+	assert.Regexp(t, `(?m)^OPEN 1\s+DONE 1$`, out)
+}
+
+func TestBoard_NoList(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	cmd := NewCmdBoard(tf.Factory)
+	err := testutil.RunCommand(t, cmd)
+	assert.ErrorContains(t, err, "no list specified")
+
+	err = testutil.RunCommand(t, NewCmdBoard(tf.Factory), "--sprint")
+	assert.ErrorContains(t, err, "no sprint folder configured")
+}
+
+func TestBoard_InteractiveMoveCard(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake, list := seedBoard(t, tf)
+
+	// Select "Write docs", move it right twice, and open it.
+	term := tui.NewHeadless(90, 16, tui.Keys("j>Lo")...)
+	var opened []string
+	opts := &boardOptions{listID: list.ID, interactive: true, terminal: term, openURL: func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}}
+	require.NoError(t, boardRun(tf.Factory, opts))
+
+	var docs fakeclickup.Task
+	for _, task := range fake.Tasks() {
+		if task.Name == "Write docs" {
+			docs = task
+		}
+	}
+	assert.Equal(t, "complete", docs.Status.Status)
+	assert.Equal(t, []string{docs.URL}, opened)
+
+	screen := term.Screen()
+	assert.Regexp(t, `(?m)^TO DO 1 · 3 pts\s+IN PROGRESS 1\s+COMPLETE 1 · 2 pts$`, screen)
+	assert.Contains(t, screen, "> Write docs")
+	assert.Contains(t, screen, "! Opened "+docs.URL)
+}
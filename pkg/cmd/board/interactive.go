@@ -0,0 +1,173 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/tui"
+)
+
+// boardModel is the interactive board. It implements tui.Model.
+type boardModel struct {
+	ctx     context.Context
+	client  *api.Client
+	cs      *iostreams.ColorScheme
+	board   *board
+	load    func() (*board, error)
+	openURL func(string) error
+
+	col, row int
+	// offsets is the first card drawn in each column.
+	offsets []int
+	message string
+	failed  bool
+}
+
+// Update implements tui.Model.
+func (m *boardModel) Update(k tui.Key) bool {
+	m.message, m.failed = "", false
+	switch {
+	case k.Code == tui.KeyCtrlC || k.Is('q'):
+		return true
+	case k.Is('r'):
+		m.reload()
+	case len(m.board.columns) == 0:
+		// Nothing to select.
+	case k.Code == tui.KeyLeft || k.Is('h'):
+		m.selectColumn(m.col - 1)
+	case k.Code == tui.KeyRight || k.Is('l'):
+		m.selectColumn(m.col + 1)
+	case k.Code == tui.KeyUp || k.Is('k'):
+		m.row = max(0, m.row-1)
+	case k.Code == tui.KeyDown || k.Is('j'):
+		m.row = min(m.row+1, max(0, len(m.column().tasks)-1))
+	case k.Is('<') || k.Is('H'):
+		m.moveCard(-1)
+	case k.Is('>') || k.Is('L'):
+		m.moveCard(1)
+	case k.Is('o'):
+		if c := m.column(); m.row < len(c.tasks) {
+			m.report("Opened "+c.tasks[m.row].URL, m.openURL(c.tasks[m.row].URL))
+		}
+	}
+	return false
+}
+
+func (m *boardModel) reload() {
+	b, err := m.load()
+	if err != nil {
+		m.report("", err)
+		return
+	}
+	m.board = b
+	m.col = max(0, min(m.col, len(b.columns)-1))
+	if len(b.columns) > 0 {
+		m.selectColumn(m.col)
+	}
+}
+
+func (m *boardModel) column() *column {
+	return m.board.columns[m.col]
+}
+
+func (m *boardModel) selectColumn(i int) {
+	if i < 0 || i >= len(m.board.columns) {
+		return
+	}
+	m.col = i
+	m.row = min(m.row, max(0, len(m.column().tasks)-1))
+}
+
+// moveCard moves the selected card to the next column in direction dir,
+// setting the task's status to that column's.
+func (m *boardModel) moveCard(dir int) {
+	from := m.column()
+	to := m.col + dir
+	if m.row >= len(from.tasks) || to < 0 || to >= len(m.board.columns) {
+		return
+	}
+	target := m.board.columns[to]
+	t := from.tasks[m.row]
+
+	req := map[string]string{"status": target.status}
+	if _, err := apiv2.UpdateTaskLocal(m.ctx, m.client, t.ID, req, ""); err != nil {
+		m.report("", fmt.Errorf("failed to move %s: %w", taskID(t), err))
+		return
+	}
+
+	t.Status.Status = target.status
+	from.tasks = append(from.tasks[:m.row], from.tasks[m.row+1:]...)
+	target.tasks = append(target.tasks, t)
+	target.hidden = false
+	m.col, m.row = to, len(target.tasks)-1
+	m.report(fmt.Sprintf("Moved %s to %s", taskID(t), target.status), nil)
+}
+
+func (m *boardModel) report(msg string, err error) {
+	if err != nil {
+		m.message, m.failed = err.Error(), true
+		return
+	}
+	m.message = msg
+}
+
+// View implements tui.Model.
+func (m *boardModel) View(width, height int) []string {
+	b := m.board
+	lines := []string{m.cs.Bold(b.name) + "  " + m.cs.Gray(b.summary())}
+	if len(b.columns) == 0 {
+		return append(lines, "", m.cs.Gray("No statuses to show."))
+	}
+
+	// Show the run of columns that fits and holds the selected one.
+	perRow := columnsPerRow(width, len(b.columns))
+	colWidth := columnWidth(width, perRow)
+	first := max(0, min(m.col-perRow/2, len(b.columns)-perRow))
+
+	// Two lines per card, below the header and rule, leaving room for the
+	// title, the scroll markers and the two status lines.
+	maxCards := max(1, (height-7)/2)
+	for len(m.offsets) < len(b.columns) {
+		m.offsets = append(m.offsets, 0)
+	}
+
+	var blocks [][]string
+	for i := first; i < first+perRow; i++ {
+		c := b.columns[i]
+		selected := -1
+		if i == m.col {
+			selected = m.row
+			if m.row < m.offsets[i] {
+				m.offsets[i] = m.row
+			}
+			if m.row >= m.offsets[i]+maxCards {
+				m.offsets[i] = m.row - maxCards + 1
+			}
+		}
+		m.offsets[i] = min(m.offsets[i], max(0, len(c.tasks)-maxCards))
+		blocks = append(blocks, columnLines(m.cs, c, colWidth, selected, m.offsets[i], maxCards))
+	}
+	lines = append(lines, "")
+	lines = append(lines, joinColumns(blocks, colWidth)...)
+
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+	lines = lines[:max(1, height-2)]
+
+	var status string
+	switch {
+	case m.message != "" && m.failed:
+		status = m.cs.Red("✗ " + m.message)
+	case m.message != "":
+		status = m.cs.Green("!") + " " + m.message
+	case first > 0 || first+perRow < len(b.columns):
+		status = m.cs.Gray(fmt.Sprintf("columns %d-%d of %d", first+1, first+perRow, len(b.columns)))
+	}
+	help := strings.Join([]string{"←→ column", "↑↓ card", "< > move card", "o open", "r reload", "q quit"}, "  ")
+	return append(lines, status, m.cs.Gray(help))
+}
@@ -246,6 +246,29 @@ func TestChatMessages_JSONOutput(t *testing.T) {
 	assert.Equal(t, "Hello", data[0].(map[string]interface{})["content"])
 }
 
+func TestChatMessages_LimitFollowsCursors(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+
+	var cursors []string
+	tf.HandleFuncV3("workspaces/12345/chat/channels/chan-abc/messages", func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		w.Header().Set("Content-Type", "application/json")
+		if cursor == "" {
+			w.Write([]byte(`{"next_cursor": "c2", "data": [{"id": "msg1"}, {"id": "msg2"}]}`))
+			return
+		}
+		w.Write([]byte(`{"next_cursor": "c3", "data": [{"id": "msg3"}, {"id": "msg4"}]}`))
+	})
+
+	cmd := NewCmdMessages(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "chan-abc", "--limit", "3", "--jq", ".data[].id", "--raw")
+	require.NoError(t, err)
+
+	assert.Equal(t, "msg1\nmsg2\nmsg3\n", tf.OutBuf.String())
+	assert.Equal(t, []string{"", "c2"}, cursors)
+}
+
 func TestChatMessages_RequiresChannelArg(t *testing.T) {
 	cmd := NewCmdMessages(nil)
 	assert.Error(t, cmd.Args(cmd, []string{}))
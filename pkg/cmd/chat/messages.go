@@ -2,10 +2,13 @@ package chat
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv3"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
 	"github.com/triptechtravel/clickup-cli/internal/text"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
@@ -13,6 +16,7 @@ import (
 
 type messagesOptions struct {
 	channelID string
+	pageFlags cmdutil.PageFlags
 	jsonFlags cmdutil.JSONFlags
 }
 
@@ -23,12 +27,19 @@ func NewCmdMessages(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "messages <channel-id>",
 		Short: "List messages in a Chat channel",
-		Long:  "List messages in a ClickUp Chat channel.",
+		Long: `List messages in a ClickUp Chat channel.
+
+A single page of messages is fetched by default. --limit follows cursors
+until that many messages are found, and --all follows them to the end.
+With --format ndjson, rows are written as each page arrives.`,
 		Example: `  # List messages in a channel
   clickup chat messages abc123
 
   # List messages as JSON
-  clickup chat messages abc123 --json`,
+  clickup chat messages abc123 --json
+
+  # Fetch up to 500 messages
+  clickup chat messages abc123 --limit 500`,
 		Args:              cobra.ExactArgs(1),
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -37,11 +48,27 @@ func NewCmdMessages(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
+	cmdutil.AddPageFlags(cmd, &opts.pageFlags, "messages")
 	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
 
 	return cmd
 }
 
+// messagesPage is a page of a channel's messages. Messages are kept as
+// returned by the API so --json shows every field.
+type messagesPage struct {
+	Data       []json.RawMessage `json:"data"`
+	NextCursor *string           `json:"next_cursor"`
+}
+
+// messageSummary holds the fields of a message shown in the table.
+type messageSummary struct {
+	ID      string  `json:"id"`
+	UserID  string  `json:"user_id"`
+	Content string  `json:"content"`
+	Date    float32 `json:"date"`
+}
+
 func runMessages(f *cmdutil.Factory, opts *messagesOptions) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
@@ -59,27 +86,86 @@ func runMessages(f *cmdutil.Factory, opts *messagesOptions) error {
 		return err
 	}
 
-	result, err := apiv3.ListChatMessages(context.Background(), client, cfg.Workspace, opts.channelID)
+	var nextCursor *string
+	fetch := func(ctx context.Context, cursor string) ([]json.RawMessage, string, error) {
+		q := url.Values{}
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		// TODO: swap to generated wrapper — ListChatMessages decodes
+		// messages into the generated type, and the raw messages are
+		// needed for --json.
+		var page messagesPage
+		path := fmt.Sprintf("workspaces/%s/chat/channels/%s/messages", cfg.Workspace, opts.channelID)
+		if len(q) > 0 {
+			path += "?" + q.Encode()
+		}
+		if err := apiv3.Do(ctx, client, "GET", path, nil, &page); err != nil {
+			return nil, "", err
+		}
+		nextCursor = page.NextCursor
+		if page.NextCursor == nil {
+			return page.Data, "", nil
+		}
+		return page.Data, *page.NextCursor, nil
+	}
+	seq := pager.Cursor(context.Background(), opts.pageFlags.Options(), fetch)
+
+	if !opts.jsonFlags.WantsJSON() && f.Streams(false) {
+		n, err := cmdutil.StreamTable(f, seq, func(raw json.RawMessage) (*tableprinter.Table, error) {
+			tbl := messageTable()
+			return tbl, addMessageRow(tbl, raw)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list messages: %w", err)
+		}
+		if n == 0 {
+			fmt.Fprintln(ios.ErrOut, "No messages found.")
+		}
+		return nil
+	}
+
+	messages, err := pager.Collect(seq)
 	if err != nil {
 		return fmt.Errorf("failed to list messages: %w", err)
 	}
 
 	if opts.jsonFlags.WantsJSON() {
-		return opts.jsonFlags.OutputJSON(ios.Out, result)
+		if messages == nil {
+			messages = []json.RawMessage{}
+		}
+		return opts.jsonFlags.OutputJSON(ios.Out, messagesPage{Data: messages, NextCursor: nextCursor})
 	}
 
-	if len(result.Data) == 0 {
+	if len(messages) == 0 {
 		fmt.Fprintln(ios.Out, cs.Gray("No messages found."))
 		return nil
 	}
 
-	tp := tableprinter.New(ios)
-	for _, msg := range result.Data {
-		tp.AddField(msg.ID)
-		tp.AddField(msg.UserID)
-		tp.AddField(text.Truncate(msg.Content, 60))
-		tp.AddField(text.FormatUnixMillisFloat(msg.Date))
-		tp.EndRow()
+	tbl := messageTable()
+	for _, raw := range messages {
+		if err := addMessageRow(tbl, raw); err != nil {
+			return err
+		}
+	}
+	return f.PrintTable(tbl)
+}
+
+func messageTable() *tableprinter.Table {
+	return tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "USER"},
+		tableprinter.Column{Header: "CONTENT"},
+		tableprinter.Column{Header: "DATE"},
+	)
+}
+
+// addMessageRow adds a message, as returned by the API, to tbl.
+func addMessageRow(tbl *tableprinter.Table, raw json.RawMessage) error {
+	var msg messageSummary
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return fmt.Errorf("failed to parse message: %w", err)
 	}
-	return tp.Render()
+	tbl.AddRow(msg.ID, msg.UserID, text.Truncate(msg.Content, 60), text.FormatUnixMillisFloat(msg.Date))
+	return nil
 }
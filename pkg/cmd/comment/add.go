@@ -9,7 +9,6 @@ import (
 	"github.com/triptechtravel/clickup-cli/api/clickupv2"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/git"
-	"github.com/triptechtravel/clickup-cli/internal/prompter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -114,7 +113,7 @@ func addRun(opts *addOptions) error {
 	// Resolve comment body.
 	body := opts.body
 	if body == "" || opts.editor {
-		p := prompter.New(ios)
+		p := opts.factory.Prompter()
 		var err error
 		body, err = p.Editor("Comment body", body, "*.md")
 		if err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/api/clickupv2"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
-	"github.com/triptechtravel/clickup-cli/internal/prompter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -79,7 +78,7 @@ func editRun(opts *editOptions) error {
 	// Resolve comment body.
 	body := opts.body
 	if body == "" || opts.editor {
-		p := prompter.New(ios)
+		p := opts.factory.Prompter()
 		var err error
 		body, err = p.Editor("Comment body", body, "*.md")
 		if err != nil {
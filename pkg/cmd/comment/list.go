@@ -129,6 +129,12 @@ func listRun(opts *listOptions) error {
 		return nil
 	}
 
+	// Other formats get one flat row per comment with the full text; the
+	// terminal view below nests replies and shortens long comments.
+	if opts.factory.WantsFormat() {
+		return opts.factory.PrintTable(commentTable(result.Comments))
+	}
+
 	tp := tableprinter.New(ios)
 	tp.SetTruncateColumn(2)
 
@@ -190,3 +196,32 @@ func formatCommentDate(dateStr string) string {
 	t := time.UnixMilli(ms)
 	return text.RelativeTime(t)
 }
+
+// commentTable builds one row per comment and reply. Replies name their
+// parent comment.
+func commentTable(comments []commentData) *tableprinter.Table {
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "USER"},
+		tableprinter.Column{Header: "DATE"},
+		tableprinter.Column{Header: "COMMENT"},
+		tableprinter.Column{Header: "PARENT"},
+	)
+	for _, c := range comments {
+		tbl.AddRow(c.ID, c.User.Username, formatCommentTimestamp(c.Date), c.CommentText)
+		for _, r := range c.Replies {
+			tbl.AddRow(r.ID, r.User.Username, formatCommentTimestamp(r.Date), r.CommentText, c.ID)
+		}
+	}
+	return tbl
+}
+
+// formatCommentTimestamp renders a millisecond timestamp as a local date
+// and time.
+func formatCommentTimestamp(dateStr string) string {
+	ms, err := strconv.ParseInt(dateStr, 10, 64)
+	if err != nil {
+		return dateStr
+	}
+	return time.UnixMilli(ms).Local().Format("2006-01-02 15:04")
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/api/clickupv2"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
-	"github.com/triptechtravel/clickup-cli/internal/prompter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -81,7 +80,7 @@ func replyRun(opts *replyOptions) error {
 	// Resolve reply body.
 	body := opts.body
 	if body == "" || opts.editor {
-		p := prompter.New(ios)
+		p := opts.factory.Prompter()
 		var err error
 		body, err = p.Editor("Reply body", body, "*.md")
 		if err != nil {
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdConfig returns the "config" parent command.
+func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <command>",
+		Short: "Read and write CLI settings",
+		Long: `Read and write settings in config.yml.
+
+Values are resolved in this order: environment variables, the current
+directory's defaults, the active profile or global config, then built-in
+defaults. 'config get' and 'config list' show where each value came from.`,
+	}
+
+	cmd.AddCommand(NewCmdGet(f))
+	cmd.AddCommand(NewCmdSet(f))
+	cmd.AddCommand(NewCmdList(f))
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func newConfigFactory(t *testing.T) (*testutil.TestFactory, *config.Config) {
+	t.Setenv("CLICKUP_CONFIG_DIR", t.TempDir())
+	tf := testutil.NewTestFactory(t)
+	cfg := &config.Config{Workspace: "12345", Space: "67890"}
+	tf.Factory.SetConfig(cfg)
+	return tf, cfg
+}
+
+func TestConfigSet_Enum(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+
+	err := testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "prompt", "off")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be one of enabled, disabled")
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "prompt", "disabled"))
+	assert.Equal(t, "disabled", cfg.Prompt)
+	assert.Contains(t, tf.OutBuf.String(), "Set prompt to disabled globally")
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "disabled", loaded.Prompt)
+}
+
+func TestConfigSet_ValidatesIDs(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+
+	err := testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "list", "999")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not find list 999")
+
+	err = testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "workspace", "999")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "available workspaces: 12345")
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "list", list.ID))
+	assert.Equal(t, list.ID, cfg.List)
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "folder", "999", "--no-validate"))
+	assert.Equal(t, "999", cfg.Folder)
+}
+
+func TestConfigSet_OfflineWarns(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+	t.Setenv("CLICKUP_RETRY_MAX_ATTEMPTS", "1")
+	tf.Server.Close()
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "space", "555"))
+	assert.Equal(t, "555", cfg.Space)
+	assert.Contains(t, tf.ErrBuf.String(), "could not verify space 555")
+}
+
+func TestConfigSet_Directory(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "space", "777", "--dir", "--no-validate"))
+	assert.Equal(t, "777", cfg.DirectoryDefaults[dir].Space)
+	assert.Equal(t, "67890", cfg.Space)
+
+	err = testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "prompt", "disabled", "--dir")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be set per directory")
+}
+
+func TestConfigGet_Source(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	cfg.SetDirectoryDefault(dir, config.DirectoryConfig{Space: "777"})
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "get", "space", "--json"))
+	var r config.Resolved
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &r))
+	assert.Equal(t, "777", r.Value)
+	assert.Equal(t, config.SourceDirectory, r.Source)
+
+	tf.OutBuf.Reset()
+	t.Setenv("CLICKUP_RETRY_MAX_DELAY", "2s")
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "get", "retry.max_delay"))
+	assert.Equal(t, "2s\n", tf.OutBuf.String())
+
+	err = testutil.RunCommand(t, NewCmdConfig(tf.Factory), "get", "colour")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown config key")
+}
+
+func TestConfigList(t *testing.T) {
+	tf, _ := newConfigFactory(t)
+	t.Setenv("CLICKUP_RETRY_MAX_DELAY", "")
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "list"))
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "workspace")
+	assert.Contains(t, out, "12345")
+	assert.Contains(t, out, "enabled")
+	assert.Contains(t, out, "default")
+}
+
+func TestConfigSet_Columns(t *testing.T) {
+	tf, cfg := newConfigFactory(t)
+
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "set", "columns.task-list", `id,name,field:"Story Type"`))
+	assert.Equal(t, `id,name,field:"Story Type"`, cfg.Columns["task-list"])
+
+	tf.OutBuf.Reset()
+	require.NoError(t, testutil.RunCommand(t, NewCmdConfig(tf.Factory), "list"))
+	assert.Contains(t, tf.OutBuf.String(), "columns.task-list")
+	assert.Contains(t, tf.OutBuf.String(), "columns.view-tasks")
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type getOptions struct {
+	key       string
+	jsonFlags cmdutil.JSONFlags
+}
+
+// NewCmdGet returns the "config get" command.
+func NewCmdGet(f *cmdutil.Factory) *cobra.Command {
+	opts := &getOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get <key>",
+		Short: "Print the effective value of a setting",
+		Long: `Print the value of a setting as resolved for the current directory.
+
+On a terminal the source is shown too: env, directory, profile, global or
+default. Use --json to get the source in scripts.`,
+		Example: `  clickup config get space
+  clickup config get prompt --json`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return keyNames(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.key = args[0]
+			return getRun(f, opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func getRun(f *cmdutil.Factory, opts *getOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	key, err := config.LookupKey(opts.key)
+	if err != nil {
+		return err
+	}
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	dir, _ := os.Getwd()
+	r := cfg.Resolve(key, dir)
+
+	if opts.jsonFlags.WantsJSON() {
+		return opts.jsonFlags.OutputJSON(ios.Out, r)
+	}
+	if !ios.IsTerminal() {
+		fmt.Fprintln(ios.Out, r.Value)
+		return nil
+	}
+	fmt.Fprintf(ios.Out, "%s %s\n", r.Value, cs.Gray("("+describeSource(r)+")"))
+	return nil
+}
+
+// describeSource renders a value's source, e.g. "env CLICKUP_RETRY_MAX_DELAY".
+func describeSource(r config.Resolved) string {
+	if r.Detail == "" {
+		return string(r.Source)
+	}
+	return string(r.Source) + " " + r.Detail
+}
+
+// allKeys returns the fixed keys followed by the per-command columns keys.
+func allKeys() []config.Key {
+	return append(append([]config.Key{}, config.Keys...), config.ColumnKeys()...)
+}
+
+func keyNames() []string {
+	keys := allKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.Name
+	}
+	return names
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type listOptions struct {
+	jsonFlags cmdutil.JSONFlags
+}
+
+// NewCmdList returns the "config list" command.
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List all settings with their effective values",
+		Long:    "List every setting as resolved for the current directory, with the source of each value.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listRun(f, opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func listRun(f *cmdutil.Factory, opts *listOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	dir, _ := os.Getwd()
+
+	keys := allKeys()
+	resolved := make([]config.Resolved, len(keys))
+	for i, k := range keys {
+		resolved[i] = cfg.Resolve(k, dir)
+	}
+
+	if opts.jsonFlags.WantsJSON() {
+		return opts.jsonFlags.OutputJSON(ios.Out, resolved)
+	}
+
+	tp := tableprinter.New(ios)
+	tp.AddField(cs.Bold("KEY"))
+	tp.AddField(cs.Bold("VALUE"))
+	tp.AddField(cs.Bold("SOURCE"))
+	tp.EndRow()
+	for _, r := range resolved {
+		tp.AddField(r.Key)
+		tp.AddField(r.Value)
+		tp.AddField(cs.Gray(describeSource(r)))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
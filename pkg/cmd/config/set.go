@@ -0,0 +1,200 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type setOptions struct {
+	key        string
+	value      string
+	dir        bool
+	noValidate bool
+}
+
+// NewCmdSet returns the "config set" command.
+func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
+	opts := &setOptions{}
+
+	keys := make([]string, len(config.Keys))
+	for i, k := range config.Keys {
+		keys[i] = fmt.Sprintf("  %-20s %s", k.Name, k.Description)
+		if len(k.Values) > 0 {
+			keys[i] += " (" + strings.Join(k.Values, ", ") + ")"
+		}
+	}
+	keys = append(keys, fmt.Sprintf("  %-20s %s (%s)", "columns.<command>", "Default --columns for a list command", strings.Join(config.ColumnCommands, ", ")))
+
+	cmd := &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Change a setting",
+		Long: `Change a setting in config.yml. Pass an empty value to unset it.
+
+Workspace, space, folder and list IDs are checked against the ClickUp API
+before saving. If the API cannot be reached the value is saved with a
+warning; --no-validate skips the check.
+
+With --dir, space, folder and list are set for the current directory only.
+Workspace, space, folder, list and sprint_folder are saved to the active
+auth profile.
+
+Keys:
+` + strings.Join(keys, "\n"),
+		Example: `  clickup config set prompt disabled
+  clickup config set editor "code --wait"
+  clickup config set list 901234567 --dir
+  clickup config set retry.max_delay 10s
+  clickup config set columns.task-list 'id,name,status,points,field:"Story Type"'
+  clickup config set folder ""`,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return keyNames(), cobra.ShellCompDirectiveNoFileComp
+			}
+			if k, err := config.LookupKey(args[0]); err == nil && len(args) == 1 {
+				return k.Values, cobra.ShellCompDirectiveNoFileComp
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.key = args[0]
+			opts.value = args[1]
+			return setRun(f, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.dir, "dir", false, "Set the value for the current directory only")
+	cmd.Flags().BoolVar(&opts.noValidate, "no-validate", false, "Save IDs without checking them against the API")
+
+	return cmd
+}
+
+func setRun(f *cmdutil.Factory, opts *setOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	key, err := config.LookupKey(opts.key)
+	if err != nil {
+		return err
+	}
+	value := strings.TrimSpace(opts.value)
+	if err := key.Validate(value); err != nil {
+		return err
+	}
+	if opts.dir && !key.Directory {
+		return fmt.Errorf("%s cannot be set per directory", key.Name)
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+
+	if key.Kind == config.KindID && value != "" && !opts.noValidate {
+		if err := validateID(f, key, value); err != nil {
+			var offline *offlineError
+			if !errors.As(err, &offline) {
+				return err
+			}
+			fmt.Fprintf(ios.ErrOut, "Warning: could not verify %s %s: %v\n", key.Resource, value, offline.err)
+		}
+	}
+
+	var dir string
+	if opts.dir {
+		if dir, err = os.Getwd(); err != nil {
+			return err
+		}
+	}
+	if err := cfg.SetKey(key, value, dir); err != nil {
+		return err
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	scope := "globally"
+	switch {
+	case dir != "":
+		scope = "for " + dir
+	case key.Profile && cfg.Profile() != config.DefaultProfile:
+		scope = "in profile " + cfg.Profile()
+	}
+	if value == "" {
+		fmt.Fprintf(ios.Out, "%s Unset %s %s\n", cs.Green("!"), cs.Bold(key.Name), scope)
+	} else {
+		fmt.Fprintf(ios.Out, "%s Set %s to %s %s\n", cs.Green("!"), cs.Bold(key.Name), value, scope)
+	}
+
+	// Say so when the value just written is not the one that applies here.
+	cwd, _ := os.Getwd()
+	if r := cfg.Resolve(key, cwd); r.Source == config.SourceEnv || (dir == "" && r.Source == config.SourceDirectory) {
+		fmt.Fprintf(ios.ErrOut, "Note: %s is currently taken from %s\n", key.Name, describeSource(r))
+	}
+	return nil
+}
+
+// offlineError marks a validation that could not reach the API.
+type offlineError struct {
+	err error
+}
+
+func (e *offlineError) Error() string { return e.err.Error() }
+
+// validateID checks that id names an existing object of the key's resource.
+func validateID(f *cmdutil.Factory, key config.Key, id string) error {
+	client, err := f.ApiClient()
+	if err != nil {
+		return &offlineError{err: err}
+	}
+	ctx := context.Background()
+
+	switch key.Resource {
+	case "workspace":
+		teams, err := apiv2.GetTeamsLocal(ctx, client)
+		if err != nil {
+			return checkLookup(err, key, id)
+		}
+		var ids []string
+		for _, t := range teams {
+			ids = append(ids, t.ID)
+		}
+		if !slices.Contains(ids, id) {
+			return fmt.Errorf("workspace %s not found; available workspaces: %s", id, strings.Join(ids, ", "))
+		}
+		return nil
+	case "space":
+		_, err = apiv2.GetSpaceLocal(ctx, client, id)
+	case "folder":
+		_, err = apiv2.GetFolderLocal(ctx, client, id)
+	case "list":
+		_, err = apiv2.GetListLocal(ctx, client, id)
+	}
+	return checkLookup(err, key, id)
+}
+
+// checkLookup turns a lookup failure into a validation error, or an
+// offlineError when the API could not be reached at all.
+func checkLookup(err error, key config.Key, id string) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *api.APIError
+	var authErr *api.AuthExpiredError
+	var urlErr *url.Error
+	if !errors.As(err, &apiErr) && !errors.As(err, &authErr) && errors.As(err, &urlErr) {
+		return &offlineError{err: err}
+	}
+	return fmt.Errorf("could not find %s %s (use --no-validate to save it anyway): %w", key.Resource, id, err)
+}
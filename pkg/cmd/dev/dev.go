@@ -0,0 +1,19 @@
+package dev
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdDev returns the dev parent command.
+func NewCmdDev(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Tools for developing against ClickUp",
+		Long:  "Utilities for building and testing integrations without a live ClickUp workspace.",
+	}
+
+	cmd.AddCommand(NewCmdFakeServer(f))
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package dev
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+func TestNewCmdFakeServer_Flags(t *testing.T) {
+	cmd := NewCmdFakeServer(nil)
+	assert.Equal(t, "fake-server", cmd.Use)
+	assert.NotNil(t, cmd.Flags().Lookup("port"))
+	assert.NotNil(t, cmd.Flags().Lookup("workspace"))
+	assert.NotNil(t, cmd.Flags().Lookup("space"))
+}
+
+func TestFakeServer_PrintsURLAndStops(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // stop as soon as the server starts
+
+	cmd := NewCmdFakeServer(tf.Factory)
+	cmd.SetArgs([]string{"--port", "0"})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "export CLICKUP_API_URL=http://127.0.0.1:")
+	assert.Contains(t, out, "Workspace 12345, space 67890")
+}
+
+func TestNewSeededFake(t *testing.T) {
+	fake, seed := newSeededFake("12345", "")
+	assert.Equal(t, "12345", seed.workspaceID)
+	assert.NotEmpty(t, seed.spaceID)
+
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	req, _ := http.NewRequest("GET", srv.URL+"/api/v2/list/"+seed.listID, nil)
+	req.Header.Set("Authorization", "pk_any")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,113 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+type fakeServerOptions struct {
+	port        int
+	workspaceID string
+	spaceID     string
+}
+
+// NewCmdFakeServer returns the "dev fake-server" command.
+func NewCmdFakeServer(f *cmdutil.Factory) *cobra.Command {
+	opts := &fakeServerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "fake-server",
+		Short: "Run an in-memory fake of the ClickUp API",
+		Long: `Run a stateful, in-memory fake of the ClickUp v2 and v3 APIs on localhost.
+
+The fake supports spaces, folders, lists, tasks, comments, time entries,
+tags, custom fields and docs. Everything is kept in memory and lost when
+the server stops. Any non-empty API token is accepted.
+
+The server starts with one workspace, space and list. Their IDs default to
+the workspace and space in your configuration, so existing commands work
+unchanged once CLICKUP_API_URL points at the fake.`,
+		Example: `  # Start the fake on the default port
+  clickup dev fake-server
+
+  # In another shell, run commands against it
+  export CLICKUP_API_URL=http://127.0.0.1:8787
+  clickup task create --list-id <list> --name "Try the fake"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			return runFakeServer(ctx, f, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.port, "port", 8787, "Port to listen on (0 picks a free port)")
+	cmd.Flags().StringVar(&opts.workspaceID, "workspace", "", "Workspace ID to seed (defaults to the configured workspace)")
+	cmd.Flags().StringVar(&opts.spaceID, "space", "", "Space ID to seed (defaults to the configured space)")
+
+	return cmd
+}
+
+func runFakeServer(ctx context.Context, f *cmdutil.Factory, opts *fakeServerOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	if cfg, err := f.Config(); err == nil {
+		if opts.workspaceID == "" {
+			opts.workspaceID = cfg.Workspace
+		}
+		if opts.spaceID == "" {
+			opts.spaceID = cfg.Space
+		}
+	}
+
+	fake, seed := newSeededFake(opts.workspaceID, opts.spaceID)
+
+	ln, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(opts.port)))
+	if err != nil {
+		return fmt.Errorf("failed to start fake server: %w", err)
+	}
+	url := "http://" + ln.Addr().String()
+
+	fmt.Fprintf(ios.Out, "%s Fake ClickUp API listening on %s\n", cs.Green("!"), cs.Bold(url))
+	fmt.Fprintf(ios.Out, "  Workspace %s, space %s, list %s (%s)\n\n", seed.workspaceID, seed.spaceID, seed.listID, seed.listName)
+	fmt.Fprintf(ios.Out, "Point the CLI at it with:\n  export CLICKUP_API_URL=%s\n\n", url)
+	fmt.Fprintln(ios.Out, cs.Gray("Press Ctrl-C to stop."))
+
+	srv := &http.Server{Handler: fake}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+	if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("fake server failed: %w", err)
+	}
+	return nil
+}
+
+type seedInfo struct {
+	workspaceID string
+	spaceID     string
+	listID      string
+	listName    string
+}
+
+// newSeededFake returns a fake with one workspace, space and folderless
+// list. Empty IDs are assigned by the fake.
+func newSeededFake(workspaceID, spaceID string) (*fakeclickup.Server, seedInfo) {
+	fake := fakeclickup.New()
+	ws := fake.AddWorkspace(fakeclickup.Team{ID: workspaceID, Name: "Fake Workspace"})
+	space := fake.AddSpace(ws.ID, fakeclickup.Space{ID: spaceID, Name: "Engineering"})
+	list := fake.AddList(space.ID, "", fakeclickup.List{Name: "Backlog"})
+	return fake, seedInfo{workspaceID: ws.ID, spaceID: space.ID, listID: list.ID, listName: list.Name}
+}
@@ -2,11 +2,17 @@ package doc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv3"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
 	"github.com/triptechtravel/clickup-cli/internal/text"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
@@ -18,8 +24,8 @@ type listOptions struct {
 	archived   bool
 	parentID   string
 	parentType string
-	limit      int
 	cursor     string
+	pageFlags  cmdutil.PageFlags
 	jsonFlags  cmdutil.JSONFlags
 }
 
@@ -32,7 +38,11 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 		Short: "List ClickUp Docs in the workspace",
 		Long: `List Docs in the configured ClickUp workspace.
 
-Supports filtering by creator, status, parent location, and pagination.`,
+Supports filtering by creator, status, parent location, and pagination.
+A single page is fetched by default, starting at --cursor if given.
+--limit follows cursors until that many Docs are found, and --all follows
+them to the end. With --format ndjson, rows are written as each page
+arrives.`,
 		Example: `  # List all Docs
   clickup doc list
 
@@ -43,7 +53,10 @@ Supports filtering by creator, status, parent location, and pagination.`,
   clickup doc list --parent-id 123456 --parent-type SPACE
 
   # Paginate
-  clickup doc list --limit 10 --cursor <cursor>`,
+  clickup doc list --limit 10 --cursor <cursor>
+
+  # Stream every Doc as NDJSON
+  clickup doc list --all --format ndjson`,
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.parentType != "" && opts.parentID == "" {
@@ -63,14 +76,31 @@ Supports filtering by creator, status, parent location, and pagination.`,
 	cmd.Flags().BoolVar(&opts.archived, "archived", false, "Include archived Docs")
 	cmd.Flags().StringVar(&opts.parentID, "parent-id", "", "Filter by parent ID")
 	cmd.Flags().StringVar(&opts.parentType, "parent-type", "", "Parent type (SPACE|FOLDER|LIST|WORKSPACE|EVERYTHING)")
-	cmd.Flags().IntVar(&opts.limit, "limit", 0, "Maximum number of Docs to return")
 	cmd.Flags().StringVar(&opts.cursor, "cursor", "", "Pagination cursor from a previous response")
+	cmdutil.AddPageFlags(cmd, &opts.pageFlags, "Docs")
 
 	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
 
 	return cmd
 }
 
+// docListPage is a page of the doc search endpoint. Docs are kept as
+// returned by the API so --json shows every field.
+type docListPage struct {
+	Docs       []json.RawMessage `json:"docs"`
+	NextCursor *string           `json:"next_cursor"`
+}
+
+// docSummary holds the fields of a Doc shown in the table.
+type docSummary struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Public      bool     `json:"public"`
+	Deleted     bool     `json:"deleted"`
+	Archived    bool     `json:"archived"`
+	DateUpdated *float32 `json:"date_updated"`
+}
+
 func runList(f *cmdutil.Factory, opts *listOptions) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
@@ -85,73 +115,131 @@ func runList(f *cmdutil.Factory, opts *listOptions) error {
 		return err
 	}
 
-	params := apiv3.SearchDocsPublicParams{
-		Deleted:  opts.deleted,
-		Archived: opts.archived,
-	}
+	q := url.Values{}
+	q.Set("deleted", strconv.FormatBool(opts.deleted))
+	q.Set("archived", strconv.FormatBool(opts.archived))
 	if opts.creator != 0 {
-		params.Creator = float64(opts.creator)
-	}
-	if opts.limit > 0 {
-		params.Limit = float64(opts.limit)
-	}
-	if opts.cursor != "" {
-		params.Cursor = opts.cursor
+		q.Set("creator", strconv.Itoa(opts.creator))
 	}
 	if opts.parentID != "" {
-		params.ParentId = opts.parentID
+		q.Set("parent_id", opts.parentID)
 		if opts.parentType != "" {
 			// The API accepts the string name directly (e.g. "SPACE", "4").
-			params.ParentType = strings.ToUpper(opts.parentType)
+			q.Set("parent_type", strings.ToUpper(opts.parentType))
 		}
 	}
 
-	ctx := context.Background()
-	result, err := apiv3.SearchDocsPublic(ctx, client, workspaceID, params)
+	// nextCursor is the cursor after the last page fetched, for --json
+	// and the next page hint.
+	var nextCursor *string
+	fetched := 0
+	fetch := func(ctx context.Context, cursor string) ([]json.RawMessage, string, error) {
+		q := maps.Clone(q)
+		if opts.pageFlags.Limit > 0 {
+			// Ask for no more than are still wanted, so the last page
+			// ends at the limit and its cursor picks up after it.
+			q.Set("limit", strconv.Itoa(opts.pageFlags.Limit-fetched))
+		}
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		// TODO: swap to generated wrapper — SearchDocsPublic takes its
+		// cursor and limit in a params struct whose query encoding is not
+		// exported, and the raw docs are needed for --json.
+		var page docListPage
+		path := fmt.Sprintf("workspaces/%s/docs?%s", workspaceID, q.Encode())
+		if err := apiv3.Do(ctx, client, "GET", path, nil, &page); err != nil {
+			return nil, "", err
+		}
+		fetched += len(page.Docs)
+		nextCursor = page.NextCursor
+		if page.NextCursor == nil {
+			return page.Docs, "", nil
+		}
+		return page.Docs, *page.NextCursor, nil
+	}
+	pageOpts := opts.pageFlags.Options()
+	pageOpts.Cursor = opts.cursor
+	seq := pager.Cursor(context.Background(), pageOpts, fetch)
+
+	if !opts.jsonFlags.WantsJSON() && f.Streams(false) {
+		n, err := cmdutil.StreamTable(f, seq, func(raw json.RawMessage) (*tableprinter.Table, error) {
+			tbl := docTable(cs)
+			return tbl, addDocRow(tbl, raw)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list docs: %w", err)
+		}
+		if n == 0 {
+			fmt.Fprintln(ios.ErrOut, "No Docs found.")
+		}
+		return nil
+	}
+
+	docs, err := pager.Collect(seq)
 	if err != nil {
 		return fmt.Errorf("failed to list docs: %w", err)
 	}
 
 	if opts.jsonFlags.WantsJSON() {
-		return opts.jsonFlags.OutputJSON(ios.Out, result)
+		if docs == nil {
+			docs = []json.RawMessage{}
+		}
+		return opts.jsonFlags.OutputJSON(ios.Out, docListPage{Docs: docs, NextCursor: nextCursor})
 	}
 
-	if len(result.Docs) == 0 {
+	if len(docs) == 0 {
 		fmt.Fprintln(ios.Out, cs.Gray("No Docs found."))
 		return nil
 	}
 
-	tp := tableprinter.New(ios)
-	tp.SetTruncateColumn(0)
-	for _, d := range result.Docs {
-		statusLabel := ""
-		if d.Deleted {
-			statusLabel = "[deleted]"
-		} else if d.Archived {
-			statusLabel = "[archived]"
-		}
-		visibility := "private"
-		if d.Public {
-			visibility = "public"
+	tbl := docTable(cs)
+	for _, raw := range docs {
+		if err := addDocRow(tbl, raw); err != nil {
+			return err
 		}
-		updated := ""
-		if d.DateUpdated != nil {
-			updated = text.FormatUnixMillisFloat(*d.DateUpdated)
-		}
-		tp.AddField(d.Name)
-		tp.AddField("#" + d.ID)
-		tp.AddField(visibility)
-		tp.AddField(updated)
-		tp.AddField(statusLabel)
-		tp.EndRow()
-	}
-	if err := tp.Render(); err != nil {
+	}
+	if err := f.PrintTable(tbl); err != nil {
 		return err
 	}
 
-	if result.NextCursor != nil && *result.NextCursor != "" {
-		fmt.Fprintf(ios.Out, "\n%s  clickup doc list --cursor %s\n", cs.Gray("Next page:"), *result.NextCursor)
+	if nextCursor != nil && *nextCursor != "" && !f.WantsFormat() {
+		fmt.Fprintf(ios.Out, "\n%s  clickup doc list --cursor %s\n", cs.Gray("Next page:"), *nextCursor)
 	}
 
 	return nil
 }
+
+func docTable(cs *iostreams.ColorScheme) *tableprinter.Table {
+	return tableprinter.NewTable(
+		tableprinter.Column{Header: "NAME", Truncate: true},
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "VISIBILITY"},
+		tableprinter.Column{Header: "UPDATED"},
+		tableprinter.Column{Header: "STATUS", Color: cs.Gray},
+	)
+}
+
+// addDocRow adds a Doc, as returned by the API, to tbl.
+func addDocRow(tbl *tableprinter.Table, raw json.RawMessage) error {
+	var d docSummary
+	if err := json.Unmarshal(raw, &d); err != nil {
+		return fmt.Errorf("failed to parse doc: %w", err)
+	}
+	statusLabel := ""
+	if d.Deleted {
+		statusLabel = "[deleted]"
+	} else if d.Archived {
+		statusLabel = "[archived]"
+	}
+	visibility := "private"
+	if d.Public {
+		visibility = "public"
+	}
+	updated := ""
+	if d.DateUpdated != nil {
+		updated = text.FormatUnixMillisFloat(*d.DateUpdated)
+	}
+	tbl.AddRow(d.Name, "#"+d.ID, visibility, updated, statusLabel)
+	return nil
+}
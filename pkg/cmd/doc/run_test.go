@@ -3,6 +3,7 @@ package doc
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/triptechtravel/clickup-cli/internal/iostreams"
 	"github.com/triptechtravel/clickup-cli/internal/testutil"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
 )
 
 // JSON fixtures use the auto-gen clickupv3 field names and types.
@@ -150,6 +152,39 @@ func TestRunList_JSONOutput(t *testing.T) {
 	assert.Equal(t, 2, len(docs))
 }
 
+// TestRunList_FollowsCursors verifies --limit and --all page through the
+// doc search results, 50 at a time on the fake.
+func TestRunList_FollowsCursors(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantDocs   int
+		wantCursor interface{}
+	}{
+		{"one page by default", nil, 50, "50"},
+		{"limit across pages", []string{"--limit", "55"}, 55, "55"},
+		{"all", []string{"--all"}, 60, nil},
+		{"from cursor", []string{"--cursor", "50"}, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := testutil.NewTestFactory(t)
+			fake := tf.Fake()
+			for i := range 60 {
+				fake.AddDoc("12345", fakeclickup.Doc{Name: fmt.Sprintf("Doc %d", i)})
+			}
+
+			err := testutil.RunCommand(t, NewCmdList(tf.Factory), append(tt.args, "--json")...)
+			require.NoError(t, err)
+
+			var got map[string]interface{}
+			require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &got))
+			assert.Len(t, got["docs"], tt.wantDocs)
+			assert.Equal(t, tt.wantCursor, got["next_cursor"])
+		})
+	}
+}
+
 // TestRunView_OutputsDetails verifies doc view renders key metadata.
 func TestRunView_OutputsDetails(t *testing.T) {
 	tf := testutil.NewTestFactory(t)
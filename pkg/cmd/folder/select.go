@@ -77,6 +77,7 @@ func NewCmdFolderSelect(f *cmdutil.Factory) *cobra.Command {
 					if existing, ok := cfg.DirectoryDefaults[dir]; ok {
 						dc.Space = existing.Space
 						dc.List = existing.List
+						dc.Profile = existing.Profile
 					}
 				}
 				cfg.SetDirectoryDefault(dir, dc)
@@ -0,0 +1,198 @@
+package history
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/journal"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type historyOptions struct {
+	id        int
+	limit     int
+	jsonFlags cmdutil.JSONFlags
+}
+
+// NewCmdHistory returns the "history" command.
+func NewCmdHistory(f *cmdutil.Factory) *cobra.Command {
+	opts := &historyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history [<entry>]",
+		Short: "List changes made by recent commands",
+		Long: `List the changes recent commands made in ClickUp, newest first.
+
+Before each change, clickup records the prior values of the fields it
+touches (status, assignees, tags, dates, custom fields, description and
+so on) in a local journal. Pass an entry number to see every field it
+changed, and revert an entry with 'clickup undo'.
+
+The journal keeps the last 200 commands, in journal.json in the config
+directory. Nothing is journaled under --dry-run.`,
+		Example: `  # List recent changes
+  clickup history
+
+  # Show the fields changed by entry 12
+  clickup history 12
+
+  # JSON output for scripting
+  clickup history --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				id, err := parseEntryID(args[0])
+				if err != nil {
+					return err
+				}
+				opts.id = id
+			}
+			return historyRun(f, opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 20, "Maximum number of entries to list")
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+// parseEntryID accepts an entry number, with or without a leading "#".
+func parseEntryID(s string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(s, "#"))
+	if err != nil || id <= 0 {
+		return 0, &cmdutil.FlagError{Err: fmt.Errorf("invalid entry %q: expected a number from 'clickup history'", s)}
+	}
+	return id, nil
+}
+
+func historyRun(f *cmdutil.Factory, opts *historyOptions) error {
+	j := f.Journal()
+	if opts.id != 0 {
+		e, err := j.Entry(opts.id)
+		if err != nil {
+			return err
+		}
+		if opts.jsonFlags.WantsJSON() {
+			return opts.jsonFlags.OutputJSON(f.IOStreams.Out, e)
+		}
+		return printEntry(f, e)
+	}
+
+	entries, err := j.Entries()
+	if err != nil {
+		return err
+	}
+	slices.Reverse(entries)
+	if opts.limit > 0 && len(entries) > opts.limit {
+		entries = entries[:opts.limit]
+	}
+
+	if opts.jsonFlags.WantsJSON() {
+		if entries == nil {
+			entries = []journal.Entry{}
+		}
+		return opts.jsonFlags.OutputJSON(f.IOStreams.Out, entries)
+	}
+	if len(entries) == 0 {
+		fmt.Fprintln(f.IOStreams.ErrOut, "No changes recorded yet.")
+		return nil
+	}
+
+	cs := f.IOStreams.ColorScheme()
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "WHEN"},
+		tableprinter.Column{Header: "COMMAND", Truncate: true},
+		tableprinter.Column{Header: "CHANGES", Truncate: true},
+		tableprinter.Column{Header: "STATUS", Color: cs.Gray},
+	)
+	for _, e := range entries {
+		tbl.AddRow("#"+strconv.Itoa(e.ID), text.RelativeTime(e.Time), e.Command, summary(e), status(e))
+	}
+	return f.PrintTable(tbl)
+}
+
+// printEntry shows every field an entry changed.
+func printEntry(f *cmdutil.Factory, e journal.Entry) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	fmt.Fprintf(ios.Out, "%s %s\n", cs.Bold("#"+strconv.Itoa(e.ID)), e.Command)
+	fmt.Fprintf(ios.Out, "%s  %s\n\n", text.RelativeTime(e.Time), status(e))
+
+	tp := tableprinter.New(ios)
+	for _, h := range []string{"TASK", "FIELD", "BEFORE", "AFTER"} {
+		tp.AddField(cs.Bold(h))
+	}
+	tp.EndRow()
+	for _, c := range e.Changes {
+		task := c.TaskID
+		if task == "" {
+			task = c.Method + " " + c.Path
+		}
+		for _, fc := range c.Fields {
+			after := text.Truncate(fc.After, 40)
+			if fc.Lost {
+				after += " " + cs.Gray("(cannot restore)")
+			}
+			tp.AddField(task)
+			tp.AddField(fc.Field)
+			tp.AddField(text.Truncate(fc.Before, 40))
+			tp.AddField(after)
+			tp.EndRow()
+		}
+		if len(c.Fields) == 0 {
+			note := c.Note
+			if note == "" {
+				note = "no change"
+			}
+			tp.AddField(task)
+			tp.AddField("-")
+			tp.AddField("")
+			tp.AddField(cs.Gray(note))
+			tp.EndRow()
+		}
+	}
+	return tp.Render()
+}
+
+// summary describes an entry's changes, e.g. "status, tags on 40 tasks".
+func summary(e journal.Entry) string {
+	var fields, tasks []string
+	for _, c := range e.Changes {
+		for _, fc := range c.Fields {
+			if !slices.Contains(fields, fc.Field) {
+				fields = append(fields, fc.Field)
+			}
+		}
+		if c.TaskID != "" && !slices.Contains(tasks, c.TaskID) {
+			tasks = append(tasks, c.TaskID)
+		}
+	}
+	if len(fields) == 0 {
+		return text.Pluralize(len(e.Changes), "request")
+	}
+	s := strings.Join(fields, ", ")
+	if len(tasks) > 0 {
+		s += " on " + text.Pluralize(len(tasks), "task")
+	}
+	return s
+}
+
+func status(e journal.Entry) string {
+	switch {
+	case e.UndoneBy != 0:
+		return fmt.Sprintf("undone by #%d", e.UndoneBy)
+	case e.Undoes != 0:
+		return fmt.Sprintf("undo of #%d", e.Undoes)
+	case e.Undoable():
+		return "can undo"
+	}
+	return "cannot undo"
+}
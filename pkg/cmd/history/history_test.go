@@ -0,0 +1,114 @@
+package history
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/journal"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/comment"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/task"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+// newJournalFactory returns a test factory backed by a fake ClickUp, whose
+// changes are journaled as commandLine.
+func newJournalFactory(t *testing.T, commandLine string) (*testutil.TestFactory, *fakeclickup.Server, fakeclickup.List) {
+	t.Helper()
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	tf.Factory.SetJournal(journal.Open(filepath.Join(t.TempDir(), "journal.json")))
+	tf.Factory.CommandLine = commandLine
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	return tf, fake, list
+}
+
+func TestHistory_Empty(t *testing.T) {
+	tf, _, _ := newJournalFactory(t, "clickup history")
+
+	err := testutil.RunCommand(t, NewCmdHistory(tf.Factory))
+	require.NoError(t, err)
+	assert.Empty(t, tf.OutBuf.String())
+	assert.Contains(t, tf.ErrBuf.String(), "No changes recorded yet.")
+
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "--json")
+	require.NoError(t, err)
+	assert.JSONEq(t, "[]", tf.OutBuf.String())
+}
+
+func TestHistory_ListAndEntry(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup task edit --status complete")
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login"})
+
+	err := testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "edit", tk.ID, "--status", "complete", "--name", "Fix logout")
+	require.NoError(t, err)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory))
+	require.NoError(t, err)
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "#1")
+	assert.Contains(t, out, "clickup task edit --status complete")
+	assert.Contains(t, out, "name, status on 1 task")
+	assert.Contains(t, out, "can undo")
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "#1")
+	require.NoError(t, err)
+	out = tf.OutBuf.String()
+	assert.Contains(t, out, "FIELD")
+	assert.Regexp(t, tk.ID+`\s+status\s+to do\s+complete`, out)
+	assert.Regexp(t, tk.ID+`\s+name\s+Fix login\s+Fix logout`, out)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "1", "--json")
+	require.NoError(t, err)
+	var e journal.Entry
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &e))
+	assert.Equal(t, 1, e.ID)
+	require.Len(t, e.Changes, 1)
+	assert.Equal(t, tk.ID, e.Changes[0].TaskID)
+}
+
+func TestHistory_NotUndoable(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup comment add")
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Discuss"})
+
+	err := testutil.RunCommand(t, comment.NewCmdComment(tf.Factory), "add", tk.ID, "Looks good")
+	require.NoError(t, err)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory))
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "1 request")
+	assert.Contains(t, tf.OutBuf.String(), "cannot undo")
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "1")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "not tracked by the journal")
+}
+
+func TestHistory_Errors(t *testing.T) {
+	tf, _, _ := newJournalFactory(t, "clickup history")
+
+	err := testutil.RunCommand(t, NewCmdHistory(tf.Factory), "abc")
+	assert.ErrorContains(t, err, `invalid entry "abc"`)
+
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "7")
+	assert.ErrorIs(t, err, journal.ErrNotFound)
+}
+
+func TestParseEntryID(t *testing.T) {
+	id, err := parseEntryID("#12")
+	require.NoError(t, err)
+	assert.Equal(t, 12, id)
+
+	for _, s := range []string{"", "0", "-3", "twelve"} {
+		_, err := parseEntryID(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,143 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/journal"
+	"github.com/triptechtravel/clickup-cli/internal/prompter"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type undoOptions struct {
+	id      int
+	confirm bool
+}
+
+// NewCmdUndo returns the "undo" command.
+func NewCmdUndo(f *cmdutil.Factory) *cobra.Command {
+	opts := &undoOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "undo [<entry>]",
+		Short: "Revert the changes made by a command",
+		Long: `Revert the changes a command made in ClickUp, using the prior values
+recorded in the journal (see 'clickup history').
+
+Without an entry number, the most recent command that can be undone is
+reverted; run undo again to step further back. Undo is itself journaled,
+so undoing an undo entry re-applies its changes.
+
+Status, name, description, priority, dates, time estimate, points,
+assignees, tags and most custom fields are restored, and created tasks are
+deleted. Deleted tasks, and fields the API cannot set back (such as
+moving a subtask back to the top level), are listed but left as they are.
+Values changed by someone else since are overwritten.
+
+A confirmation prompt is shown unless --yes is passed.`,
+		Example: `  # Undo the last command
+  clickup undo
+
+  # Undo entry 12 from 'clickup history' without a prompt
+  clickup undo 12 --yes
+
+  # Show the requests undo would send
+  clickup undo --dry-run`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				id, err := parseEntryID(args[0])
+				if err != nil {
+					return err
+				}
+				opts.id = id
+			}
+			return undoRun(f, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func undoRun(f *cmdutil.Factory, opts *undoOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	// The client attaches the journal's recorder, so it must exist before
+	// the entry is reverted.
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	j := f.Journal()
+	var e journal.Entry
+	if opts.id != 0 {
+		e, err = j.Entry(opts.id)
+	} else {
+		e, err = j.Latest()
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case e.UndoneBy != 0:
+		return fmt.Errorf("#%d was already undone by #%d", e.ID, e.UndoneBy)
+	case !e.Undoable():
+		return fmt.Errorf("#%d has no changes that can be undone", e.ID)
+	}
+
+	restored, lost := describe(e)
+	if !opts.confirm && ios.IsTerminal() {
+		fmt.Fprintf(ios.ErrOut, "%s %s (%s)\n", cs.Bold("#"+strconv.Itoa(e.ID)), e.Command, text.RelativeTime(e.Time))
+		for _, line := range restored {
+			fmt.Fprintf(ios.ErrOut, "  %s\n", line)
+		}
+		ok, err := prompter.New(ios).Confirm(fmt.Sprintf("Restore %s?", text.Pluralize(len(restored), "value")), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(ios.ErrOut, "Cancelled.")
+			return nil
+		}
+	}
+
+	sent, err := j.Revert(context.Background(), client, e)
+	if err != nil {
+		return fmt.Errorf("undo #%d stopped after %s: %w", e.ID, text.Pluralize(sent, "request"), err)
+	}
+
+	fmt.Fprintf(ios.Out, "%s Undid #%d: restored %s\n", cs.Green("!"), e.ID, text.Pluralize(len(restored), "value"))
+	for _, line := range lost {
+		fmt.Fprintf(ios.ErrOut, "%s not restored: %s\n", cs.Yellow("!"), line)
+	}
+	return nil
+}
+
+// describe lists the values undoing e restores and those it cannot.
+func describe(e journal.Entry) (restored, lost []string) {
+	for _, c := range e.Changes {
+		for _, fc := range c.Fields {
+			if fc.Lost || len(c.Undo) == 0 {
+				lost = append(lost, fmt.Sprintf("%s %s (%s)", c.TaskID, fc.Field, c.Note))
+				continue
+			}
+			restored = append(restored, fmt.Sprintf("%s %s: %s → %s", c.TaskID, fc.Field, show(fc.After), show(fc.Before)))
+		}
+	}
+	return restored, lost
+}
+
+func show(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return strconv.Quote(text.Truncate(v, 40))
+}
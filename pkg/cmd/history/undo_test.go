@@ -0,0 +1,141 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/journal"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/comment"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/task"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func TestUndo_BulkEdit(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup task edit --status complete --tags release")
+	a := fake.AddTask(list.ID, fakeclickup.Task{Name: "First", Tags: []fakeclickup.Tag{{Name: "bug"}}})
+	b := fake.AddTask(list.ID, fakeclickup.Task{Name: "Second", Status: fakeclickup.Status{Status: "in progress"}})
+
+	err := testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "edit", a.ID, b.ID, "--status", "complete", "--tags", "release")
+	require.NoError(t, err)
+	for _, id := range []string{a.ID, b.ID} {
+		got, _ := fake.Task(id)
+		require.Equal(t, "complete", got.Status.Status)
+		require.Len(t, got.Tags, 1)
+		require.Equal(t, "release", got.Tags[0].Name)
+	}
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory))
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "status, tags on 2 tasks")
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "Undid #1: restored 5 values")
+
+	got, _ := fake.Task(a.ID)
+	assert.Equal(t, "to do", got.Status.Status)
+	require.Len(t, got.Tags, 1)
+	assert.Equal(t, "bug", got.Tags[0].Name)
+	got, _ = fake.Task(b.ID)
+	assert.Equal(t, "in progress", got.Status.Status)
+	assert.Empty(t, got.Tags)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory), "1")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "undone by #2")
+
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "1", "--yes")
+	assert.ErrorContains(t, err, "#1 was already undone by #2")
+}
+
+func TestUndo_UndoAnUndo(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup task edit --status complete")
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login"})
+
+	err := testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "edit", tk.ID, "--status", "complete")
+	require.NoError(t, err)
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	require.NoError(t, err)
+	got, _ := fake.Task(tk.ID)
+	require.Equal(t, "to do", got.Status.Status)
+
+	// Undo skips undo entries unless they are named, so the edit is
+	// re-applied by undoing #2.
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	assert.ErrorIs(t, err, journal.ErrNotFound)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "2", "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "Undid #2")
+	got, _ = fake.Task(tk.ID)
+	assert.Equal(t, "complete", got.Status.Status)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdHistory(tf.Factory))
+	require.NoError(t, err)
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "undo of #2")
+	assert.Contains(t, out, "undone by #3")
+	assert.Contains(t, out, "undone by #2")
+}
+
+func TestUndo_NotRestored(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup task edit --status complete --parent")
+	epic := fake.AddTask(list.ID, fakeclickup.Task{Name: "Epic"})
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login"})
+
+	err := testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "edit", tk.ID, "--status", "complete", "--parent", epic.ID)
+	require.NoError(t, err)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "restored 1 value")
+	assert.Contains(t, tf.ErrBuf.String(), "not restored: "+tk.ID+" parent (cannot restore parent)")
+
+	got, _ := fake.Task(tk.ID)
+	assert.Equal(t, "to do", got.Status.Status)
+	assert.Equal(t, epic.ID, got.Parent)
+}
+
+func TestUndo_NothingToUndo(t *testing.T) {
+	tf, fake, list := newJournalFactory(t, "clickup comment add")
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Discuss"})
+
+	err := testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	assert.ErrorIs(t, err, journal.ErrNotFound)
+
+	err = testutil.RunCommand(t, comment.NewCmdComment(tf.Factory), "add", tk.ID, "Looks good")
+	require.NoError(t, err)
+
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "--yes")
+	assert.ErrorContains(t, err, "nothing to undo")
+	err = testutil.RunCommand(t, NewCmdUndo(tf.Factory), "1", "--yes")
+	assert.ErrorContains(t, err, "#1 has no changes that can be undone")
+	assert.Len(t, fake.Comments(tk.ID), 1)
+}
+
+func TestDescribe(t *testing.T) {
+	e := journal.Entry{Changes: []journal.Change{
+		{
+			TaskID: "abc",
+			Fields: []journal.FieldChange{{Field: "status", Before: "to do", After: "done"}},
+			Undo:   []journal.Request{{Method: "PUT", Path: "/api/v2/task/abc"}},
+		},
+		{
+			TaskID: "def",
+			Fields: []journal.FieldChange{{Field: "task", Before: "def", Lost: true}},
+			Note:   "deleted tasks cannot be restored",
+		},
+	}}
+
+	restored, lost := describe(e)
+	assert.Equal(t, []string{`abc status: "done" → "to do"`}, restored)
+	assert.Equal(t, []string{"def task (deleted tasks cannot be restored)"}, lost)
+}
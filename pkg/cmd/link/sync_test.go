@@ -0,0 +1,46 @@
+package link
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+// stubGH puts a gh on PATH that serves PR #42 and accepts edits to it.
+func stubGH(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the gh stub is a shell script")
+	}
+	dir := t.TempDir()
+	script := `#!/bin/sh
+case "$1 $2" in
+"pr view") echo '{"number":42,"title":"Add OAuth login","body":"Adds GitHub OAuth.","url":"https://github.com/acme/app/pull/42"}' ;;
+"pr edit") ;;
+*) echo "unexpected gh $*" >&2; exit 1 ;;
+esac
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gh"), []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestLinkSync_Cassette(t *testing.T) {
+	stubGH(t)
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.SetConfig(&config.Config{Workspace: "12345"})
+	tf.Replay(t, "testdata/link_sync.yaml")
+
+	cmd := NewCmdLinkSync(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "42", "--repo", "acme/app", "--task", "86abc123")
+	require.NoError(t, err)
+
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "Updated PR #42 body with ClickUp task info")
+	assert.Contains(t, out, "Linked PR #42 to task 86abc123")
+}
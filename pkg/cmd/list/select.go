@@ -92,6 +92,7 @@ func NewCmdListSelect(f *cmdutil.Factory) *cobra.Command {
 					if existing, ok := cfg.DirectoryDefaults[dir]; ok {
 						dc.Space = existing.Space
 						dc.Folder = existing.Folder
+						dc.Profile = existing.Profile
 					}
 				}
 				cfg.SetDirectoryDefault(dir, dc)
@@ -105,7 +105,7 @@ func runMemberList(f *cmdutil.Factory, jsonFlags *cmdutil.JSONFlags) error {
 	}
 
 	if len(entries) == 0 {
-		fmt.Fprintln(ios.Out, "No members found for this workspace.")
+		fmt.Fprintln(ios.ErrOut, "No members found for this workspace.")
 		return nil
 	}
 
@@ -113,15 +113,21 @@ func runMemberList(f *cmdutil.Factory, jsonFlags *cmdutil.JSONFlags) error {
 		return jsonFlags.OutputJSON(ios.Out, entries)
 	}
 
-	tp := tableprinter.New(ios)
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "USERNAME"},
+		tableprinter.Column{Header: "EMAIL"},
+		tableprinter.Column{Header: "ROLE", Color: cs.Gray},
+	)
 	for _, e := range entries {
-		tp.AddField(strconv.Itoa(e.ID))
-		tp.AddField(e.Username)
-		tp.AddField(e.Email)
-		tp.AddField(cs.Gray(e.Role))
-		tp.EndRow()
+		tbl.AddRow(strconv.Itoa(e.ID), e.Username, e.Email, e.Role)
+	}
+	if err := f.PrintTable(tbl); err != nil {
+		return err
+	}
+	if f.WantsFormat() {
+		return nil
 	}
-	tp.Render()
 
 	fmt.Fprintf(ios.Out, "\n%s\n", cs.Gray(fmt.Sprintf("%d members", len(entries))))
 
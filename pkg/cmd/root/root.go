@@ -2,27 +2,34 @@ package root
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/alias"
+	apicmd "github.com/triptechtravel/clickup-cli/pkg/cmd/api"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/attachment"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/auth"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/board"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/chat"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/comment"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/completion"
+	configcmd "github.com/triptechtravel/clickup-cli/pkg/cmd/config"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/dev"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/doc"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/field"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/folder"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/goal"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/history"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/inbox"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/link"
 	listcmd "github.com/triptechtravel/clickup-cli/pkg/cmd/list"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/member"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/search"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/space"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/sprint"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/status"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/tag"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/task"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/template"
-	"github.com/triptechtravel/clickup-cli/pkg/cmd/view"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/version"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/view"
 	"github.com/triptechtravel/clickup-cli/pkg/cmd/webhook"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
@@ -40,6 +47,15 @@ Links GitHub PRs, branches, and commits to ClickUp tasks.`,
 		SilenceUsage:  true,
 	}
 
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return &cmdutil.FlagError{Err: err}
+	})
+
+	cmd.PersistentFlags().BoolVar(&f.Debug, "debug", false, "Log HTTP requests and responses to stderr")
+	cmd.PersistentFlags().BoolVar(&f.DryRun, "dry-run", false, "Print the requests that would change ClickUp instead of sending them")
+	cmd.PersistentFlags().StringVar(&f.Profile, "profile", "", "Use the named auth profile (overrides CLICKUP_PROFILE)")
+	cmdutil.AddFormatFlag(cmd, f)
+
 	// Core commands
 	cmd.AddCommand(auth.NewCmdAuth(f))
 	cmd.AddCommand(task.NewCmdTask(f))
@@ -53,6 +69,7 @@ Links GitHub PRs, branches, and commits to ClickUp tasks.`,
 	// Workflow commands
 	cmd.AddCommand(link.NewCmdLink(f))
 	cmd.AddCommand(sprint.NewCmdSprint(f))
+	cmd.AddCommand(board.NewCmdBoard(f))
 	cmd.AddCommand(space.NewCmdSpace(f))
 	cmd.AddCommand(field.NewCmdField(f))
 	cmd.AddCommand(folder.NewCmdFolder(f))
@@ -72,9 +89,36 @@ Links GitHub PRs, branches, and commits to ClickUp tasks.`,
 	// Chat
 	cmd.AddCommand(chat.NewCmdChat(f))
 
+	// Journal
+	cmd.AddCommand(history.NewCmdHistory(f))
+	cmd.AddCommand(history.NewCmdUndo(f))
+
 	// Utility commands
+	cmd.AddCommand(alias.NewCmdAlias(f))
+	cmd.AddCommand(search.NewCmdSearch(f))
+	cmd.AddCommand(configcmd.NewCmdConfig(f))
+	cmd.AddCommand(apicmd.NewCmdAPI(f))
+	cmd.AddCommand(dev.NewCmdDev(f))
 	cmd.AddCommand(version.NewCmdVersion())
 	cmd.AddCommand(completion.NewCmdCompletion(cmd))
 
+	markUsageErrors(cmd)
+
 	return cmd
 }
+
+// markUsageErrors makes argument errors of cmd and its subcommands
+// FlagErrors, so they exit with the usage code like flag errors do.
+func markUsageErrors(cmd *cobra.Command) {
+	if args := cmd.Args; args != nil {
+		cmd.Args = func(c *cobra.Command, a []string) error {
+			if err := args(c, a); err != nil {
+				return &cmdutil.FlagError{Err: err}
+			}
+			return nil
+		}
+	}
+	for _, c := range cmd.Commands() {
+		markUsageErrors(c)
+	}
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdDelete returns the "search delete" command.
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a saved search",
+		Long: `Delete a saved search. A project search is deleted before a global one
+of the same name.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeNames(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteRun(f, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func deleteRun(f *cmdutil.Factory, name string) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	searches, err := loadSearches(f)
+	if err != nil {
+		return err
+	}
+	s, ok := searches[name]
+	if !ok {
+		return fmt.Errorf("no such search %q", name)
+	}
+
+	if s.Path != "" {
+		local, err := config.LoadSearches(s.Path)
+		if err != nil {
+			return err
+		}
+		delete(local, name)
+		if err := config.SaveSearches(s.Path, local); err != nil {
+			return fmt.Errorf("failed to save %s: %w", s.Path, err)
+		}
+	} else {
+		cfg, err := f.Config()
+		if err != nil {
+			return err
+		}
+		delete(cfg.Searches, name)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	fmt.Fprintf(ios.Out, "%s Deleted search %s: %s\n", cs.Green("!"), cs.Bold(name), s.Query)
+	return nil
+}
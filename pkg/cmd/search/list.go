@@ -0,0 +1,74 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type listOptions struct {
+	jsonFlags cmdutil.JSONFlags
+}
+
+// NewCmdList returns the "search list" command.
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List saved searches",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listRun(f, opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func listRun(f *cmdutil.Factory, opts *listOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	searches, err := loadSearches(f)
+	if err != nil {
+		return err
+	}
+	names := sortedNames(searches)
+
+	if opts.jsonFlags.WantsJSON() {
+		data := make([]savedSearch, len(names))
+		for i, name := range names {
+			data[i] = searches[name]
+		}
+		return opts.jsonFlags.OutputJSON(ios.Out, data)
+	}
+
+	if len(names) == 0 {
+		fmt.Fprintln(ios.Out, "No saved searches. Add one with 'clickup search save <name> <query>'")
+		return nil
+	}
+
+	tp := tableprinter.New(ios)
+	tp.AddField(cs.Bold("NAME"))
+	tp.AddField(cs.Bold("QUERY"))
+	tp.AddField(cs.Bold("SAVED IN"))
+	tp.EndRow()
+	for _, name := range names {
+		s := searches[name]
+		tp.AddField(name)
+		tp.AddField(s.Query)
+		if s.Path != "" {
+			tp.AddField(s.Path)
+		} else {
+			tp.AddField(cs.Gray("config.yml"))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,173 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type runOptions struct {
+	name       string
+	extra      []string
+	ids        bool
+	pageFlags  cmdutil.PageFlags
+	tableFlags cmdutil.TableFlags
+	jsonFlags  cmdutil.JSONFlags
+}
+
+// runColumns are the columns 'search run' shows by default.
+var runColumns = []string{"id", "name", "status", "priority", "assignee", "list", "due"}
+
+// localPages is how many pages are scanned by default when part of the
+// search can only be checked on fetched tasks.
+const localPages = 10
+
+// NewCmdRun returns the "search run" command.
+func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
+	opts := &runOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run <name> [filter]",
+		Short: "Run a saved search",
+		Long: `Run a saved search across the workspace, most recently updated tasks
+first. Filter terms after the name narrow the search further.
+
+--ids prints only the matching task IDs, one per line, for use with
+commands that take several task IDs. It exits non-zero when no task
+matches.
+
+One page of up to 100 tasks is fetched by default, or up to 10 pages when
+part of the search is checked on the fetched tasks. --limit stops after
+that many matches, and --all scans every page.
+
+Columns: ` + strings.Join(cmdutil.TaskColumns, ", "),
+		Example: `  clickup search run shipped
+
+  # Narrow a saved search
+  clickup search run shipped assignee:me
+
+  # Close every task a saved search finds
+  clickup search run shipped --ids | xargs -r clickup task edit --status done`,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeNames(f),
+		PersistentPreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.extra = args[1:]
+			return runRun(f, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.ids, "ids", false, "Print only task IDs, one per line")
+	cmdutil.AddPageFlags(cmd, &opts.pageFlags, "tasks")
+	cmdutil.AddTableFlags(cmd, &opts.tableFlags, "search-run", cmdutil.TaskColumns)
+	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
+
+	return cmd
+}
+
+func runRun(f *cmdutil.Factory, opts *runOptions) error {
+	ios := f.IOStreams
+
+	searches, err := loadSearches(f)
+	if err != nil {
+		return err
+	}
+	s, ok := searches[opts.name]
+	if !ok {
+		return fmt.Errorf("no such search %q. Run 'clickup search list' to see saved searches", opts.name)
+	}
+	query := strings.Join(append([]string{s.Query}, opts.extra...), " ")
+	expr, err := cmdutil.ParseTaskFilter(query)
+	if err != nil {
+		return fmt.Errorf("search %s: %w", opts.name, err)
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	if cfg.Workspace == "" {
+		return fmt.Errorf("workspace not configured. Run 'clickup config set workspace <id>' first")
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	filter, err := expr.Compile(cmdutil.FilterOptions{
+		ResolveUser: cmdutil.FilterUserResolver(ctx, client),
+		Team:        true,
+	})
+	if err != nil {
+		return fmt.Errorf("search %s: %w", opts.name, err)
+	}
+
+	pageOpts := opts.pageFlags.Options()
+	// --limit counts matching tasks, so it applies after local filters.
+	limit := 0
+	if filter.Local() {
+		limit, pageOpts.Limit = pageOpts.Limit, 0
+		if !opts.pageFlags.Paging() {
+			pageOpts.MaxPages = localPages
+		}
+	}
+	seq := pager.Pages(ctx, pageOpts,
+		apiv2.TeamTaskPages(client, cfg.Workspace, filter.Params.Encode()))
+	if filter.Local() {
+		seq = pager.Limit(pager.Filter(seq, filter.Match), limit)
+	}
+
+	tasks, err := pager.Collect(seq)
+	if err != nil {
+		return fmt.Errorf("failed to run search: %w", err)
+	}
+
+	if opts.ids {
+		// Fail rather than print nothing, so a script passing the IDs on
+		// does not run its command with none.
+		if len(tasks) == 0 {
+			fmt.Fprintln(ios.ErrOut, "No tasks found.")
+			return &cmdutil.SilentError{Err: fmt.Errorf("no tasks found")}
+		}
+		for _, t := range tasks {
+			fmt.Fprintln(ios.Out, t.ID)
+		}
+		return nil
+	}
+
+	if len(tasks) == 0 {
+		fmt.Fprintln(ios.ErrOut, "No tasks found.")
+		return nil
+	}
+
+	if opts.jsonFlags.WantsJSON() {
+		return opts.jsonFlags.OutputJSON(ios.Out, tasks)
+	}
+
+	return printTasks(f, &opts.tableFlags, tasks)
+}
+
+func printTasks(f *cmdutil.Factory, flags *cmdutil.TableFlags, tasks []clickup.Task) error {
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	tt := cmdutil.NewTaskTable(f, runColumns, flags.CustomFields(cfg))
+	for _, t := range tasks {
+		tt.AddTask(t)
+	}
+	tbl := tt.Table()
+	if err := flags.Apply(cfg, tbl); err != nil {
+		return err
+	}
+	return f.PrintTable(tbl)
+}
@@ -0,0 +1,128 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type saveOptions struct {
+	name    string
+	query   string
+	local   bool
+	clobber bool
+}
+
+// NewCmdSave returns the "search save" command.
+func NewCmdSave(f *cmdutil.Factory) *cobra.Command {
+	opts := &saveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "save <name> <query>",
+		Short: "Save a task filter expression under a name",
+		Long: `Save a filter expression, in the syntax of 'clickup task list', under a
+name for 'clickup search run'. The query is checked before it is saved.
+
+With --local the search is kept in the project's .clickup-searches.yml,
+which is created at the root of the git repository if there is none.`,
+		Example: `  # Tasks finished this week
+  clickup search save shipped 'status:done updated>-7d'
+
+  # My open bugs, shared with everyone working in this repository
+  clickup search save --local my-bugs 'assignee:me is:open tag:bug'`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.query = strings.Join(args[1:], " ")
+			return saveRun(f, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.local, "local", false, "Save in the project's "+config.SearchesFile+" instead of config.yml")
+	cmd.Flags().BoolVar(&opts.clobber, "clobber", false, "Overwrite an existing search of the same name")
+
+	return cmd
+}
+
+func saveRun(f *cmdutil.Factory, opts *saveOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	name := opts.name
+	if name == "" || strings.ContainsAny(name, " \t\n") || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid search name %q", name)
+	}
+	query := strings.TrimSpace(opts.query)
+	if query == "" {
+		return fmt.Errorf("search query cannot be empty")
+	}
+	if _, err := cmdutil.ParseTaskFilter(query); err != nil {
+		return &cmdutil.FlagError{Err: err}
+	}
+
+	// A project search of the same name shadows a global one.
+	searches, err := loadSearches(f)
+	if err != nil {
+		return err
+	}
+	shadowedBy := searches[name].Path
+
+	var (
+		stored map[string]string
+		save   func() error
+	)
+	if opts.local {
+		path := projectSearchesFile()
+		if path == "" {
+			if path, err = newProjectSearchesFile(); err != nil {
+				return err
+			}
+		}
+		if stored, err = config.LoadSearches(path); err != nil {
+			return err
+		}
+		save = func() error {
+			if err := config.SaveSearches(path, stored); err != nil {
+				return fmt.Errorf("failed to save %s: %w", path, err)
+			}
+			return nil
+		}
+	} else {
+		cfg, err := f.Config()
+		if err != nil {
+			return err
+		}
+		if cfg.Searches == nil {
+			cfg.Searches = map[string]string{}
+		}
+		stored = cfg.Searches
+		save = func() error {
+			if err := cfg.Save(); err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+			return nil
+		}
+	}
+
+	existing, exists := stored[name]
+	if exists && !opts.clobber {
+		return fmt.Errorf("search %q already exists (%s). Use --clobber to overwrite it", name, existing)
+	}
+	stored[name] = query
+	if err := save(); err != nil {
+		return err
+	}
+
+	verb := "Saved"
+	if exists {
+		verb = "Changed"
+	}
+	fmt.Fprintf(ios.Out, "%s %s search %s: %s\n", cs.Green("!"), verb, cs.Bold(name), query)
+	if !opts.local && shadowedBy != "" {
+		fmt.Fprintf(ios.ErrOut, "%s The search %s in %s takes precedence over this one\n", cs.Yellow("!"), name, shadowedBy)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package search
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// NewCmdSearch returns the "search" parent command.
+func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <command>",
+		Short: "Save and run task filter expressions",
+		Long: `Saved searches are named task filter expressions, in the syntax of
+'clickup task list', that run across the workspace.
+
+Searches are kept in your config.yml, or with --local in a project's
+` + "`.clickup-searches.yml`" + `, found by walking up from the current directory.
+Project searches take precedence over global ones of the same name.
+
+'clickup search run <name> --ids' prints one task ID per line, so a saved
+search can feed any command that takes a list of task IDs.`,
+	}
+
+	cmd.AddCommand(NewCmdSave(f))
+	cmd.AddCommand(NewCmdRun(f))
+	cmd.AddCommand(NewCmdList(f))
+	cmd.AddCommand(NewCmdDelete(f))
+
+	return cmd
+}
@@ -0,0 +1,126 @@
+package search
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+// newSearchTest runs in an empty project directory with its own config
+// directory, holding one global search.
+func newSearchTest(t *testing.T) (*testutil.TestFactory, *config.Config) {
+	t.Setenv("CLICKUP_CONFIG_DIR", t.TempDir())
+	t.Chdir(t.TempDir())
+	tf := testutil.NewTestFactory(t)
+	cfg, err := tf.Factory.Config()
+	require.NoError(t, err)
+	cfg.Searches = map[string]string{"mine": "assignee:me is:open"}
+	return tf, cfg
+}
+
+func runSearch(tf *testutil.TestFactory, args ...string) error {
+	tf.OutBuf.Reset()
+	tf.ErrBuf.Reset()
+	root := &cobra.Command{Use: "clickup", SilenceErrors: true, SilenceUsage: true}
+	root.AddCommand(NewCmdSearch(tf.Factory))
+	root.SetOut(tf.OutBuf)
+	root.SetErr(tf.ErrBuf)
+	root.SetArgs(append([]string{"search"}, args...))
+	return root.Execute()
+}
+
+func TestSearchSave(t *testing.T) {
+	tf, cfg := newSearchTest(t)
+
+	require.NoError(t, runSearch(tf, "save", "shipped", "status:done", "updated>-7d"))
+	assert.Equal(t, "status:done updated>-7d", cfg.Searches["shipped"])
+	assert.Contains(t, tf.OutBuf.String(), "Saved search shipped")
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Searches, loaded.Searches)
+
+	err = runSearch(tf, "save", "shipped", "status:closed")
+	assert.ErrorContains(t, err, "--clobber")
+	require.NoError(t, runSearch(tf, "save", "--clobber", "shipped", "status:closed"))
+	assert.Contains(t, tf.OutBuf.String(), "Changed search shipped")
+
+	err = runSearch(tf, "save", "bad", "due<soon")
+	assert.ErrorContains(t, err, `invalid date "soon"`)
+	err = runSearch(tf, "save", "two words", "tag:bug")
+	assert.ErrorContains(t, err, "invalid search name")
+	assert.NotContains(t, cfg.Searches, "bad")
+}
+
+func TestSearchSave_Local(t *testing.T) {
+	tf, cfg := newSearchTest(t)
+
+	require.NoError(t, runSearch(tf, "save", "--local", "mine", "assignee:me tag:bug"))
+	assert.Equal(t, "assignee:me is:open", cfg.Searches["mine"], "global search is untouched")
+
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	path := filepath.Join(dir, config.SearchesFile)
+	local, err := config.LoadSearches(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"mine": "assignee:me tag:bug"}, local)
+
+	// The project file is found from subdirectories and takes precedence.
+	require.NoError(t, os.Mkdir("sub", 0o755))
+	t.Chdir("sub")
+	require.NoError(t, runSearch(tf, "list", "--json"))
+	var listed []savedSearch
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &listed))
+	assert.Equal(t, []savedSearch{{Name: "mine", Query: "assignee:me tag:bug", Path: path}}, listed)
+
+	require.NoError(t, runSearch(tf, "delete", "mine"))
+	local, err = config.LoadSearches(path)
+	require.NoError(t, err)
+	assert.Empty(t, local)
+	assert.Contains(t, cfg.Searches, "mine", "the global search is deleted separately")
+}
+
+func TestSearchRun(t *testing.T) {
+	tf, cfg := newSearchTest(t)
+	tf.Factory.Format = "csv"
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	a := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login", Tags: []fakeclickup.Tag{{Name: "bug"}}})
+	b := fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix logout", Tags: []fakeclickup.Tag{{Name: "bug"}}})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Write docs"})
+	cfg.Searches["bugs"] = "tag:bug"
+
+	require.NoError(t, runSearch(tf, "run", "bugs", "--ids"))
+	assert.ElementsMatch(t, []string{a.ID, b.ID}, strings.Fields(tf.OutBuf.String()))
+
+	require.NoError(t, runSearch(tf, "run", "bugs", "logout", "--columns", "name"))
+	assert.Equal(t, "NAME\nFix logout\n", tf.OutBuf.String())
+
+	cfg.Searches["none"] = "tag:missing"
+	err := runSearch(tf, "run", "none", "--ids")
+	assert.True(t, cmdutil.IsSilentError(err), "an empty --ids result fails: %v", err)
+	assert.Empty(t, tf.OutBuf.String())
+	assert.Equal(t, "No tasks found.\n", tf.ErrBuf.String())
+
+	err = runSearch(tf, "run", "nope")
+	assert.ErrorContains(t, err, `no such search "nope"`)
+}
+
+func TestSearchRun_Completion(t *testing.T) {
+	tf, cfg := newSearchTest(t)
+	cfg.Searches["bugs"] = "tag:bug"
+
+	names, directive := completeNames(tf.Factory)(NewCmdRun(tf.Factory), nil, "b")
+	assert.Equal(t, []string{"bugs\ttag:bug"}, names)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
@@ -0,0 +1,98 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/git"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// savedSearch is a named filter expression and where it is kept.
+type savedSearch struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	// Path is the project file holding the search, or "" for config.yml.
+	Path string `json:"path,omitempty"`
+}
+
+// loadSearches returns the saved searches by name. Searches in the
+// project file shadow global ones of the same name.
+func loadSearches(f *cmdutil.Factory) (map[string]savedSearch, error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	searches := map[string]savedSearch{}
+	for name, query := range cfg.Searches {
+		searches[name] = savedSearch{Name: name, Query: query}
+	}
+
+	path := projectSearchesFile()
+	if path == "" {
+		return searches, nil
+	}
+	local, err := config.LoadSearches(path)
+	if err != nil {
+		return nil, err
+	}
+	for name, query := range local {
+		searches[name] = savedSearch{Name: name, Query: query, Path: path}
+	}
+	return searches, nil
+}
+
+// projectSearchesFile returns the nearest project searches file, or "".
+func projectSearchesFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return config.FindSearchesFile(dir)
+}
+
+// newProjectSearchesFile returns where to create a project searches file:
+// the root of the current git repository, or the current directory.
+func newProjectSearchesFile() (string, error) {
+	if dir, err := git.NewClient().TopLevel(); err == nil && dir != "" {
+		return filepath.Join(dir, config.SearchesFile), nil
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, config.SearchesFile), nil
+}
+
+func sortedNames(searches map[string]savedSearch) []string {
+	names := make([]string, 0, len(searches))
+	for name := range searches {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// completeNames completes the first argument with saved search names.
+func completeNames(f *cmdutil.Factory) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		searches, err := loadSearches(f)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		var names []string
+		for _, name := range sortedNames(searches) {
+			if strings.HasPrefix(name, toComplete) {
+				names = append(names, name+"\t"+searches[name].Query)
+			}
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -83,7 +83,8 @@ func NewCmdSpaceSelect(f *cmdutil.Factory) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				cfg.SetDirectoryDefault(dir, config.DirectoryConfig{Space: selectedID})
+				// A new space resets the folder and list but keeps the profile binding.
+				cfg.SetDirectoryDefault(dir, config.DirectoryConfig{Space: selectedID, Profile: cfg.DirectoryDefaults[dir].Profile})
 				if err := cfg.Save(); err != nil {
 					return err
 				}
@@ -3,15 +3,17 @@ package sprint
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
-	"net/url"
-
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
 	"github.com/triptechtravel/clickup-cli/internal/text"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
@@ -20,6 +22,7 @@ import (
 // NewCmdSprintCurrent returns the sprint current command.
 func NewCmdSprintCurrent(f *cmdutil.Factory) *cobra.Command {
 	var jsonFlags cmdutil.JSONFlags
+	var tableFlags cmdutil.TableFlags
 	var folderID string
 
 	cmd := &cobra.Command{
@@ -29,22 +32,30 @@ func NewCmdSprintCurrent(f *cmdutil.Factory) *cobra.Command {
 
 Finds the sprint whose dates contain today, then lists
 all tasks grouped by status with assignees, priorities,
-and linked GitHub branches.`,
+and linked GitHub branches.
+
+With --columns or --sort, or a default set saved under
+columns.sprint-current, tasks are shown in one table instead.
+Columns are those of 'clickup task list' plus sprint.`,
 		Example: `  # Show current sprint tasks
   clickup sprint current
 
   # Specify a sprint folder
   clickup sprint current --folder 132693664
 
+  # One table sorted by points, largest first
+  clickup sprint current --columns id,name,assignee,points --sort -points
+
   # JSON output
   clickup sprint current --json`,
 		PreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSprintCurrent(f, folderID, &jsonFlags)
+			return runSprintCurrent(f, folderID, &tableFlags, &jsonFlags)
 		},
 	}
 
 	cmd.Flags().StringVar(&folderID, "folder", "", "Sprint folder ID (auto-detected if not set)")
+	cmdutil.AddTableFlags(cmd, &tableFlags, "sprint-current", append(slices.Clone(cmdutil.TaskColumns), "sprint"))
 	cmdutil.AddJSONFlags(cmd, &jsonFlags)
 
 	return cmd
@@ -65,7 +76,10 @@ type sprintTaskEntry struct {
 	SprintName   string `json:"sprint_name"`
 }
 
-func runSprintCurrent(f *cmdutil.Factory, folderID string, jsonFlags *cmdutil.JSONFlags) error {
+// sprintColumns are the columns of the single sprint table.
+var sprintColumns = []string{"id", "name", "status", "assignee", "priority", "points", "estimate", "spent", "due", "sprint"}
+
+func runSprintCurrent(f *cmdutil.Factory, folderID string, tableFlags *cmdutil.TableFlags, jsonFlags *cmdutil.JSONFlags) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
 	ctx := context.Background()
@@ -169,31 +183,26 @@ func runSprintCurrent(f *cmdutil.Factory, folderID string, jsonFlags *cmdutil.JS
 	due := parseMSTimestamp(currentList.DueDate)
 	tc, _ := currentList.TaskCount.Int64()
 
-	fmt.Fprintf(ios.Out, "%s  %s  %s\n\n",
-		cs.Bold(currentList.Name),
-		cs.Cyan(formatDateRange(start, due)),
-		cs.Gray(text.Pluralize(int(tc), "task")),
-	)
+	if !jsonFlags.WantsJSON() && !f.WantsFormat() {
+		fmt.Fprintf(ios.Out, "%s  %s  %s\n\n",
+			cs.Bold(currentList.Name),
+			cs.Cyan(formatDateRange(start, due)),
+			cs.Gray(text.Pluralize(int(tc), "task")),
+		)
+	}
 
 	// Fetch tasks in the sprint.
-	var allTasks []clickup.Task
-	for page := 0; ; page++ {
-		q := url.Values{}
-		q.Set("include_closed", "true")
-		q.Set("subtasks", "true")
-		q.Set("page", strconv.Itoa(page))
-		tasks, err := apiv2.GetTasksLocal(ctx, client, currentList.ID, "?"+q.Encode())
-		if err != nil {
-			return fmt.Errorf("failed to fetch sprint tasks: %w", err)
-		}
-		if len(tasks) == 0 {
-			break
-		}
-		allTasks = append(allTasks, tasks...)
+	q := url.Values{}
+	q.Set("include_closed", "true")
+	q.Set("subtasks", "true")
+	allTasks, err := pager.Collect(pager.Pages(ctx, pager.Options{},
+		apiv2.TaskPages[clickup.Task](client, "list/"+currentList.ID+"/task?"+q.Encode())))
+	if err != nil {
+		return fmt.Errorf("failed to fetch sprint tasks: %w", err)
 	}
 
 	if len(allTasks) == 0 {
-		fmt.Fprintln(ios.Out, "No tasks in this sprint.")
+		fmt.Fprintln(ios.ErrOut, "No tasks in this sprint.")
 		return nil
 	}
 
@@ -249,6 +258,26 @@ func runSprintCurrent(f *cmdutil.Factory, folderID string, jsonFlags *cmdutil.JS
 	if jsonFlags.WantsJSON() {
 		return jsonFlags.OutputJSON(ios.Out, entries)
 	}
+	if f.WantsFormat() || tableFlags.Customized(cfg) {
+		tt := cmdutil.NewTaskTable(f, sprintColumns, tableFlags.CustomFields(cfg),
+			tableprinter.Column{Header: "SPRINT", Key: "sprint_name"})
+		for _, t := range allTasks {
+			tt.AddTask(t, currentList.Name)
+		}
+		tbl := tt.Table()
+		if err := tableFlags.Apply(cfg, tbl); err != nil {
+			return err
+		}
+		if err := f.PrintTable(tbl); err != nil {
+			return err
+		}
+		if f.WantsFormat() {
+			return nil
+		}
+		fmt.Fprintln(ios.Out)
+		printSprintFooter(ios)
+		return nil
+	}
 
 	// Group by status for display.
 	statusGroups := make(map[string][]sprintTaskEntry)
@@ -288,14 +317,18 @@ func runSprintCurrent(f *cmdutil.Factory, folderID string, jsonFlags *cmdutil.JS
 		fmt.Fprintln(ios.Out)
 	}
 
-	// Quick actions footer
+	printSprintFooter(ios)
+	return nil
+}
+
+// printSprintFooter prints the quick actions shown after the sprint tasks.
+func printSprintFooter(ios *iostreams.IOStreams) {
+	cs := ios.ColorScheme()
 	fmt.Fprintln(ios.Out, cs.Gray("---"))
 	fmt.Fprintln(ios.Out, cs.Gray("Quick actions:"))
 	fmt.Fprintf(ios.Out, "  %s  clickup task view <id>\n", cs.Gray("View:"))
 	fmt.Fprintf(ios.Out, "  %s  clickup task edit <id> --status <status>\n", cs.Gray("Edit:"))
 	fmt.Fprintf(ios.Out, "  %s  clickup sprint current --json\n", cs.Gray("JSON:"))
-
-	return nil
 }
 
 // formatSprintDuration converts milliseconds to a human-readable duration string.
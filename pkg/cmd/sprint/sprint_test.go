@@ -3,6 +3,11 @@ package sprint
 import (
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
 )
 
 func TestParseMSTimestamp(t *testing.T) {
@@ -197,3 +202,19 @@ func TestClassifySprint(t *testing.T) {
 		})
 	}
 }
+
+func TestSprintCurrent_Cassette(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.SetConfig(&config.Config{Workspace: "12345", SprintFolder: "f1"})
+	tf.Replay(t, "testdata/sprint_current.yaml")
+
+	cmd := NewCmdSprintCurrent(tf.Factory)
+	err := testutil.RunCommand(t, cmd, "--folder", "f1")
+	require.NoError(t, err)
+
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "Sprint 2 (15/1 - 28/1)")
+	assert.Contains(t, out, "Fix login timeout")
+	assert.Contains(t, out, "Ship release notes")
+	assert.Contains(t, tf.ErrBuf.String(), "No active sprint found")
+}
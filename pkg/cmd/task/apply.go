@@ -0,0 +1,465 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/api/clickupv2"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/git"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/prompter"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type applyOptions struct {
+	file    string
+	listID  string
+	confirm bool
+}
+
+// NewCmdApply returns a command to sync tasks with a markdown plan.
+func NewCmdApply(f *cmdutil.Factory) *cobra.Command {
+	opts := &applyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply <file>",
+		Short: "Create and update tasks from a markdown plan",
+		Long: `Bring tasks in line with a markdown outline of tasks, subtasks and
+checklists.
+
+The shallowest heading level in the file names tasks, and the level below
+names their subtasks. Deeper headings name checklists of the task above
+them. Task list items ("- [ ] item", "- [x] done") are checklist items;
+items that are not under a checklist heading go in a checklist named
+"Checklist".
+
+If the first paragraph under a task heading is only "key: value" lines, it
+sets fields of the task: any field 'clickup task export' writes, such as
+status, priority, assignees, tags, due_date or points, or a custom field
+by name. A custom field named like one of those is written field:<name>.
+The rest of a task's section is its markdown description.
+
+YAML front matter between --- lines sets fields for every task, which the
+task's own lines override. Its list key names the list new tasks are
+created in; --list or the configured default list are used otherwise.
+
+Tasks are created the first time the file is applied, and their IDs are
+written back after their headings as <!-- id: ... --> comments. Applying
+the file again updates those tasks instead of creating new ones. Only
+fields that differ are changed, and checklist items are added or resolved
+but never removed.
+
+The changes are listed before anything is written, and confirmed on a
+terminal unless --yes is passed. Use --dry-run to only list them.`,
+		Example: `  # plan.md:
+  #   ---
+  #   list: 12345
+  #   tags: [checkout]
+  #   ---
+  #   # Checkout redesign
+  #
+  #   priority: high
+  #
+  #   Replace the legacy checkout flow.
+  #
+  #   ## Payment form
+  #
+  #   - [ ] Card input
+  #   - [ ] Validation
+  clickup task apply plan.md
+
+  # Preview the changes only
+  clickup --dry-run task apply plan.md`,
+		Args:              cobra.ExactArgs(1),
+		PersistentPreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.file = args[0]
+			return runApply(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listID, "list", "", "List to create new tasks in (defaults to the plan's list, then the configured list)")
+	cmd.Flags().BoolVarP(&opts.confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// applyPlan is what applying a plan does to one task.
+type applyPlan struct {
+	plan *planTask
+	// task is the task as it is, or a blank task to be created.
+	task        *clickup.Task
+	create      bool
+	update      *taskUpdate
+	description *string
+	checklists  []checklistPlan
+}
+
+// checklistPlan is the change to one checklist of a task.
+type checklistPlan struct {
+	name string
+	// id is empty for a checklist to be created.
+	id      string
+	add     []planItem
+	resolve []checklistItemState
+}
+
+// checklistItemState is an existing checklist item to resolve or reopen.
+type checklistItemState struct {
+	id, name string
+	resolved bool
+}
+
+func (p *applyPlan) changed() bool {
+	return p.create || len(p.update.changes) > 0 || p.description != nil || len(p.checklists) > 0
+}
+
+func runApply(f *cmdutil.Factory, opts *applyOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	src, err := os.ReadFile(opts.file)
+	if err != nil {
+		return err
+	}
+	doc, err := parsePlan(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+	tasks := doc.flatten()
+	if len(tasks) == 0 {
+		return fmt.Errorf("%s has no task headings", opts.file)
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	listID := opts.listID
+	if listID == "" {
+		listID = doc.list
+	}
+	if listID == "" {
+		dir, _ := os.Getwd()
+		listID = cfg.ListForDir(dir)
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resolve := newUserResolver(ctx, client)
+	var listFields []clickup.CustomField
+
+	plans := make(map[*planTask]*applyPlan, len(tasks))
+	for _, t := range tasks {
+		p := &applyPlan{plan: t}
+		if t.id != "" {
+			parsed := git.ParseTaskID(t.id)
+			p.task, err = apiv2.GetTaskLocal(ctx, client, parsed.ID, cmdutil.CustomIDTaskQueryMD(cfg, parsed.IsCustomID))
+			if err != nil {
+				return fmt.Errorf("%q: failed to fetch task %s: %w", t.name, t.id, err)
+			}
+		} else {
+			if listID == "" {
+				return fmt.Errorf("no list to create %q in. Use --list, a list key in the front matter, or run 'clickup list select'", t.name)
+			}
+			if listFields == nil {
+				if listFields, err = apiv2.GetAccessibleCustomFieldsLocal(ctx, client, listID); err != nil {
+					return fmt.Errorf("failed to fetch custom fields: %w", err)
+				}
+			}
+			p.task, p.create = &clickup.Task{CustomFields: listFields}, true
+		}
+
+		rec := maps.Clone(doc.defaults)
+		maps.Copy(rec, t.fields)
+		rec["name"] = t.name
+		if t.parent != nil && !plans[t.parent].create {
+			rec["parent"] = plans[t.parent].task.ID
+		}
+		p.update, err = planUpdate(p.task, rec, resolve)
+		if err != nil {
+			return fmt.Errorf("%q: %w", t.name, err)
+		}
+		if t.description != nil && (p.create || !sameRecordValue("description", p.task.MarkdownDescription, *t.description)) {
+			p.description = t.description
+		}
+		p.checklists = planChecklists(p.task, t.checklists)
+		plans[t] = p
+	}
+
+	var changed int
+	for _, t := range tasks {
+		p := plans[t]
+		if !p.changed() {
+			continue
+		}
+		changed++
+		printApplyPlan(ios, p)
+	}
+	if unchanged := len(tasks) - changed; unchanged > 0 {
+		fmt.Fprintln(ios.Out, cs.Gray(text.Pluralize(unchanged, "task")+" unchanged"))
+	}
+	if changed == 0 {
+		fmt.Fprintln(ios.ErrOut, "No changes to apply.")
+		return nil
+	}
+
+	if !opts.confirm && !f.DryRun && ios.IsTerminal() {
+		ok, err := prompter.New(ios).Confirm(fmt.Sprintf("Apply changes to %s?", text.Pluralize(changed, "task")), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(ios.ErrOut, "Cancelled.")
+			return nil
+		}
+	}
+
+	created := map[*planTask]string{}
+	var nCreated, nUpdated, failed int
+	for _, t := range tasks {
+		p := plans[t]
+		if !p.changed() {
+			continue
+		}
+		label := applyLabel(p)
+		if p.create {
+			if t.parent != nil {
+				// A parent created above has its new task in its plan.
+				parentID := plans[t.parent].task.ID
+				if parentID == "" && !f.DryRun {
+					fmt.Fprintf(ios.ErrOut, "%s skipped %s: its parent was not created\n", cs.Red("✗"), label)
+					failed++
+					continue
+				}
+				p.update.body["parent"] = parentID
+			}
+			task, err := createFromUpdate(ctx, client, listID, p.update)
+			if task == nil {
+				fmt.Fprintf(ios.ErrOut, "%s failed to create %s: %v\n", cs.Red("✗"), label, err)
+				failed++
+				continue
+			}
+			// The task exists from here on, even if a later step fails.
+			p.task = task
+			created[t] = task.ID
+			nCreated++
+			if err != nil {
+				fmt.Fprintf(ios.ErrOut, "%s created %s but %v\n", cs.Yellow("!"), label, err)
+				failed++
+				continue
+			}
+		} else if err := p.update.apply(ctx, client, p.task); err != nil {
+			fmt.Fprintf(ios.ErrOut, "%s failed to update %s: %v\n", cs.Red("✗"), label, err)
+			failed++
+			continue
+		}
+
+		if err := p.applyRest(ctx, client); err != nil {
+			if p.create {
+				fmt.Fprintf(ios.ErrOut, "%s created %s but %v\n", cs.Yellow("!"), label, err)
+			} else {
+				fmt.Fprintf(ios.ErrOut, "%s %s: %v\n", cs.Red("✗"), label, err)
+			}
+			failed++
+			continue
+		}
+		if !p.create {
+			nUpdated++
+		}
+		// Under --dry-run the plan and the held requests are the output.
+		if f.DryRun {
+			continue
+		}
+		if p.create {
+			fmt.Fprintf(ios.Out, "Created task %s %s\n", cs.Bold(t.name), cs.Gray("#"+p.task.ID))
+		} else {
+			fmt.Fprintf(ios.Out, "Updated task %s\n", label)
+		}
+	}
+
+	if !f.DryRun {
+		if len(created) > 0 {
+			if err := os.WriteFile(opts.file, writePlanIDs(src, tasks, created), 0o644); err != nil {
+				return fmt.Errorf("failed to write task IDs to %s: %w", opts.file, err)
+			}
+			fmt.Fprintf(ios.ErrOut, "Wrote %s to %s\n", text.Pluralize(len(created), "task ID"), opts.file)
+		}
+		fmt.Fprintf(ios.Out, "\n%s Applied %s: %d created, %d updated\n", cs.Green("!"), opts.file, nCreated, nUpdated)
+	}
+	if failed > 0 {
+		return &cmdutil.SilentError{Err: fmt.Errorf("%d of %d tasks failed", failed, changed)}
+	}
+	return nil
+}
+
+// planChecklists works out the checklist changes that give t the items of
+// a plan task. Items that are not in the plan are kept.
+func planChecklists(t *clickup.Task, want []*planChecklist) []checklistPlan {
+	var out []checklistPlan
+	for _, cl := range want {
+		cp := checklistPlan{name: cl.name}
+		var existing *clickup.Checklist
+		for i := range t.Checklists {
+			if strings.EqualFold(t.Checklists[i].Name, cl.name) {
+				existing = &t.Checklists[i]
+				cp.id = existing.ID
+				break
+			}
+		}
+		for _, item := range cl.items {
+			var found *clickup.Item
+			if existing != nil {
+				for i := range existing.Items {
+					if strings.EqualFold(existing.Items[i].Name, item.name) {
+						found = &existing.Items[i]
+						break
+					}
+				}
+			}
+			switch {
+			case found == nil:
+				cp.add = append(cp.add, item)
+			case found.Resolved != item.resolved:
+				cp.resolve = append(cp.resolve, checklistItemState{found.ID, found.Name, item.resolved})
+			}
+		}
+		if cp.id == "" || len(cp.add) > 0 || len(cp.resolve) > 0 {
+			out = append(out, cp)
+		}
+	}
+	return out
+}
+
+// applyRest sets the description and checklists of a task once its fields
+// have been updated.
+func (p *applyPlan) applyRest(ctx context.Context, client *api.Client) error {
+	if p.description != nil {
+		if err := setMarkdownDescription(client, p.task.ID, *p.description); err != nil {
+			return fmt.Errorf("failed to set description: %w", err)
+		}
+	}
+
+	var resolveNew []string
+	for i, cp := range p.checklists {
+		if cp.id == "" {
+			resp, err := apiv2.CreateChecklist(ctx, client, p.task.ID, &clickupv2.CreateChecklistJSONRequest{Name: cp.name})
+			if err != nil {
+				return fmt.Errorf("failed to create checklist %q: %w", cp.name, err)
+			}
+			p.checklists[i].id = resp.Checklist.ID
+			cp.id = resp.Checklist.ID
+		}
+		for _, item := range cp.add {
+			name := item.name
+			if _, err := apiv2.CreateChecklistItem(ctx, client, cp.id, &clickupv2.CreateChecklistItemJSONRequest{Name: &name}); err != nil {
+				return fmt.Errorf("failed to add checklist item %q: %w", item.name, err)
+			}
+			if item.resolved {
+				resolveNew = append(resolveNew, cp.name+"\x00"+item.name)
+			}
+		}
+		for _, st := range cp.resolve {
+			if err := setChecklistItemResolved(ctx, client, cp.id, st.id, st.resolved); err != nil {
+				return fmt.Errorf("failed to update checklist item %q: %w", st.name, err)
+			}
+		}
+	}
+	if len(resolveNew) == 0 || p.task.ID == "" {
+		return nil
+	}
+
+	// Items are created open; look up the new ones to resolve them.
+	task, err := apiv2.GetTaskLocal(ctx, client, p.task.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to resolve checklist items: %w", err)
+	}
+	for _, cl := range task.Checklists {
+		for _, item := range cl.Items {
+			key := strings.ToLower(cl.Name + "\x00" + item.Name)
+			for _, want := range resolveNew {
+				if strings.ToLower(want) == key && !item.Resolved {
+					if err := setChecklistItemResolved(ctx, client, cl.ID, item.ID, true); err != nil {
+						return fmt.Errorf("failed to resolve checklist item %q: %w", item.Name, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func setChecklistItemResolved(ctx context.Context, client *api.Client, checklistID, itemID string, resolved bool) error {
+	_, err := apiv2.EditChecklistItem(ctx, client, checklistID, itemID, &clickupv2.EditChecklistItemJSONRequest{
+		Resolved: &resolved,
+	})
+	return err
+}
+
+// applyLabel names the task a plan is for.
+func applyLabel(p *applyPlan) string {
+	if p.create {
+		return fmt.Sprintf("%q", p.plan.name)
+	}
+	id := p.task.ID
+	if p.task.CustomID != "" {
+		id = p.task.CustomID
+	}
+	return id + " " + p.task.Name
+}
+
+func printApplyPlan(ios *iostreams.IOStreams, p *applyPlan) {
+	cs := ios.ColorScheme()
+	verb := "Update"
+	if p.create {
+		verb = "Create"
+	}
+	line := cs.Bold(verb) + " " + applyLabel(p)
+	if p.plan.parent != nil && p.create {
+		line += cs.Gray(fmt.Sprintf(" (subtask of %q)", p.plan.parent.name))
+	}
+	fmt.Fprintln(ios.Out, line)
+	for _, c := range p.update.changes {
+		if c.Field == "name" && p.create {
+			continue
+		}
+		fmt.Fprintf(ios.Out, "  %s: %s → %s\n", c.Field, previewValue(cs, c.From), previewValue(cs, c.To))
+	}
+	if p.description != nil {
+		fmt.Fprintf(ios.Out, "  description: %s\n", previewValue(cs, *p.description))
+	}
+	for _, cp := range p.checklists {
+		var items []string
+		for _, item := range cp.add {
+			s := "+ " + item.name
+			if item.resolved {
+				s += " ✓"
+			}
+			items = append(items, s)
+		}
+		for _, st := range cp.resolve {
+			if st.resolved {
+				items = append(items, "✓ "+st.name)
+			} else {
+				items = append(items, "○ "+st.name)
+			}
+		}
+		name := cp.name
+		if cp.id == "" {
+			name += " (new)"
+		}
+		fmt.Fprintf(ios.Out, "  checklist %s: %s\n", name, strings.Join(items, ", "))
+	}
+}
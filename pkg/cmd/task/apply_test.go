@@ -0,0 +1,162 @@
+package task
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func writePlan(t *testing.T, src string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "plan.md")
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	return path
+}
+
+func tasksByName(fake *fakeclickup.Server) map[string]fakeclickup.Task {
+	out := map[string]fakeclickup.Task{}
+	for _, tk := range fake.Tasks() {
+		out[tk.Name] = tk
+	}
+	return out
+}
+
+func TestApplyCommand_RoundTrip(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+
+	plan := writePlan(t, "---\nlist: "+list.ID+"\ntags: [checkout]\n---\n"+`# Checkout redesign
+
+priority: high
+
+Replace the legacy flow.
+
+### Launch
+
+- [ ] Update docs
+- [x] Write tests
+
+## Payment form
+
+## Receipts
+`)
+
+	err := testutil.RunCommand(t, NewCmdApply(tf.Factory), plan, "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "Applied "+plan+": 3 created, 0 updated")
+
+	byName := tasksByName(fake)
+	require.Len(t, byName, 3)
+	epic := byName["Checkout redesign"]
+	assert.Equal(t, "high", epic.Priority.Priority)
+	assert.Equal(t, "Replace the legacy flow.", epic.MarkdownDescription)
+	require.Len(t, epic.Tags, 1)
+	assert.Equal(t, "checkout", epic.Tags[0].Name)
+	assert.Equal(t, epic.ID, byName["Payment form"].Parent)
+	assert.Equal(t, epic.ID, byName["Receipts"].Parent)
+
+	require.Len(t, epic.Checklists, 1)
+	launch := epic.Checklists[0]
+	assert.Equal(t, "Launch", launch.Name)
+	require.Len(t, launch.Items, 2)
+	assert.Equal(t, "Update docs", launch.Items[0].Name)
+	assert.False(t, launch.Items[0].Resolved)
+	assert.Equal(t, "Write tests", launch.Items[1].Name)
+	assert.True(t, launch.Items[1].Resolved, "items checked in the plan are resolved after they are created")
+
+	written, err := os.ReadFile(plan)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), "# Checkout redesign <!-- id: "+epic.ID+" -->\n")
+	assert.Contains(t, string(written), "## Receipts <!-- id: "+byName["Receipts"].ID+" -->\n")
+
+	// Checking an item off resolves it on the task found by its written ID.
+	checked := strings.Replace(string(written), "- [ ] Update docs", "- [x] Update docs", 1)
+	require.NoError(t, os.WriteFile(plan, []byte(checked), 0o644))
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdApply(tf.Factory), plan, "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "0 created, 1 updated")
+	got, _ := fake.Task(epic.ID)
+	require.Len(t, got.Checklists, 1)
+	assert.True(t, got.Checklists[0].Items[0].Resolved)
+
+	tf.ErrBuf.Reset()
+	err = testutil.RunCommand(t, NewCmdApply(tf.Factory), plan, "--yes")
+	require.NoError(t, err)
+	assert.Contains(t, tf.ErrBuf.String(), "No changes to apply.")
+	assert.Len(t, fake.Tasks(), 3)
+}
+
+func TestApplyCommand_CountsCreatedTaskWhenChecklistFails(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	tf.HandleFunc("task/{task}/checklist", func(w http.ResponseWriter, r *http.Request) {
+		if tk, _ := fake.Task(r.PathValue("task")); tk.Name == "Receipts" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"err":"Checklist limit reached","ECODE":"CHECK_001"}`))
+			return
+		}
+		fake.ServeHTTP(w, r)
+	})
+
+	plan := writePlan(t, "---\nlist: "+list.ID+"\n---\n"+`# Checkout redesign
+
+## Payment form
+
+- [ ] Card fields
+
+## Receipts
+
+- [ ] Email template
+`)
+
+	err := testutil.RunCommand(t, NewCmdApply(tf.Factory), plan, "--yes")
+	require.EqualError(t, err, "1 of 3 tasks failed")
+	assert.Contains(t, tf.ErrBuf.String(), `created "Receipts" but failed to create checklist "Checklist"`)
+	assert.Contains(t, tf.OutBuf.String(), "3 created, 0 updated")
+
+	written, err := os.ReadFile(plan)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), "## Receipts <!-- id: "+tasksByName(fake)["Receipts"].ID+" -->\n",
+		"the created task's ID is written back so applying again updates it")
+}
+
+func TestApplyCommand_DryRun(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+
+	src := "---\nlist: " + list.ID + "\n---\n" + `# Epic one
+
+### Launch
+
+- [ ] Update docs
+
+## Child
+`
+	plan := writePlan(t, src)
+	tf.Factory.DryRun = true
+	err := testutil.RunCommand(t, NewCmdApply(tf.Factory), plan)
+	require.NoError(t, err)
+
+	assert.Empty(t, fake.Tasks())
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "Epic one", "the plan is still shown")
+	assert.NotContains(t, out, "Created task")
+	assert.NotContains(t, out, "Applied")
+	// The checklist item is added to the checklist created before it.
+	assert.Contains(t, tf.ErrBuf.String(), "[dry-run] POST "+tf.Server.URL+"/api/v2/checklist/dry-run-2/checklist_item\n")
+
+	written, err := os.ReadFile(plan)
+	require.NoError(t, err)
+	assert.Equal(t, src, string(written), "no IDs are written back")
+}
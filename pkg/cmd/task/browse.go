@@ -0,0 +1,724 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lithammer/fuzzysearch/fuzzy"
+	"github.com/spf13/cobra"
+	clickupv2 "github.com/triptechtravel/clickup-cli/api/clickupv2"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/browser"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
+	"github.com/triptechtravel/clickup-cli/internal/tui"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+const (
+	// browseTaskLimit caps the tasks loaded for one list.
+	browseTaskLimit = 500
+	// browseSplitWidth is the terminal width from which the task detail is
+	// shown beside the list instead of in place of it.
+	browseSplitWidth = 100
+)
+
+type browseOptions struct {
+	// terminal is set by tests; nil opens the controlling terminal.
+	terminal tui.Terminal
+	openURL  func(string) error
+}
+
+// NewCmdBrowse returns the "task browse" command.
+func NewCmdBrowse(f *cmdutil.Factory) *cobra.Command {
+	opts := &browseOptions{openURL: browser.Open}
+
+	cmd := &cobra.Command{
+		Use:   "browse",
+		Short: "Browse spaces, lists and tasks interactively",
+		Long: `Open a full-screen browser for the workspace.
+
+Drill down from spaces to folders, lists and tasks, filter any level as
+you type, and open a task to see its details. From a task you can set its
+status, assign someone, comment, start a timer or open it in the browser
+without leaving the screen.
+
+Keys:
+  ↑/↓ or j/k    move            enter or →    open
+  ← or esc      back            /             filter
+  s             set status      a             assign
+  c             comment         t             start timer
+  o             open in browser r             reload
+  q             quit
+
+If a default space is configured, browsing starts inside it.`,
+		Example: `  # Browse the workspace
+  clickup task browse`,
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return browseRun(f, opts)
+		},
+	}
+
+	return cmd
+}
+
+func browseRun(f *cmdutil.Factory, opts *browseOptions) error {
+	ios := f.IOStreams
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	if cfg.Workspace == "" {
+		return fmt.Errorf("workspace not configured. Run 'clickup config set workspace <id>' first")
+	}
+
+	in, inOK := ios.In.(*os.File)
+	out, outOK := ios.Out.(*os.File)
+	if opts.terminal == nil && (!ios.IsTerminal() || !inOK || !outOK) {
+		return fmt.Errorf("task browse needs an interactive terminal; use 'clickup task list' or 'clickup task search' in scripts")
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	m := newBrowseModel(context.Background(), client, cfg, ios.ColorScheme())
+	m.openURL = opts.openURL
+	if err := m.start(); err != nil {
+		return err
+	}
+
+	term := opts.terminal
+	if term == nil {
+		tty, err := tui.Open(in, out)
+		if err != nil {
+			return err
+		}
+		defer tty.Close()
+		term = tty
+	}
+	return tui.Run(term, m)
+}
+
+type browseKind int
+
+const (
+	browseSpace browseKind = iota
+	browseFolder
+	browseList
+	browseTask
+)
+
+type browseItem struct {
+	kind browseKind
+	id   string
+	name string
+	task *clickup.Task
+}
+
+// browsePane is one level of the space → folder → list → task drill-down.
+type browsePane struct {
+	title  string
+	items  []browseItem
+	load   func() ([]browseItem, error)
+	filter string
+	cursor int // index into visible()
+	offset int
+}
+
+// visible returns the items matching the pane's filter.
+func (p *browsePane) visible() []browseItem {
+	if p.filter == "" {
+		return p.items
+	}
+	var out []browseItem
+	for _, it := range p.items {
+		if fuzzy.MatchFold(p.filter, it.name) || (it.task != nil && strings.EqualFold(it.task.CustomID, p.filter)) || strings.EqualFold(it.id, p.filter) {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+func (p *browsePane) selected() *browseItem {
+	items := p.visible()
+	if p.cursor < 0 || p.cursor >= len(items) {
+		return nil
+	}
+	return &items[p.cursor]
+}
+
+// browseDetail is the task shown in the detail pane.
+type browseDetail struct {
+	task   *clickup.Task
+	lines  []string
+	scroll int
+}
+
+// browsePrompt reads a line of input for a quick action.
+type browsePrompt struct {
+	label  string
+	input  string
+	submit func(string) (string, error)
+}
+
+// browseModel is the task browser's state. It implements tui.Model.
+type browseModel struct {
+	ctx     context.Context
+	client  *api.Client
+	cfg     *config.Config
+	cs      *iostreams.ColorScheme
+	openURL func(string) error
+
+	panes     []*browsePane
+	detail    *browseDetail
+	filtering bool
+	prompt    *browsePrompt
+	message   string
+	failed    bool
+
+	// bodyHeight is the number of list rows in the last frame, used to page.
+	bodyHeight int
+}
+
+func newBrowseModel(ctx context.Context, client *api.Client, cfg *config.Config, cs *iostreams.ColorScheme) *browseModel {
+	return &browseModel{ctx: ctx, client: client, cfg: cfg, cs: cs, openURL: browser.Open, bodyHeight: 10}
+}
+
+// start loads the workspace's spaces, and opens the default space if one
+// is configured.
+func (m *browseModel) start() error {
+	if err := m.push("Spaces", m.loadSpaces); err != nil {
+		return err
+	}
+	if m.cfg.Space == "" {
+		return nil
+	}
+	root := m.panes[0]
+	for i, it := range root.items {
+		if it.id == m.cfg.Space {
+			root.cursor = i
+			m.open()
+			break
+		}
+	}
+	return nil
+}
+
+func (m *browseModel) pane() *browsePane {
+	return m.panes[len(m.panes)-1]
+}
+
+// push loads a new level and makes it current.
+func (m *browseModel) push(title string, load func() ([]browseItem, error)) error {
+	items, err := load()
+	if err != nil {
+		return err
+	}
+	m.panes = append(m.panes, &browsePane{title: title, items: items, load: load})
+	return nil
+}
+
+func (m *browseModel) loadSpaces() ([]browseItem, error) {
+	spaces, err := apiv2.GetSpacesLocal(m.ctx, m.client, m.cfg.Workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch spaces: %w", err)
+	}
+	items := make([]browseItem, len(spaces))
+	for i, s := range spaces {
+		items[i] = browseItem{kind: browseSpace, id: s.ID, name: s.Name}
+	}
+	return items, nil
+}
+
+// loadSpace returns a space's folders followed by its folderless lists.
+func (m *browseModel) loadSpace(spaceID string) ([]browseItem, error) {
+	folders, err := apiv2.GetFoldersLocal(m.ctx, m.client, spaceID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch folders: %w", err)
+	}
+	lists, err := apiv2.GetFolderlessListsLocal(m.ctx, m.client, spaceID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lists: %w", err)
+	}
+	var items []browseItem
+	for _, f := range folders {
+		items = append(items, browseItem{kind: browseFolder, id: f.ID, name: f.Name})
+	}
+	for _, l := range lists {
+		items = append(items, browseItem{kind: browseList, id: l.ID, name: l.Name})
+	}
+	return items, nil
+}
+
+func (m *browseModel) loadFolder(folderID string) ([]browseItem, error) {
+	lists, err := apiv2.GetListsLocal(m.ctx, m.client, folderID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lists: %w", err)
+	}
+	items := make([]browseItem, len(lists))
+	for i, l := range lists {
+		items[i] = browseItem{kind: browseList, id: l.ID, name: l.Name}
+	}
+	return items, nil
+}
+
+func (m *browseModel) loadTasks(listID string) ([]browseItem, error) {
+	tasks, err := pager.Collect(pager.Pages(m.ctx, pager.Options{Limit: browseTaskLimit},
+		apiv2.TaskPages[clickup.Task](m.client, "list/"+listID+"/task")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+	items := make([]browseItem, len(tasks))
+	for i := range tasks {
+		items[i] = browseItem{kind: browseTask, id: tasks[i].ID, name: tasks[i].Name, task: &tasks[i]}
+	}
+	return items, nil
+}
+
+// Update implements tui.Model.
+func (m *browseModel) Update(k tui.Key) bool {
+	if k.Code == tui.KeyCtrlC {
+		return true
+	}
+	if m.prompt != nil {
+		m.updatePrompt(k)
+		return false
+	}
+	if m.filtering {
+		m.updateFilter(k)
+		return false
+	}
+
+	m.message, m.failed = "", false
+	switch {
+	case k.Is('q'):
+		return true
+	case k.Is('/'):
+		m.detail = nil
+		m.filtering = true
+	case k.Code == tui.KeyUp || k.Is('k'):
+		m.move(-1)
+	case k.Code == tui.KeyDown || k.Is('j'):
+		m.move(1)
+	case k.Code == tui.KeyPgUp:
+		m.move(-m.bodyHeight)
+	case k.Code == tui.KeyPgDown:
+		m.move(m.bodyHeight)
+	case k.Code == tui.KeyHome || k.Is('g'):
+		m.move(-1 << 30)
+	case k.Code == tui.KeyEnd || k.Is('G'):
+		m.move(1 << 30)
+	case k.Code == tui.KeyEnter || k.Code == tui.KeyRight || k.Is('l'):
+		m.open()
+	case k.Code == tui.KeyLeft || k.Code == tui.KeyBackspace || k.Is('h'):
+		m.back(false)
+	case k.Code == tui.KeyEsc:
+		m.back(true)
+	case k.Is('r'):
+		m.reload()
+	case k.Is('s'):
+		m.withTask(m.promptStatus)
+	case k.Is('a'):
+		m.withTask(m.promptAssign)
+	case k.Is('c'):
+		m.withTask(m.promptComment)
+	case k.Is('t'):
+		m.withTask(m.startTimer)
+	case k.Is('o'):
+		m.withTask(m.openInBrowser)
+	}
+	return false
+}
+
+func (m *browseModel) updateFilter(k tui.Key) {
+	p := m.pane()
+	switch k.Code {
+	case tui.KeyRune:
+		p.filter += string(k.Rune)
+	case tui.KeyBackspace:
+		if p.filter == "" {
+			m.filtering = false
+			return
+		}
+		r := []rune(p.filter)
+		p.filter = string(r[:len(r)-1])
+	case tui.KeyEnter:
+		m.filtering = false
+		return
+	case tui.KeyEsc:
+		p.filter = ""
+		m.filtering = false
+	case tui.KeyUp:
+		m.move(-1)
+		return
+	case tui.KeyDown:
+		m.move(1)
+		return
+	default:
+		return
+	}
+	p.cursor, p.offset = 0, 0
+}
+
+func (m *browseModel) updatePrompt(k tui.Key) {
+	p := m.prompt
+	switch k.Code {
+	case tui.KeyRune:
+		p.input += string(k.Rune)
+	case tui.KeyBackspace:
+		if r := []rune(p.input); len(r) > 0 {
+			p.input = string(r[:len(r)-1])
+		}
+	case tui.KeyEsc:
+		m.prompt = nil
+		m.message = "Cancelled."
+	case tui.KeyEnter:
+		m.prompt = nil
+		input := strings.TrimSpace(p.input)
+		if input == "" {
+			m.message = "Cancelled."
+			return
+		}
+		msg, err := p.submit(input)
+		m.report(msg, err)
+	}
+}
+
+func (m *browseModel) report(msg string, err error) {
+	if err != nil {
+		m.message, m.failed = err.Error(), true
+		return
+	}
+	m.message, m.failed = msg, false
+}
+
+// move moves the cursor, or scrolls the detail pane when it is open.
+func (m *browseModel) move(delta int) {
+	if d := m.detail; d != nil {
+		d.scroll = max(0, min(d.scroll+delta, len(d.lines)-1))
+		return
+	}
+	p := m.pane()
+	n := len(p.visible())
+	p.cursor = max(0, min(p.cursor+delta, n-1))
+}
+
+// open drills into the selected item, or shows the selected task.
+func (m *browseModel) open() {
+	it := m.pane().selected()
+	if it == nil {
+		return
+	}
+	var err error
+	switch it.kind {
+	case browseSpace:
+		id := it.id
+		err = m.push(it.name, func() ([]browseItem, error) { return m.loadSpace(id) })
+	case browseFolder:
+		id := it.id
+		err = m.push(it.name, func() ([]browseItem, error) { return m.loadFolder(id) })
+	case browseList:
+		id := it.id
+		err = m.push(it.name, func() ([]browseItem, error) { return m.loadTasks(id) })
+	case browseTask:
+		err = m.showTask(it.id)
+	}
+	m.report("", err)
+}
+
+// back closes the detail pane, clears the filter on esc, or returns to
+// the previous level.
+func (m *browseModel) back(esc bool) {
+	switch p := m.pane(); {
+	case m.detail != nil:
+		m.detail = nil
+	case esc && p.filter != "":
+		p.filter, p.cursor, p.offset = "", 0, 0
+	case len(m.panes) > 1:
+		m.panes = m.panes[:len(m.panes)-1]
+	}
+}
+
+func (m *browseModel) reload() {
+	p := m.pane()
+	items, err := p.load()
+	if err != nil {
+		m.report("", err)
+		return
+	}
+	p.items = items
+	m.move(0)
+	if m.detail != nil {
+		m.report("", m.showTask(m.detail.task.ID))
+	}
+}
+
+// showTask fetches a task and opens it in the detail pane.
+func (m *browseModel) showTask(id string) error {
+	task, subtasks, err := fetchTaskWithExtras(m.ctx, m.client, m.cfg, id, false)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	writeTaskView(&buf, m.cs, task, subtasks)
+	text := strings.ReplaceAll(strings.TrimRight(buf.String(), "\n"), "\t", "    ")
+
+	scroll := 0
+	if m.detail != nil && m.detail.task.ID == task.ID {
+		scroll = m.detail.scroll
+	}
+	m.detail = &browseDetail{task: task, lines: strings.Split(text, "\n"), scroll: scroll}
+	m.replaceTask(task)
+	return nil
+}
+
+// replaceTask updates the list rows showing task.
+func (m *browseModel) replaceTask(task *clickup.Task) {
+	for _, p := range m.panes {
+		for i := range p.items {
+			if p.items[i].kind == browseTask && p.items[i].id == task.ID {
+				p.items[i].name = task.Name
+				p.items[i].task = task
+			}
+		}
+	}
+}
+
+// withTask runs action on the task in the detail pane or under the cursor.
+func (m *browseModel) withTask(action func(*clickup.Task)) {
+	if m.detail != nil {
+		action(m.detail.task)
+		return
+	}
+	if it := m.pane().selected(); it != nil && it.kind == browseTask {
+		action(it.task)
+		return
+	}
+	m.report("", fmt.Errorf("select a task first"))
+}
+
+// changed refreshes a task after a quick action modified it. The action
+// has succeeded by then, so a failed refresh is not reported.
+func (m *browseModel) changed(task *clickup.Task, msg string) (string, error) {
+	if m.detail != nil {
+		m.showTask(task.ID)
+	} else if updated, err := apiv2.GetTaskLocal(m.ctx, m.client, task.ID, ""); err == nil {
+		m.replaceTask(updated)
+	}
+	return msg, nil
+}
+
+func (m *browseModel) promptStatus(task *clickup.Task) {
+	statuses, err := cmdutil.FetchListStatuses(m.client, task.List.ID)
+	if err == nil && len(statuses) == 0 {
+		statuses, err = cmdutil.FetchSpaceStatuses(m.client, task.Space.ID)
+	}
+	if err != nil {
+		m.report("", err)
+		return
+	}
+	m.prompt = &browsePrompt{
+		label: fmt.Sprintf("Status (%s)", strings.Join(statuses, ", ")),
+		submit: func(input string) (string, error) {
+			status, err := cmdutil.MatchStatus(input, statuses)
+			if err != nil {
+				return "", err
+			}
+			req := map[string]string{"status": status}
+			if _, err := apiv2.UpdateTaskLocal(m.ctx, m.client, task.ID, req, ""); err != nil {
+				return "", fmt.Errorf("failed to update status: %w", err)
+			}
+			return m.changed(task, "Status set to "+status)
+		},
+	}
+}
+
+func (m *browseModel) promptAssign(task *clickup.Task) {
+	m.prompt = &browsePrompt{
+		label: "Assign (name, username, ID or me)",
+		submit: func(input string) (string, error) {
+			id, name, err := cmdutil.ResolveAssignee(m.ctx, m.client, input)
+			if err != nil {
+				return "", err
+			}
+			req := map[string]any{"assignees": map[string][]int{"add": {id}}}
+			if _, err := apiv2.UpdateTaskLocal(m.ctx, m.client, task.ID, req, ""); err != nil {
+				return "", fmt.Errorf("failed to assign: %w", err)
+			}
+			return m.changed(task, "Assigned "+name)
+		},
+	}
+}
+
+func (m *browseModel) promptComment(task *clickup.Task) {
+	m.prompt = &browsePrompt{
+		label: "Comment",
+		submit: func(input string) (string, error) {
+			req := &clickupv2.CreateTaskCommentJSONRequest{CommentText: &input}
+			if _, err := apiv2.CreateTaskComment(m.ctx, m.client, task.ID, req); err != nil {
+				return "", fmt.Errorf("failed to add comment: %w", err)
+			}
+			return "Comment added to " + task.ID, nil
+		},
+	}
+}
+
+func (m *browseModel) startTimer(task *clickup.Task) {
+	id := task.ID
+	req := &clickupv2.StartatimeEntryJSONRequest{Tid: &id}
+	if _, err := apiv2.StartatimeEntry(m.ctx, m.client, m.cfg.Workspace, req); err != nil {
+		m.report("", fmt.Errorf("failed to start timer: %w", err))
+		return
+	}
+	m.report("Timer started on "+id, nil)
+}
+
+func (m *browseModel) openInBrowser(task *clickup.Task) {
+	if task.URL == "" {
+		m.report("", fmt.Errorf("task %s has no URL", task.ID))
+		return
+	}
+	m.report("Opened "+task.URL, m.openURL(task.URL))
+}
+
+// View implements tui.Model.
+func (m *browseModel) View(width, height int) []string {
+	m.bodyHeight = max(height-3, 1)
+	lines := []string{m.header()}
+	lines = append(lines, m.body(width, m.bodyHeight)...)
+	return append(lines, m.statusLine(), m.cs.Gray(m.help()))
+}
+
+func (m *browseModel) header() string {
+	titles := make([]string, len(m.panes))
+	for i, p := range m.panes {
+		titles[i] = p.title
+	}
+	return m.cs.Bold("clickup") + " " + strings.Join(titles, " › ")
+}
+
+func (m *browseModel) body(width, height int) []string {
+	if m.detail == nil {
+		return m.paneLines(width, height)
+	}
+	detail := m.detailLines(height)
+	if width < browseSplitWidth {
+		return detail
+	}
+
+	listWidth := width * 2 / 5
+	left := m.paneLines(listWidth, height)
+	lines := make([]string, height)
+	for i := range lines {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(detail) {
+			r = detail[i]
+		}
+		lines[i] = tui.Pad(l, listWidth) + m.cs.Gray(" │ ") + r
+	}
+	return lines
+}
+
+func (m *browseModel) paneLines(width, height int) []string {
+	p := m.pane()
+	items := p.visible()
+	if len(items) == 0 {
+		if p.filter != "" {
+			return []string{m.cs.Gray(fmt.Sprintf("  No matches for %q", p.filter))}
+		}
+		return []string{m.cs.Gray("  Nothing here")}
+	}
+
+	// Keep the cursor on screen.
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+height {
+		p.offset = p.cursor - height + 1
+	}
+
+	var lines []string
+	for i := p.offset; i < len(items) && i < p.offset+height; i++ {
+		marker := "  "
+		if i == p.cursor {
+			marker = m.cs.Cyan("> ")
+		}
+		lines = append(lines, tui.Truncate(marker+m.itemLine(items[i], i == p.cursor), width))
+	}
+	return lines
+}
+
+func (m *browseModel) itemLine(it browseItem, selected bool) string {
+	name := it.name
+	if it.kind == browseFolder {
+		name += "/"
+	}
+	if selected {
+		name = m.cs.Bold(name)
+	}
+	if it.kind != browseTask {
+		return name
+	}
+
+	status := it.task.Status.Status
+	line := m.cs.StatusColor(status)(tui.Pad(status, 12)) + " " + name
+	if len(it.task.Assignees) > 0 {
+		names := make([]string, len(it.task.Assignees))
+		for i, a := range it.task.Assignees {
+			names[i] = a.Username
+		}
+		line += " " + m.cs.Gray("@"+strings.Join(names, ", @"))
+	}
+	return line
+}
+
+func (m *browseModel) detailLines(height int) []string {
+	d := m.detail
+	end := min(d.scroll+height, len(d.lines))
+	return d.lines[d.scroll:end]
+}
+
+func (m *browseModel) statusLine() string {
+	switch {
+	case m.prompt != nil:
+		return m.prompt.label + ": " + m.prompt.input + "█"
+	case m.filtering:
+		return "/" + m.pane().filter + "█"
+	case m.message != "" && m.failed:
+		return m.cs.Red("✗ " + m.message)
+	case m.message != "":
+		return m.cs.Green("!") + " " + m.message
+	case m.pane().filter != "":
+		return m.cs.Gray(fmt.Sprintf("filter: %s (esc to clear)", m.pane().filter))
+	}
+	return ""
+}
+
+func (m *browseModel) help() string {
+	switch {
+	case m.prompt != nil:
+		return "enter submit  esc cancel"
+	case m.filtering:
+		return "type to filter  enter keep  esc clear"
+	case m.detail != nil:
+		return "↑↓ scroll  s status  a assign  c comment  t timer  o open  ← back  q quit"
+	}
+	if it := m.pane().selected(); it != nil && it.kind == browseTask {
+		return "enter view  s status  a assign  c comment  t timer  o open  / filter  ← back  q quit"
+	}
+	return "enter open  / filter  ← back  r reload  q quit"
+}
@@ -0,0 +1,121 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/internal/tui"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func TestBrowse_DrillDownAndQuickActions(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	folder := fake.AddFolder("67890", fakeclickup.Folder{Name: "Sprints"})
+	fake.AddList("67890", folder.ID, fakeclickup.List{Name: "Sprint 1"})
+	backlog := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	fake.AddTask(backlog.ID, fakeclickup.Task{Name: "Fix login"})
+	docs := fake.AddTask(backlog.ID, fakeclickup.Task{Name: "Write docs"})
+
+	cfg, _ := tf.Factory.Config()
+	client, _ := tf.Factory.ApiClient()
+	m := newBrowseModel(context.Background(), client, cfg, tf.IOS.ColorScheme())
+	var opened []string
+	m.openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	require.NoError(t, m.start())
+
+	term := tui.NewHeadless(120, 20)
+	term.Draw(m.View(term.Size()))
+	screen := term.Screen()
+	assert.Contains(t, screen, "Spaces › Test Space", "starts in the configured space")
+	assert.Contains(t, screen, "> Sprints/")
+	assert.Contains(t, screen, "  Backlog")
+
+	term.Type(m, "/back")
+	assert.NotContains(t, term.Screen(), "Sprints/")
+	assert.Contains(t, term.Screen(), "/back█")
+
+	term.Type(m, "\r\r")
+	screen = term.Screen()
+	assert.Contains(t, screen, "Test Space › Backlog")
+	assert.Contains(t, screen, "> to do        Fix login")
+
+	term.Type(m, "j\r")
+	screen = term.Screen()
+	assert.Contains(t, screen, "> to do        Write docs")
+	assert.Contains(t, screen, "│ Write docs #"+docs.ID, "detail pane beside the list")
+	assert.Contains(t, screen, "Status: to do")
+
+	term.Type(m, "s")
+	assert.Contains(t, term.Screen(), "Status (to do, in progress, complete): █")
+	term.Type(m, "progr\r")
+	assert.Contains(t, term.Screen(), "! Status set to in progress")
+	assert.Contains(t, term.Screen(), "Status: in progress", "detail pane is refreshed")
+	got, _ := fake.Task(docs.ID)
+	assert.Equal(t, "in progress", got.Status.Status)
+
+	term.Type(m, "ame\r")
+	assert.Contains(t, term.Screen(), "! Assigned Fake User")
+	got, _ = fake.Task(docs.ID)
+	require.Len(t, got.Assignees, 1)
+
+	term.Type(m, "cLooks good\r")
+	require.Len(t, fake.Comments(docs.ID), 1)
+	assert.Equal(t, "Looks good", fake.Comments(docs.ID)[0].CommentText)
+
+	term.Type(m, "t")
+	require.Len(t, fake.TimeEntries(), 1)
+	assert.Contains(t, term.Screen(), "! Timer started on "+docs.ID)
+
+	term.Type(m, "o")
+	assert.Equal(t, []string{docs.URL}, opened)
+
+	// Back out of the detail pane, then the list.
+	term.Type(m, "\x1b")
+	assert.NotContains(t, term.Screen(), "│")
+	assert.Contains(t, term.Screen(), "Write docs @Fake User")
+	term.Type(m, "\x1b")
+	assert.Contains(t, term.Screen(), "filter: back (esc to clear)")
+	term.Type(m, "h")
+	assert.Contains(t, term.Screen(), "> Test Space")
+
+	assert.True(t, term.Type(m, "q"))
+}
+
+func TestBrowse_NarrowTerminalShowsDetailAlone(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login", Description: "Steps to reproduce"})
+
+	term := tui.NewHeadless(60, 12, tui.Keys("\r\r")...)
+	err := browseRun(tf.Factory, &browseOptions{terminal: term})
+	require.NoError(t, err)
+
+	screen := term.Screen()
+	assert.Contains(t, screen, "Fix login #")
+	assert.Contains(t, screen, "Steps to reproduce")
+	assert.NotContains(t, screen, "> to do")
+	assert.Equal(t, 3, term.Frames())
+}
+
+func TestBrowse_SelectTaskFirst(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Fake()
+
+	term := tui.NewHeadless(80, 10, tui.Keys("s")...)
+	require.NoError(t, browseRun(tf.Factory, &browseOptions{terminal: term}))
+	assert.Contains(t, term.Screen(), "✗ select a task first")
+}
+
+func TestBrowse_NeedsTerminal(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	err := browseRun(tf.Factory, &browseOptions{})
+	assert.ErrorContains(t, err, "needs an interactive terminal")
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
-	"github.com/triptechtravel/clickup-cli/internal/prompter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
@@ -180,7 +179,7 @@ func runCreate(f *cmdutil.Factory, opts *createOptions) error {
 			return fmt.Errorf("--name is required in non-interactive mode")
 		}
 
-		p := prompter.New(ios)
+		p := f.Prompter()
 
 		name, err := p.Input("Task name:", "")
 		if err != nil {
@@ -374,6 +373,12 @@ func runCreate(f *cmdutil.Factory, opts *createOptions) error {
 		}
 	}
 
+	// Under --dry-run the task is a placeholder; the held requests are
+	// the output.
+	if f.DryRun {
+		return nil
+	}
+
 	cs := ios.ColorScheme()
 	id := task.ID
 	if task.CustomID != "" {
@@ -592,11 +597,14 @@ func runBulkCreate(f *cmdutil.Factory, opts *createOptions) error {
 		created++
 		results = append(results, task)
 
-		if !opts.jsonFlags.WantsJSON() {
+		if !opts.jsonFlags.WantsJSON() && !f.DryRun {
 			fmt.Fprintf(ios.Out, "(%d/%d) Created task %s %s\n", i+1, total, cs.Bold(task.Name), cs.Gray("#"+id))
 		}
 	}
 
+	if f.DryRun {
+		return nil
+	}
 	if opts.jsonFlags.WantsJSON() {
 		return opts.jsonFlags.OutputJSON(ios.Out, results)
 	}
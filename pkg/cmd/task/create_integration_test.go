@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
 )
 
 const createdTaskJSON = `{
@@ -217,3 +218,19 @@ func TestCreateCommand_NoNameNonInteractive(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--name is required")
 }
+
+func TestCreateCommand_DryRunPlaceholderID(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	field := fake.AddField(list.ID, fakeclickup.CustomField{Name: "Env", Type: "short_text"})
+
+	tf.Factory.DryRun = true
+	err := testutil.RunCommand(t, NewCmdCreate(tf.Factory), "--list-id", list.ID, "--name", "Deploy", "--field", "Env=prod")
+	require.NoError(t, err)
+
+	assert.Empty(t, fake.Tasks())
+	// Requests that build on the held create name its placeholder ID.
+	assert.Contains(t, tf.ErrBuf.String(), "[dry-run] POST "+tf.Server.URL+"/api/v2/task/dry-run-1/field/"+field.ID+"\n")
+	assert.Equal(t, 2, tf.Factory.DryRunCount())
+}
@@ -123,6 +123,9 @@ func runDelete(f *cmdutil.Factory, opts *deleteOptions) error {
 		}
 
 		deleted++
+		if f.DryRun {
+			continue
+		}
 		if bulk {
 			fmt.Fprintf(ios.Out, "(%d/%d) Deleted task %s (%s)\n", i+1, total, cs.Bold(name), taskID)
 		} else {
@@ -130,7 +133,7 @@ func runDelete(f *cmdutil.Factory, opts *deleteOptions) error {
 		}
 	}
 
-	if bulk {
+	if bulk && !f.DryRun {
 		fmt.Fprintf(ios.Out, "\n%s Deleted %d/%d tasks\n", cs.Green("!"), deleted, total)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
 	"github.com/triptechtravel/clickup-cli/internal/git"
@@ -36,6 +37,7 @@ type editOptions struct {
 	customItemID        int
 	fields              []string
 	clearFields         []string
+	editor              bool
 	jsonFlags           cmdutil.JSONFlags
 }
 
@@ -57,7 +59,13 @@ but do not stop the batch.
 
 Custom fields can be set with --field "Name=value" (repeatable) and cleared
 with --clear-field "Name" (repeatable). Use 'clickup field list' to discover
-available custom fields and their types.`,
+available custom fields and their types.
+
+With --editor, the task opens in your editor as a document: YAML front
+matter with its name, status, priority, assignees (by username), tags,
+dates, points, time estimate and custom fields (under fields, by name),
+followed by its markdown description. On save, only the fields that were
+changed are updated; clear a field by leaving its value empty.`,
 		Example: `  # Update status and priority (auto-detects task from git branch)
   clickup task edit --status "in progress" --priority 2
 
@@ -77,7 +85,10 @@ available custom fields and their types.`,
   clickup task edit 86abc1 86abc2 --add-tags r&d,new-app-development
 
   # Remove specific tags
-  clickup task edit CU-abc123 --remove-tags fix`,
+  clickup task edit CU-abc123 --remove-tags fix
+
+  # Edit every field of a task in your editor
+  clickup task edit CU-abc123 --editor`,
 		Args:              cobra.ArbitraryArgs,
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -111,6 +122,7 @@ available custom fields and their types.`,
 	cmd.Flags().IntVar(&opts.customItemID, "type", -1, "Task type (0=task, 1=milestone, or custom type ID)")
 	cmd.Flags().StringArrayVar(&opts.fields, "field", nil, `Set a custom field value ("Name=value", repeatable)`)
 	cmd.Flags().StringArrayVar(&opts.clearFields, "clear-field", nil, `Clear a custom field value ("Name", repeatable)`)
+	cmd.Flags().BoolVarP(&opts.editor, "editor", "e", false, "Edit all fields and the description in your editor")
 
 	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
 
@@ -139,6 +151,22 @@ func runEdit(f *cmdutil.Factory, opts *editOptions, cmd *cobra.Command) error {
 		}
 	}
 
+	if opts.editor {
+		if len(taskIDs) > 1 {
+			return fmt.Errorf("--editor edits one task at a time")
+		}
+		var others []string
+		cmd.LocalFlags().VisitAll(func(fl *pflag.Flag) {
+			if fl.Changed && fl.Name != "editor" {
+				others = append(others, "--"+fl.Name)
+			}
+		})
+		if len(others) > 0 {
+			return fmt.Errorf("--editor cannot be combined with %s", strings.Join(others, ", "))
+		}
+		return runEditInEditor(f, taskIDs[0])
+	}
+
 	// Ensure at least one field is being updated.
 	if !cmd.Flags().Changed("name") &&
 		!cmd.Flags().Changed("description") &&
@@ -443,7 +471,9 @@ func runEdit(f *cmdutil.Factory, opts *editOptions, cmd *cobra.Command) error {
 		updated++
 		results = append(results, task)
 
-		if !opts.jsonFlags.WantsJSON() {
+		// Under --dry-run the task is a placeholder; the held requests
+		// are the output.
+		if !opts.jsonFlags.WantsJSON() && !f.DryRun {
 			if bulk {
 				fmt.Fprintf(ios.Out, "(%d/%d) Updated task %s %s\n", i+1, total, cs.Bold(task.Name), cs.Gray("#"+id))
 			} else {
@@ -463,6 +493,9 @@ func runEdit(f *cmdutil.Factory, opts *editOptions, cmd *cobra.Command) error {
 		}
 	}
 
+	if f.DryRun {
+		return nil
+	}
 	if opts.jsonFlags.WantsJSON() {
 		if bulk {
 			return opts.jsonFlags.OutputJSON(ios.Out, results)
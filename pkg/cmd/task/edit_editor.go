@@ -0,0 +1,222 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/git"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"gopkg.in/yaml.v3"
+)
+
+// editorFields are the standard fields of a task document, in order.
+var editorFields = []string{
+	"name", "status", "priority", "assignees", "tags",
+	"due_date", "start_date", "points", "time_estimate",
+}
+
+// editorListFields are written as YAML lists.
+var editorListFields = []string{"assignees", "tags"}
+
+// taskDocument returns t as a document to edit: its fields as YAML front
+// matter, custom fields under fields, then its markdown description.
+func taskDocument(t *clickup.Task) (string, error) {
+	rec := taskRecord(t)
+	front := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range editorFields {
+		v, err := editorValue(key, rec[key])
+		if err != nil {
+			return "", err
+		}
+		front.Content = append(front.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, v)
+	}
+	if len(t.CustomFields) > 0 {
+		fields := &yaml.Node{Kind: yaml.MappingNode}
+		for _, cf := range t.CustomFields {
+			v, err := editorValue(cf.Type, rec[customFieldKey(cf.Name)])
+			if err != nil {
+				return "", err
+			}
+			fields.Content = append(fields.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: cf.Name}, v)
+		}
+		front.Content = append(front.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "fields"}, fields)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(front); err != nil {
+		return "", err
+	}
+	description := t.MarkdownDescription
+	if description == "" {
+		description = t.Description
+	}
+	return "---\n" + buf.String() + "---\n\n" + strings.TrimSpace(description) + "\n", nil
+}
+
+// editorValue returns the YAML node for a value of a field of the given
+// kind, a standard field name or a custom field type.
+func editorValue(kind, value string) (*yaml.Node, error) {
+	switch {
+	case value == "":
+		if slices.Contains(editorListFields, kind) {
+			return &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}, nil
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}, nil
+	case slices.Contains(editorListFields, kind):
+		n := &yaml.Node{}
+		if err := n.Encode(text.SplitAndTrim(value)); err != nil {
+			return nil, err
+		}
+		n.Style = yaml.FlowStyle
+		return n, nil
+	case kind == "points" || kind == "number":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			n := &yaml.Node{}
+			err := n.Encode(f)
+			return n, err
+		}
+	case kind == "due_date" || kind == "start_date" || kind == "date":
+		// Written plain so they read back as dates.
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: value}, nil
+	}
+	n := &yaml.Node{}
+	err := n.Encode(value)
+	return n, err
+}
+
+// parseTaskDocument reads an edited task document back into a record and
+// a description.
+func parseTaskDocument(doc string) (map[string]string, string, error) {
+	src := []byte(doc)
+	front, offset, err := splitFrontMatter(src)
+	if err != nil {
+		return nil, "", err
+	}
+	if front == nil {
+		return nil, "", fmt.Errorf("the document must start with front matter between --- lines")
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(front, &m); err != nil {
+		return nil, "", fmt.Errorf("invalid front matter: %w", err)
+	}
+
+	rec := make(map[string]string, len(m))
+	for key, v := range m {
+		if key == "fields" {
+			fields, ok := v.(map[string]any)
+			if !ok && v != nil {
+				return nil, "", fmt.Errorf("fields must be a mapping of custom field names to values")
+			}
+			for name, fv := range fields {
+				rec[customFieldKey(name)] = yamlRecordValue(fv)
+			}
+			continue
+		}
+		if !slices.Contains(editorFields, key) {
+			return nil, "", fmt.Errorf("unknown field %q; custom fields go under fields", key)
+		}
+		rec[key] = yamlRecordValue(v)
+	}
+	return rec, strings.TrimSpace(string(src[offset:])), nil
+}
+
+// runEditInEditor edits a task as a document in the user's editor and
+// applies the fields that were changed.
+func runEditInEditor(f *cmdutil.Factory, rawID string) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	parsed := git.ParseTaskID(rawID)
+	task, err := apiv2.GetTaskLocal(ctx, client, parsed.ID, cmdutil.CustomIDTaskQueryMD(cfg, parsed.IsCustomID))
+	if err != nil {
+		return fmt.Errorf("failed to fetch task %s: %w", rawID, err)
+	}
+
+	original, err := taskDocument(task)
+	if err != nil {
+		return err
+	}
+	edited, err := f.Prompter().Editor("Edit task "+rawID, original, "*.md")
+	if err != nil {
+		return fmt.Errorf("editor failed: %w", err)
+	}
+	if strings.TrimSpace(edited) == strings.TrimSpace(original) {
+		fmt.Fprintln(ios.ErrOut, "No changes made.")
+		return nil
+	}
+
+	rec, description, err := parseTaskDocument(edited)
+	if err == nil {
+		var u *taskUpdate
+		if u, err = planUpdate(task, rec, newUserResolver(ctx, client)); err == nil {
+			return applyEditorUpdate(f, task, u, description)
+		}
+	}
+
+	// Keep the edits so they are not lost to a typo.
+	if tmp, tmpErr := os.CreateTemp("", "clickup-task-*.md"); tmpErr == nil {
+		_, _ = tmp.WriteString(edited)
+		tmp.Close()
+		fmt.Fprintf(ios.ErrOut, "%s Your edits were saved to %s\n", cs.Yellow("!"), tmp.Name())
+	}
+	return err
+}
+
+// applyEditorUpdate applies an update planned from a task document, and
+// its description if that changed.
+func applyEditorUpdate(f *cmdutil.Factory, task *clickup.Task, u *taskUpdate, description string) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	current := task.MarkdownDescription
+	if current == "" {
+		current = task.Description
+	}
+	setDescription := !sameRecordValue("description", current, description)
+	if len(u.changes) == 0 && !setDescription {
+		fmt.Fprintln(ios.ErrOut, "No changes made.")
+		return nil
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	if err := u.apply(context.Background(), client, task); err != nil {
+		return fmt.Errorf("failed to update task %s: %w", task.ID, err)
+	}
+	if setDescription {
+		if err := setMarkdownDescription(client, task.ID, description); err != nil {
+			return fmt.Errorf("task updated but failed to set markdown description: %w", err)
+		}
+	}
+
+	fmt.Fprintf(ios.Out, "%s Updated task %s %s\n", cs.Green("!"), cs.Bold(task.Name), cs.Gray("#"+task.ID))
+	for _, c := range u.changes {
+		fmt.Fprintf(ios.Out, "  %s: %s → %s\n", c.Field, previewValue(cs, c.From), previewValue(cs, c.To))
+	}
+	if setDescription {
+		fmt.Fprintln(ios.Out, "  description updated")
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+)
+
+func TestTaskDocument(t *testing.T) {
+	task := recordTestTask()
+	task.MarkdownDescription = "Users are logged out after **5 minutes**."
+	task.CustomFields[0].Value = float64(1)
+	task.Points = clickup.Point{}
+
+	doc, err := taskDocument(task)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(doc, "---\nname: Fix login\nstatus: in progress\npriority: high\n"), doc)
+	assert.Contains(t, doc, "assignees: [alice, bob]\n")
+	assert.Contains(t, doc, "tags: [bug, auth]\n")
+	assert.Contains(t, doc, "due_date:\nstart_date:\npoints:\n")
+	assert.Contains(t, doc, "time_estimate: 1h30m\n")
+	assert.Contains(t, doc, "fields:\n  Stage: Build\n")
+	assert.True(t, strings.HasSuffix(doc, "---\n\nUsers are logged out after **5 minutes**.\n"), doc)
+
+	// An unedited document changes nothing.
+	rec, description, err := parseTaskDocument(doc)
+	require.NoError(t, err)
+	assert.Equal(t, task.MarkdownDescription, description)
+	u, err := planUpdate(task, rec, recordTestResolver)
+	require.NoError(t, err)
+	assert.Empty(t, u.changes)
+}
+
+func TestParseTaskDocument_Edited(t *testing.T) {
+	doc := `---
+name: Fix login timeout
+status: in progress
+priority: high
+assignees: [alice, carol]
+tags: []
+due_date: 2026-11-01
+start_date:
+points: 3
+time_estimate: 1h30m
+fields:
+  Stage: Design
+---
+
+New description.
+`
+	rec, description, err := parseTaskDocument(doc)
+	require.NoError(t, err)
+	assert.Equal(t, "New description.", description)
+	assert.Equal(t, "2026-11-01", rec["due_date"])
+	assert.Equal(t, "", rec["tags"])
+
+	u, err := planUpdate(recordTestTask(), rec, recordTestResolver)
+	require.NoError(t, err)
+	var fields []string
+	for _, c := range u.changes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"name", "assignees", "tags", "due_date", "points", "field:Stage"}, fields)
+	assert.Equal(t, clickup.TaskAssigneeUpdateRequest{Add: []int{3}, Rem: []int{2}}, u.body["assignees"])
+	assert.True(t, u.setTags)
+	assert.Empty(t, u.tags)
+}
+
+func TestParseTaskDocument_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"no front matter", "Just a description\n", "must start with front matter"},
+		{"unknown field", "---\nowner: alice\n---\n", `unknown field "owner"`},
+		{"bad fields", "---\nfields: [a, b]\n---\n", "fields must be a mapping"},
+		{"bad yaml", "---\nname: [unclosed\n---\n", "invalid front matter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseTaskDocument(tt.doc)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestEditCommand_EditorRejectsFieldFlags(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+
+	err := testutil.RunCommand(t, NewCmdEdit(tf.Factory), "abc123", "--editor", "--status", "done")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--editor cannot be combined with --status")
+
+	err = testutil.RunCommand(t, NewCmdEdit(tf.Factory), "abc123", "def456", "--editor")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "one task at a time")
+}
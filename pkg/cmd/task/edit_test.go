@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
 )
 
 func TestNewCmdEdit_Flags(t *testing.T) {
@@ -22,5 +25,27 @@ func TestNewCmdEdit_Flags(t *testing.T) {
 	assert.NotNil(t, cmd.Flags().Lookup("start-date"))
 	assert.NotNil(t, cmd.Flags().Lookup("time-estimate"))
 	assert.NotNil(t, cmd.Flags().Lookup("points"))
+	assert.NotNil(t, cmd.Flags().Lookup("editor"))
 	assert.Equal(t, "edit [<task-id>...]", cmd.Use)
 }
+
+func TestEditCommand_DryRun(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	a := fake.AddTask(list.ID, fakeclickup.Task{Name: "First"})
+	b := fake.AddTask(list.ID, fakeclickup.Task{Name: "Second"})
+
+	tf.Factory.DryRun = true
+	err := testutil.RunCommand(t, NewCmdEdit(tf.Factory), a.ID, b.ID, "--name", "Renamed")
+	require.NoError(t, err)
+
+	for _, id := range []string{a.ID, b.ID} {
+		got, _ := fake.Task(id)
+		assert.NotEqual(t, "Renamed", got.Name, "dry run must not change %s", id)
+		assert.Contains(t, tf.ErrBuf.String(), "[dry-run] PUT "+tf.Server.URL+"/api/v2/task/"+id+"\n")
+	}
+	assert.Contains(t, tf.ErrBuf.String(), `"name": "Renamed"`)
+	assert.Empty(t, tf.OutBuf.String(), "success messages should be held back")
+	assert.Equal(t, 2, tf.Factory.DryRunCount())
+}
@@ -0,0 +1,153 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+type exportOptions struct {
+	listID        string
+	output        string
+	includeClosed bool
+}
+
+// NewCmdExport returns a command to export a list's tasks to a file.
+func NewCmdExport(f *cmdutil.Factory) *cobra.Command {
+	opts := &exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a list's tasks to CSV or YAML",
+		Long: `Write every task in a list, subtasks included, with its standard fields
+and a field:<name> column for each custom field of the list.
+
+Values are written in the form 'clickup task import' reads back: dates as
+YYYY-MM-DD, time estimates like 1h30m, users by username, and drop-down
+and label options by name. Edit the file and import it to update the
+tasks in bulk.
+
+The file is CSV unless --format yaml is given or the --output file ends
+in .yaml or .yml. If --list is not provided, the configured default list
+is used.`,
+		Example: `  # Export the default list as CSV
+  clickup task export > tasks.csv
+
+  # Export a list as YAML, closed tasks included
+  clickup task export --list 12345 --include-closed -o tasks.yaml`,
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.listID == "" {
+				cfg, err := f.Config()
+				if err != nil {
+					return err
+				}
+				dir, _ := os.Getwd()
+				opts.listID = cfg.ListForDir(dir)
+			}
+			if opts.listID == "" {
+				return fmt.Errorf("no list specified. Use --list or run 'clickup list select' to set a default")
+			}
+			return runExport(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listID, "list", "", "ClickUp list ID (defaults to configured list)")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Write to a file instead of standard output")
+	cmd.Flags().BoolVarP(&opts.includeClosed, "include-closed", "c", false, "Include closed/completed tasks")
+
+	return cmd
+}
+
+func runExport(f *cmdutil.Factory, opts *exportOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	format, err := recordFormat(f.Format, opts.output)
+	if err != nil {
+		return &cmdutil.FlagError{Err: err}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	fields, err := apiv2.GetAccessibleCustomFieldsLocal(ctx, client, opts.listID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch custom fields: %w", err)
+	}
+
+	path := "list/" + opts.listID + "/task?subtasks=true"
+	if opts.includeClosed {
+		path += "&include_closed=true"
+	}
+	tasks, err := pager.Collect(pager.Pages(ctx, pager.Options{},
+		apiv2.TaskPages[clickup.Task](client, path)))
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	keys := append(slices.Clone(recordFields), recordReadOnlyFields...)
+	for _, cf := range fields {
+		keys = append(keys, customFieldKey(cf.Name))
+	}
+	var cols []tableprinter.Column
+	for _, key := range keys {
+		cols = append(cols, tableprinter.Column{Header: key, Key: key})
+	}
+	tbl := tableprinter.NewTable(cols...)
+	for i := range tasks {
+		rec := taskRecord(&tasks[i])
+		row := make([]string, len(keys))
+		for j, key := range keys {
+			row[j] = rec[key]
+		}
+		tbl.AddRow(row...)
+	}
+
+	var buf bytes.Buffer
+	if err := tableprinter.Encode(&buf, format, tbl); err != nil {
+		return err
+	}
+	if opts.output == "" {
+		_, err := ios.Out.Write(buf.Bytes())
+		return err
+	}
+	if err := os.WriteFile(opts.output, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(ios.ErrOut, "%s Exported %s to %s\n", cs.Green("!"), text.Pluralize(len(tasks), "task"), opts.output)
+	return nil
+}
+
+// recordFormat picks csv or yaml for a task file from --format, then from
+// the file's extension, defaulting to csv.
+func recordFormat(format, path string) (string, error) {
+	switch format {
+	case tableprinter.FormatCSV, tableprinter.FormatYAML:
+		return format, nil
+	case "", tableprinter.FormatTable:
+	default:
+		return "", fmt.Errorf("task files are csv or yaml, not %s", format)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return tableprinter.FormatYAML, nil
+	}
+	return tableprinter.FormatCSV, nil
+}
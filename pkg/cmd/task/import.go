@@ -0,0 +1,342 @@
+package task
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/git"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/prompter"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+	"gopkg.in/yaml.v3"
+)
+
+type importOptions struct {
+	file    string
+	listID  string
+	confirm bool
+}
+
+// NewCmdImport returns a command to create and update tasks from a file.
+func NewCmdImport(f *cmdutil.Factory) *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create or update tasks from a CSV or YAML file",
+		Long: `Bring tasks in line with a file in the form 'clickup task export' writes.
+
+A record with an id or custom_id updates that task. Only the fields that
+differ are sent, fields the file leaves out are kept, and an empty value
+clears a field. A record with neither creates a task in --list, or in the
+configured default list. The list, url, date_created and date_updated
+columns are ignored.
+
+Custom fields are named "field:" and their field name, such as
+field:Story Type. Drop-down and label options are matched by name, and
+users by username, email or ID.
+
+The changes are listed before anything is written, and confirmed on a
+terminal unless --yes is passed. Use --dry-run to only list them. The
+format comes from --format or the file extension; use - to read CSV, or
+YAML with --format yaml, from standard input.`,
+		Example: `  # Round trip: export, edit, import
+  clickup task export --list 12345 -o tasks.csv
+  clickup task import tasks.csv --list 12345
+
+  # Preview the changes only
+  clickup --dry-run task import tasks.yaml`,
+		Args:              cobra.ExactArgs(1),
+		PersistentPreRunE: cmdutil.NeedsAuth(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.file = args[0]
+			if opts.listID == "" {
+				cfg, err := f.Config()
+				if err != nil {
+					return err
+				}
+				dir, _ := os.Getwd()
+				opts.listID = cfg.ListForDir(dir)
+			}
+			return runImport(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listID, "list", "", "List to create new tasks in (defaults to configured list)")
+	cmd.Flags().BoolVarP(&opts.confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// importPlan is the update planned for one record.
+type importPlan struct {
+	task   *clickup.Task
+	create bool
+	// name is the name a task is created with.
+	name   string
+	update *taskUpdate
+}
+
+func runImport(f *cmdutil.Factory, opts *importOptions) error {
+	ios := f.IOStreams
+	cs := ios.ColorScheme()
+
+	format, err := recordFormat(f.Format, opts.file)
+	if err != nil {
+		return &cmdutil.FlagError{Err: err}
+	}
+	records, err := readRecordFile(ios, opts.file, format)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resolve := newUserResolver(ctx, client)
+	var listFields []clickup.CustomField
+
+	var plans []importPlan
+	for i, rec := range records {
+		p := importPlan{}
+		switch {
+		case rec["id"] != "" || rec["custom_id"] != "":
+			id, isCustom := rec["custom_id"], true
+			if rec["id"] != "" {
+				parsed := git.ParseTaskID(rec["id"])
+				id, isCustom = parsed.ID, parsed.IsCustomID
+			}
+			p.task, err = apiv2.GetTaskLocal(ctx, client, id, cmdutil.CustomIDTaskQuery(cfg, isCustom))
+			if err != nil {
+				return fmt.Errorf("record %d: failed to fetch task %s: %w", i+1, id, err)
+			}
+		default:
+			if opts.listID == "" {
+				return fmt.Errorf("record %d has no id or custom_id. Use --list to create tasks in a list", i+1)
+			}
+			if strings.TrimSpace(rec["name"]) == "" {
+				return fmt.Errorf("record %d has no id, custom_id or name", i+1)
+			}
+			if listFields == nil {
+				if listFields, err = apiv2.GetAccessibleCustomFieldsLocal(ctx, client, opts.listID); err != nil {
+					return fmt.Errorf("failed to fetch custom fields: %w", err)
+				}
+			}
+			p.task, p.create = &clickup.Task{CustomFields: slices.Clone(listFields)}, true
+			p.name = strings.TrimSpace(rec["name"])
+		}
+
+		p.update, err = planUpdate(p.task, rec, resolve)
+		if err != nil {
+			return fmt.Errorf("record %d (%s): %w", i+1, recordLabel(p), err)
+		}
+		if len(p.update.changes) > 0 {
+			plans = append(plans, p)
+		}
+	}
+
+	unchanged := len(records) - len(plans)
+	for _, p := range plans {
+		verb := "Update"
+		if p.create {
+			verb = "Create"
+		}
+		fmt.Fprintf(ios.Out, "%s %s\n", cs.Bold(verb), recordLabel(p))
+		for _, c := range p.update.changes {
+			fmt.Fprintf(ios.Out, "  %s: %s → %s\n", c.Field, previewValue(cs, c.From), previewValue(cs, c.To))
+		}
+	}
+	if unchanged > 0 {
+		fmt.Fprintln(ios.Out, cs.Gray(text.Pluralize(unchanged, "task")+" unchanged"))
+	}
+	if len(plans) == 0 {
+		fmt.Fprintln(ios.ErrOut, "No changes to import.")
+		return nil
+	}
+
+	if !opts.confirm && ios.IsTerminal() {
+		ok, err := prompter.New(ios).Confirm(fmt.Sprintf("Apply changes to %s?", text.Pluralize(len(plans), "task")), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(ios.ErrOut, "Cancelled.")
+			return nil
+		}
+	}
+
+	var created, updated, failed int
+	for i, p := range plans {
+		label := recordLabel(p)
+		if p.create {
+			t, err := createFromUpdate(ctx, client, opts.listID, p.update)
+			switch {
+			case t == nil:
+				fmt.Fprintf(ios.ErrOut, "%s (%d/%d) failed to create %s: %v\n", cs.Red("✗"), i+1, len(plans), label, err)
+				failed++
+				continue
+			case err != nil:
+				fmt.Fprintf(ios.ErrOut, "%s (%d/%d) created %s but %v\n", cs.Yellow("!"), i+1, len(plans), label, err)
+				failed++
+				continue
+			}
+			created++
+			if f.DryRun {
+				continue
+			}
+			fmt.Fprintf(ios.Out, "(%d/%d) Created task %s %s\n", i+1, len(plans), cs.Bold(t.Name), cs.Gray("#"+t.ID))
+			continue
+		}
+		if err := p.update.apply(ctx, client, p.task); err != nil {
+			fmt.Fprintf(ios.ErrOut, "%s (%d/%d) failed to update %s: %v\n", cs.Red("✗"), i+1, len(plans), label, err)
+			failed++
+			continue
+		}
+		updated++
+		if !f.DryRun {
+			fmt.Fprintf(ios.Out, "(%d/%d) Updated task %s\n", i+1, len(plans), label)
+		}
+	}
+
+	if !f.DryRun {
+		fmt.Fprintf(ios.Out, "\n%s Imported %s: %d created, %d updated\n", cs.Green("!"), text.Pluralize(len(plans), "task"), created, updated)
+	}
+	if failed > 0 {
+		return &cmdutil.SilentError{Err: fmt.Errorf("%d of %d tasks failed", failed, len(plans))}
+	}
+	return nil
+}
+
+// recordLabel names the task a plan is for: its ID and name, or the name
+// it will be created with.
+func recordLabel(p importPlan) string {
+	if p.create {
+		return fmt.Sprintf("%q", p.name)
+	}
+	id := p.task.ID
+	if p.task.CustomID != "" {
+		id = p.task.CustomID
+	}
+	return id + " " + p.task.Name
+}
+
+// previewValue shows a value on one line, or (none) for an empty one.
+func previewValue(cs *iostreams.ColorScheme, v string) string {
+	if v == "" {
+		return cs.Gray("(none)")
+	}
+	v = strings.Join(strings.Fields(v), " ")
+	return text.Truncate(v, 60)
+}
+
+// readRecordFile reads the task records in path, or standard input for -.
+func readRecordFile(ios *iostreams.IOStreams, path, format string) ([]map[string]string, error) {
+	var r io.Reader = ios.In
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+	var (
+		records []map[string]string
+		err     error
+	)
+	if format == tableprinter.FormatYAML {
+		records, err = readYAMLRecords(r)
+	} else {
+		records, err = readCSVRecords(r)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// readCSVRecords reads records from CSV with a header row.
+func readCSVRecords(r io.Reader) ([]map[string]string, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	header := rows[0]
+	if len(header) > 0 {
+		// Spreadsheets often start the file with a byte order mark.
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	var records []map[string]string
+	for _, row := range rows[1:] {
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		rec := make(map[string]string, len(header))
+		for i, key := range header {
+			rec[strings.TrimSpace(key)] = row[i]
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// readYAMLRecords reads records from a YAML sequence of mappings. Lists
+// are joined with commas, as they are in CSV.
+func readYAMLRecords(r io.Reader) ([]map[string]string, error) {
+	var docs []map[string]any
+	if err := yaml.NewDecoder(r).Decode(&docs); err != nil && err != io.EOF {
+		return nil, err
+	}
+	records := make([]map[string]string, 0, len(docs))
+	for _, doc := range docs {
+		rec := make(map[string]string, len(doc))
+		for key, v := range doc {
+			rec[key] = yamlRecordValue(v)
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func yamlRecordValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339)
+	case []any:
+		parts := make([]string, len(v))
+		for i, p := range v {
+			parts[i] = yamlRecordValue(p)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
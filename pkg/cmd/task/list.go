@@ -3,13 +3,14 @@ package task
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
@@ -22,21 +23,43 @@ type listOptions struct {
 	page            int
 	includeClosed   bool
 	includeSubtasks bool
+	filter          *cmdutil.TaskFilter
+	pageFlags       cmdutil.PageFlags
+	tableFlags      cmdutil.TableFlags
 	jsonFlags       cmdutil.JSONFlags
 }
 
+// listColumns are the columns 'task list' shows by default.
+var listColumns = []string{"id", "name", "status", "priority", "assignee", "tags", "due"}
+
 // NewCmdList returns a command to list ClickUp tasks in a given list.
 func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 	opts := &listOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "list",
+		Use:   "list [filter]",
 		Short: "List tasks in a ClickUp list",
 		Long: `List tasks from a ClickUp list with optional filters.
 
 If --list-id is not provided, the configured default list is used
-(set via 'clickup list select'). Results can be filtered by assignee,
-status, and sprint.`,
+(set via 'clickup list select'). Results can be filtered with a filter
+expression, or with --assignee, --status and --sprint, which add to it.
+
+` + cmdutil.TaskFilterHelp + `
+
+Filters applied to fetched tasks are applied page by page, so use --limit
+or --all to look past the first page.
+
+--columns picks and orders the columns, and --sort orders the rows; prefix
+a sort column with - for descending. Custom fields are available as
+field:"<name>". Save a default column set with
+'clickup config set columns.task-list <columns>'.
+
+A single page of up to 100 tasks is fetched by default. --limit fetches
+pages until that many tasks are found, and --all fetches every page. With
+--format ndjson and no --sort, rows are written as each page arrives.
+
+Columns: ` + strings.Join(cmdutil.TaskColumns, ", "),
 		Example: `  # List tasks using your configured default list
   clickup task list
 
@@ -46,13 +69,43 @@ status, and sprint.`,
   # Filter by assignee and status
   clickup task list --list-id 12345 --assignee me --status "in progress"
 
+  # My open backend tasks due in the next three days, with 3+ points
+  clickup task list 'assignee:me is:open tag:backend -tag:wontfix due<+3d points>=3'
+
+  # A filter starting with - goes after --
+  clickup task list -- -status:blocked
+
+  # Bugs by custom field, across every page
+  clickup task list 'field:"Story Type"=Bug priority<=high' --all
+
   # Include closed tasks
   clickup task list --list-id 12345 --include-closed
 
   # Include subtasks
-  clickup task list --list-id 12345 --include-subtasks`,
+  clickup task list --list-id 12345 --include-subtasks
+
+  # Stream every task in the list as NDJSON
+  clickup task list --list-id 12345 --all --format ndjson
+
+  # Pick columns, including a custom field, and sort by due date then priority
+  clickup task list --columns 'id,name,status,points,field:"Story Type"' --sort due,-priority`,
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := cmdutil.ParseTaskFilter(strings.Join(args, " "))
+			if err != nil {
+				return &cmdutil.FlagError{Err: err}
+			}
+			if len(opts.status) > 0 {
+				filter.Add("status", opts.status...)
+			}
+			if len(opts.assignee) > 0 {
+				filter.Add("assignee", opts.assignee...)
+			}
+			if opts.sprint != "" {
+				filter.Add("tag", opts.sprint)
+			}
+			opts.filter = filter
+
 			if opts.listID == "" {
 				cfg, err := f.Config()
 				if err != nil {
@@ -72,10 +125,12 @@ status, and sprint.`,
 	cmd.Flags().StringSliceVar(&opts.assignee, "assignee", nil, `Filter by assignee ID(s), or "me" for yourself`)
 	cmd.Flags().StringSliceVar(&opts.status, "status", nil, "Filter by status(es)")
 	cmd.Flags().StringVar(&opts.sprint, "sprint", "", "Filter by sprint name")
-	cmd.Flags().IntVar(&opts.page, "page", 0, "Page number for pagination (starts at 0)")
+	cmd.Flags().IntVar(&opts.page, "page", 0, "Page number to start from (starts at 0)")
 	cmd.Flags().BoolVarP(&opts.includeClosed, "include-closed", "c", false, "Include closed/completed tasks")
 	cmd.Flags().BoolVar(&opts.includeSubtasks, "include-subtasks", false, "Include subtasks in results")
 
+	cmdutil.AddPageFlags(cmd, &opts.pageFlags, "tasks")
+	cmdutil.AddTableFlags(cmd, &opts.tableFlags, "task-list", cmdutil.TaskColumns)
 	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
 
 	return cmd
@@ -89,19 +144,13 @@ func runList(f *cmdutil.Factory, opts *listOptions) error {
 		return err
 	}
 
-	q := url.Values{}
-	if opts.page > 0 {
-		q.Set("page", fmt.Sprintf("%d", opts.page))
-	}
-	for _, s := range opts.status {
-		q.Add("statuses[]", s)
-	}
-	for _, a := range opts.assignee {
-		q.Add("assignees[]", a)
-	}
-	if opts.sprint != "" {
-		q.Add("tags[]", opts.sprint)
+	ctx := context.Background()
+	filter, err := opts.filter.Compile(cmdutil.FilterOptions{ResolveUser: cmdutil.FilterUserResolver(ctx, client)})
+	if err != nil {
+		return err
 	}
+
+	q := filter.Params
 	if opts.includeClosed {
 		q.Set("include_closed", "true")
 	}
@@ -114,8 +163,36 @@ func runList(f *cmdutil.Factory, opts *listOptions) error {
 		qs = "?" + q.Encode()
 	}
 
-	ctx := context.Background()
-	tasks, err := apiv2.GetTasksLocal(ctx, client, opts.listID, qs)
+	pageOpts := opts.pageFlags.Options()
+	pageOpts.Start = opts.page
+	// --limit counts matching tasks, so it applies after local filters.
+	limit := 0
+	if filter.Local() {
+		limit, pageOpts.Limit = pageOpts.Limit, 0
+	}
+	tasks := pager.Pages(ctx, pageOpts,
+		apiv2.TaskPages[clickup.Task](client, "list/"+opts.listID+"/task"+qs))
+	if filter.Local() {
+		tasks = pager.Limit(pager.Filter(tasks, filter.Match), limit)
+	}
+
+	if !opts.jsonFlags.WantsJSON() && f.Streams(opts.tableFlags.Sort != "") {
+		n, err := streamTaskTable(f, &opts.tableFlags, tasks)
+		if err != nil {
+			return fmt.Errorf("failed to list tasks: %w", err)
+		}
+		if n == 0 {
+			fmt.Fprintln(ios.ErrOut, "No tasks found.")
+		}
+		return nil
+	}
+	return printTaskList(f, opts, tasks)
+}
+
+func printTaskList(f *cmdutil.Factory, opts *listOptions, seq iter.Seq2[clickup.Task, error]) error {
+	ios := f.IOStreams
+
+	tasks, err := pager.Collect(seq)
 	if err != nil {
 		return fmt.Errorf("failed to list tasks: %w", err)
 	}
@@ -129,9 +206,12 @@ func runList(f *cmdutil.Factory, opts *listOptions) error {
 		return opts.jsonFlags.OutputJSON(ios.Out, tasks)
 	}
 
-	if err := printTaskTable(f, tasks); err != nil {
+	if err := printTaskTable(f, &opts.tableFlags, tasks); err != nil {
 		return err
 	}
+	if f.WantsFormat() {
+		return nil
+	}
 
 	// Quick actions footer
 	cs := ios.ColorScheme()
@@ -146,59 +226,33 @@ func runList(f *cmdutil.Factory, opts *listOptions) error {
 	return nil
 }
 
-func printTaskTable(f *cmdutil.Factory, tasks []clickup.Task) error {
-	ios := f.IOStreams
-	cs := ios.ColorScheme()
-	tp := tableprinter.New(ios)
-
-	// Header row
-	tp.AddField(cs.Bold("ID"))
-	tp.AddField(cs.Bold("NAME"))
-	tp.AddField(cs.Bold("STATUS"))
-	tp.AddField(cs.Bold("PRIORITY"))
-	tp.AddField(cs.Bold("ASSIGNEE"))
-	tp.AddField(cs.Bold("TAGS"))
-	tp.AddField(cs.Bold("DUE"))
-	tp.EndRow()
-
-	tp.SetTruncateColumn(1) // Truncate name column if table is too wide.
+// streamTaskTable writes each task as it is fetched, as a one-row table.
+func streamTaskTable(f *cmdutil.Factory, flags *cmdutil.TableFlags, tasks iter.Seq2[clickup.Task, error]) (int, error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return 0, err
+	}
+	fields := flags.CustomFields(cfg)
+	return cmdutil.StreamTable(f, tasks, func(t clickup.Task) (*tableprinter.Table, error) {
+		tt := cmdutil.NewTaskTable(f, listColumns, fields)
+		tt.AddTask(t)
+		tbl := tt.Table()
+		return tbl, flags.Apply(cfg, tbl)
+	})
+}
 
+func printTaskTable(f *cmdutil.Factory, flags *cmdutil.TableFlags, tasks []clickup.Task) error {
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	tt := cmdutil.NewTaskTable(f, listColumns, flags.CustomFields(cfg))
 	for _, t := range tasks {
-		id := t.ID
-		if t.CustomID != "" {
-			id = t.CustomID
-		}
-		tp.AddField(id)
-		tp.AddField(t.Name)
-
-		statusText := t.Status.Status
-		statusColorFn := cs.StatusColor(strings.ToLower(statusText))
-		tp.AddField(statusColorFn(statusText))
-
-		tp.AddField(t.Priority.Priority)
-
-		assigneeNames := make([]string, 0, len(t.Assignees))
-		for _, a := range t.Assignees {
-			assigneeNames = append(assigneeNames, a.Username)
-		}
-		tp.AddField(strings.Join(assigneeNames, ", "))
-
-		tagNames := make([]string, 0, len(t.Tags))
-		for _, tag := range t.Tags {
-			tagNames = append(tagNames, tag.Name)
-		}
-		tp.AddField(strings.Join(tagNames, ", "))
-
-		var dueStr string
-		if t.DueDate != nil {
-			if dt := t.DueDate.Time(); dt != nil {
-				dueStr = dt.Format("Jan 02")
-			}
-		}
-		tp.AddField(dueStr)
-
-		tp.EndRow()
+		tt.AddTask(t)
 	}
-
-	return tp.Render()
+	tbl := tt.Table()
+	if err := flags.Apply(cfg, tbl); err != nil {
+		return err
+	}
+	return f.PrintTable(tbl)
 }
@@ -2,13 +2,18 @@ package task
 
 import (
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
 )
 
 var sampleTasksJSON = `{
@@ -100,3 +105,161 @@ func TestTaskList_NoListError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no list specified")
 }
+
+func TestTaskList_ColumnsAndSort(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.Format = "csv"
+	tf.Handle("GET", "list/mylist/task", 200, `{"tasks": [
+		{"id": "t1", "name": "Later", "due_date": "1700000000000", "priority": {"priority": "low"},
+		 "custom_fields": [{"name": "Story Type", "type": "short_text", "value": "bug"}]},
+		{"id": "t2", "name": "Sooner", "due_date": "1690000000000", "priority": {"priority": "urgent"},
+		 "custom_fields": [{"name": "Story Type", "type": "short_text", "value": "feature"}]},
+		{"id": "t3", "name": "Undated", "priority": {"priority": "urgent"}}
+	]}`)
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist",
+		"--columns", `id,name,field:"Story Type"`, "--sort", "-priority,due")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ID,NAME,STORY TYPE\nt2,Sooner,feature\nt3,Undated,\nt1,Later,bug\n", tf.OutBuf.String())
+}
+
+func TestTaskList_SavedColumns(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.Format = "csv"
+	tf.Factory.SetConfig(&config.Config{Columns: map[string]string{"task-list": "name,status"}})
+	tf.Handle("GET", "list/mylist/task", 200, sampleTasksJSON)
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist")
+	require.NoError(t, err)
+	assert.Equal(t, "NAME,STATUS\nFix bug,open\n", tf.OutBuf.String())
+
+	err = testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist", "--columns", "id,colour")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "colour"`)
+}
+
+func TestTaskList_FormatCSV(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.Format = "csv"
+	tf.Handle("GET", "list/mylist/task", 200, sampleTasksJSON)
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ID,NAME,STATUS,PRIORITY,ASSIGNEE,TAGS,DUE\nt1,Fix bug,open,high,,,\n", tf.OutBuf.String(),
+		"formatted output should have no footer")
+}
+
+// handleTaskPages serves list/mylist/task as three pages of tasks t1..t5
+// and records the pages requested.
+func handleTaskPages(tf *testutil.TestFactory) *[]string {
+	pages := []string{
+		`{"tasks": [{"id": "t1", "name": "One"}, {"id": "t2", "name": "Two"}], "last_page": false}`,
+		`{"tasks": [{"id": "t3", "name": "Three"}, {"id": "t4", "name": "Four"}], "last_page": false}`,
+		`{"tasks": [{"id": "t5", "name": "Five"}], "last_page": true}`,
+	}
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	tf.HandleFunc("list/mylist/task", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		mu.Lock()
+		requested = append(requested, page)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		n, _ := strconv.Atoi(page)
+		if n >= len(pages) {
+			w.Write([]byte(`{"tasks": [], "last_page": true}`))
+			return
+		}
+		w.Write([]byte(pages[n]))
+	})
+	return &requested
+}
+
+func TestTaskList_Pagination(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      string
+		wantPages []string
+	}{
+		{"one page by default", nil, "ID\nt1\nt2\n", []string{"0"}},
+		{"start page", []string{"--page", "1"}, "ID\nt3\nt4\n", []string{"1"}},
+		{"limit", []string{"--limit", "3"}, "ID\nt1\nt2\nt3\n", []string{"0", "1"}},
+		{"all", []string{"--all"}, "ID\nt1\nt2\nt3\nt4\nt5\n", []string{"0", "1", "2", "3", "4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := testutil.NewTestFactory(t)
+			tf.Factory.Format = "csv"
+			requested := handleTaskPages(tf)
+
+			args := append([]string{"--list-id", "mylist", "--columns", "id"}, tt.args...)
+			require.NoError(t, testutil.RunCommand(t, NewCmdList(tf.Factory), args...))
+
+			assert.Equal(t, tt.want, tf.OutBuf.String())
+			sort.Strings(*requested)
+			assert.Equal(t, tt.wantPages, *requested)
+		})
+	}
+}
+
+func TestTaskList_StreamsNDJSON(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.Format = "ndjson"
+	handleTaskPages(tf)
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist", "--columns", "id,name", "--all")
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"id":"t1","name":"One"}
+{"id":"t2","name":"Two"}
+{"id":"t3","name":"Three"}
+{"id":"t4","name":"Four"}
+{"id":"t5","name":"Five"}
+`, tf.OutBuf.String())
+}
+
+func TestTaskList_LimitAndAllExclusive(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", "mylist", "--limit", "5", "--all")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "none of the others can be")
+}
+
+func TestTaskList_FilterExpression(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	tf.Factory.Format = "csv"
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	me := []fakeclickup.User{{ID: 1}}
+	soon := strconv.FormatInt(time.Now().Add(24*time.Hour).UnixMilli(), 10)
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix login", Assignees: me, Tags: []fakeclickup.Tag{{Name: "backend"}}, Points: "5", DueDate: soon})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Fix logout", Assignees: me, Tags: []fakeclickup.Tag{{Name: "backend"}, {Name: "wontfix"}}, Points: "5", DueDate: soon})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Small fix", Assignees: me, Tags: []fakeclickup.Tag{{Name: "backend"}}, Points: "1", DueDate: soon})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Later fix", Assignees: me, Tags: []fakeclickup.Tag{{Name: "backend"}}, Points: "8"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Shipped fix", Status: fakeclickup.Status{Status: "complete"}, Assignees: me})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "Docs"})
+
+	run := func(args ...string) string {
+		t.Helper()
+		tf.OutBuf.Reset()
+		args = append([]string{"--list-id", list.ID, "--columns", "name"}, args...)
+		require.NoError(t, testutil.RunCommand(t, NewCmdList(tf.Factory), args...))
+		return tf.OutBuf.String()
+	}
+
+	assert.Equal(t, "NAME\nFix login\n", run(`assignee:me tag:backend -tag:wontfix points>=3 due<+3d`))
+	assert.Equal(t, "NAME\nShipped fix\n", run(`status:complete`), "status filters include closed tasks")
+	assert.Equal(t, "NAME\nDocs\n", run(`assignee:none`))
+	assert.Equal(t, "NAME\nFix login\nFix logout\n", run("fix", "log", "--status", "to do"))
+	assert.Equal(t, "NAME\nSmall fix\nLater fix\n", run("--sprint", "backend", "--limit", "2", "--", "-points:5"), "--limit counts matches")
+
+	err := testutil.RunCommand(t, NewCmdList(tf.Factory), "--list-id", list.ID, "due<soon")
+	assert.ErrorContains(t, err, `invalid date "soon"`)
+}
@@ -0,0 +1,392 @@
+package task
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+	"gopkg.in/yaml.v3"
+)
+
+// A plan is a markdown outline of tasks. The shallowest heading level in
+// the document names tasks, the level below names their subtasks, and
+// deeper headings name checklists. Task list items ("- [ ] ...") are
+// checklist items, and a task's other content is its description.
+
+// planTask is a task described by a plan heading.
+type planTask struct {
+	name string
+	// id is the task ID written after the heading, empty until the task
+	// is created.
+	id string
+	// fields are the key: value lines under the heading, as a record.
+	fields map[string]string
+	// description is the markdown under the heading, less fields and
+	// checklists. It is nil when the heading has no other content.
+	description *string
+	checklists  []*planChecklist
+	subtasks    []*planTask
+	parent      *planTask
+
+	// idAt is the offset in the file where the heading's ID comment goes.
+	idAt int
+}
+
+// planChecklist is a checklist of a plan task.
+type planChecklist struct {
+	name  string
+	items []planItem
+}
+
+// planItem is a checklist item.
+type planItem struct {
+	name     string
+	resolved bool
+}
+
+// planDoc is a parsed plan.
+type planDoc struct {
+	// list is the list new tasks are created in, from the front matter.
+	list string
+	// defaults are the front matter fields, applied to every task.
+	defaults map[string]string
+	tasks    []*planTask
+}
+
+// defaultChecklistName names the checklist of items that are not under a
+// checklist heading.
+const defaultChecklistName = "Checklist"
+
+var (
+	planIDComment = regexp.MustCompile(`\s*<!--\s*id:\s*([^\s>]+)\s*-->\s*$`)
+	planFieldLine = regexp.MustCompile(`^([^:\s][^:]*):[ \t]*(.*)$`)
+	planTaskItem  = regexp.MustCompile(`^\[([ xX])\][ \t]+(.+)$`)
+)
+
+// planStructureFields are set by a plan's structure, not by field lines.
+var planStructureFields = []string{"id", "custom_id", "name", "parent", "description"}
+
+// flatten returns the tasks of the plan with each parent before its
+// subtasks.
+func (d *planDoc) flatten() []*planTask {
+	var all []*planTask
+	for _, t := range d.tasks {
+		all = append(all, t)
+		all = append(all, t.subtasks...)
+	}
+	return all
+}
+
+// splitFrontMatter separates a leading block of YAML between --- lines
+// from the rest of src. offset is where the rest starts in src.
+func splitFrontMatter(src []byte) (front []byte, offset int, err error) {
+	rest, ok := bytes.CutPrefix(src, []byte("---\n"))
+	if !ok {
+		rest, ok = bytes.CutPrefix(src, []byte("---\r\n"))
+	}
+	if !ok {
+		return nil, 0, nil
+	}
+	start := len(src) - len(rest)
+	for pos := start; pos <= len(src); {
+		end := bytes.IndexByte(src[pos:], '\n')
+		line := src[pos:]
+		if end >= 0 {
+			line = src[pos : pos+end]
+		}
+		if string(bytes.TrimRight(line, "\r \t")) == "---" {
+			next := len(src)
+			if end >= 0 {
+				next = pos + end + 1
+			}
+			return src[start:pos], next, nil
+		}
+		if end < 0 {
+			break
+		}
+		pos += end + 1
+	}
+	return nil, 0, fmt.Errorf("front matter is not closed with ---")
+}
+
+// parsePlan reads a plan from src.
+func parsePlan(src []byte) (*planDoc, error) {
+	front, offset, err := splitFrontMatter(src)
+	if err != nil {
+		return nil, err
+	}
+	doc := &planDoc{defaults: map[string]string{}}
+	if len(front) > 0 {
+		var m map[string]any
+		if err := yaml.Unmarshal(front, &m); err != nil {
+			return nil, fmt.Errorf("invalid front matter: %w", err)
+		}
+		for key, v := range m {
+			if key == "list" {
+				doc.list = yamlRecordValue(v)
+				continue
+			}
+			key = planFieldKey(key)
+			if slices.Contains(planStructureFields, key) {
+				return nil, fmt.Errorf("front matter: %s is set by the document, not a field", key)
+			}
+			doc.defaults[key] = yamlRecordValue(v)
+		}
+	}
+
+	// Parse the whole file so that offsets are offsets into src, with the
+	// front matter blanked out so it is not read as markdown.
+	body := slices.Clone(src)
+	for i := 0; i < offset; i++ {
+		if body[i] != '\n' {
+			body[i] = ' '
+		}
+	}
+	root := goldmark.New().Parser().Parse(text.NewReader(body))
+
+	p := &planParser{src: src, doc: doc, descStart: -1}
+	for n := root.FirstChild(); n != nil; n = n.NextSibling() {
+		if h, ok := n.(*ast.Heading); ok && (p.taskLevel == 0 || h.Level < p.taskLevel) {
+			p.taskLevel = h.Level
+		}
+	}
+	for n := root.FirstChild(); n != nil; n = n.NextSibling() {
+		if err := p.block(n); err != nil {
+			return nil, err
+		}
+	}
+	p.finishSection()
+	return doc, nil
+}
+
+// planParser walks the top-level blocks of a plan.
+type planParser struct {
+	src       []byte
+	doc       *planDoc
+	taskLevel int
+
+	// task is the task whose section is being read, and checklist the
+	// checklist heading within it, if any.
+	task      *planTask
+	checklist *planChecklist
+	// fieldsDone is set once the first block of a task has been seen.
+	fieldsDone bool
+	// descStart and desc collect the description of task.
+	descStart int
+	desc      []string
+}
+
+func (p *planParser) block(n ast.Node) error {
+	if h, ok := n.(*ast.Heading); ok {
+		return p.heading(h)
+	}
+	if p.task == nil {
+		// Content before the first heading is not part of any task.
+		return nil
+	}
+	start := blockStart(p.src, n)
+	if start >= 0 {
+		p.flushDescription(start)
+	}
+
+	seen := p.fieldsDone
+	p.fieldsDone = true
+	if !seen {
+		if para, ok := n.(*ast.Paragraph); ok {
+			if fields, ok := p.fieldLines(para); ok {
+				for key := range fields {
+					if slices.Contains(planStructureFields, key) {
+						return fmt.Errorf("%q: %s is set by the document, not a field", p.task.name, key)
+					}
+				}
+				p.task.fields = fields
+				return nil
+			}
+		}
+	}
+
+	if list, ok := n.(*ast.List); ok {
+		if items, ok := p.checklistItems(list); ok {
+			if p.checklist == nil {
+				p.checklist = p.taskChecklist(defaultChecklistName)
+			}
+			p.checklist.items = append(p.checklist.items, items...)
+			return nil
+		}
+	}
+	if p.checklist != nil && p.checklist.name != defaultChecklistName {
+		// Text under a checklist heading has nowhere to go.
+		return nil
+	}
+	p.descStart = start
+	return nil
+}
+
+func (p *planParser) heading(h *ast.Heading) error {
+	lines := h.Lines()
+	if lines.Len() == 0 {
+		return fmt.Errorf("heading without a name")
+	}
+	p.flushDescription(blockStart(p.src, h))
+
+	last := lines.At(lines.Len() - 1)
+	title := strings.TrimSpace(string(p.src[lines.At(0).Start:last.Stop]))
+	idAt := last.Stop
+
+	if h.Level > p.taskLevel+1 {
+		if p.task == nil {
+			return fmt.Errorf("checklist %q is not under a task heading", title)
+		}
+		p.checklist = p.taskChecklist(title)
+		p.descStart = -1
+		return nil
+	}
+
+	p.finishSection()
+	t := &planTask{name: title, idAt: idAt}
+	if m := planIDComment.FindStringSubmatchIndex(title); m != nil {
+		t.id = title[m[2]:m[3]]
+		t.name = strings.TrimSpace(title[:m[0]])
+	}
+	if t.name == "" {
+		return fmt.Errorf("heading without a name")
+	}
+
+	if h.Level > p.taskLevel {
+		if len(p.doc.tasks) == 0 {
+			return fmt.Errorf("subtask %q is not under a task heading", t.name)
+		}
+		t.parent = p.doc.tasks[len(p.doc.tasks)-1]
+		t.parent.subtasks = append(t.parent.subtasks, t)
+	} else {
+		p.doc.tasks = append(p.doc.tasks, t)
+	}
+	p.task, p.checklist, p.fieldsDone, p.descStart = t, nil, false, -1
+	return nil
+}
+
+// finishSection stores the description collected for the current task.
+func (p *planParser) finishSection() {
+	p.flushDescription(len(p.src))
+	if p.task != nil && len(p.desc) > 0 {
+		desc := strings.Join(p.desc, "\n\n")
+		p.task.description = &desc
+	}
+	p.desc = nil
+}
+
+// flushDescription adds the description text that runs up to end.
+func (p *planParser) flushDescription(end int) {
+	if p.descStart < 0 || p.task == nil {
+		return
+	}
+	if s := strings.TrimSpace(string(p.src[p.descStart:end])); s != "" {
+		p.desc = append(p.desc, s)
+	}
+	p.descStart = -1
+}
+
+// taskChecklist returns the current task's checklist with the given name,
+// adding it if needed.
+func (p *planParser) taskChecklist(name string) *planChecklist {
+	for _, cl := range p.task.checklists {
+		if strings.EqualFold(cl.name, name) {
+			return cl
+		}
+	}
+	cl := &planChecklist{name: name}
+	p.task.checklists = append(p.task.checklists, cl)
+	return cl
+}
+
+// fieldLines reads a paragraph made only of key: value lines.
+func (p *planParser) fieldLines(para *ast.Paragraph) (map[string]string, bool) {
+	lines := para.Lines()
+	fields := make(map[string]string, lines.Len())
+	for i := 0; i < lines.Len(); i++ {
+		seg := lines.At(i)
+		m := planFieldLine.FindStringSubmatch(strings.TrimSpace(string(seg.Value(p.src))))
+		if m == nil {
+			return nil, false
+		}
+		fields[planFieldKey(m[1])] = strings.TrimSpace(m[2])
+	}
+	return fields, len(fields) > 0
+}
+
+// checklistItems reads a list whose items are all task list items.
+func (p *planParser) checklistItems(list *ast.List) ([]planItem, bool) {
+	var items []planItem
+	for li := list.FirstChild(); li != nil; li = li.NextSibling() {
+		block := li.FirstChild()
+		if block == nil || block.Lines().Len() == 0 {
+			return nil, false
+		}
+		first := block.Lines().At(0)
+		m := planTaskItem.FindStringSubmatch(strings.TrimSpace(string(first.Value(p.src))))
+		if m == nil {
+			return nil, false
+		}
+		items = append(items, planItem{name: strings.TrimSpace(m[2]), resolved: m[1] != " "})
+	}
+	return items, len(items) > 0
+}
+
+// planFieldKey maps a field line key to a record key: standard fields may
+// be written like "Due date", and any other name is a custom field. A
+// custom field named like a standard field is written field:<name>.
+func planFieldKey(key string) string {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, customFieldPrefix) {
+		return key
+	}
+	std := strings.ReplaceAll(strings.ToLower(key), " ", "_")
+	if slices.Contains(recordFields, std) || slices.Contains(recordReadOnlyFields, std) {
+		return std
+	}
+	return customFieldKey(key)
+}
+
+// blockStart returns the offset of the start of the line n begins on, or
+// -1 if n has no source lines.
+func blockStart(src []byte, n ast.Node) int {
+	start := -1
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || c.Type() != ast.TypeBlock || c.Lines().Len() == 0 {
+			return ast.WalkContinue, nil
+		}
+		start = c.Lines().At(0).Start
+		return ast.WalkStop, nil
+	})
+	if start < 0 {
+		return -1
+	}
+	return bytes.LastIndexByte(src[:start], '\n') + 1
+}
+
+// writePlanIDs returns src with an ID comment after the heading of each
+// task that was created.
+func writePlanIDs(src []byte, tasks []*planTask, created map[*planTask]string) []byte {
+	type insert struct {
+		at int
+		s  string
+	}
+	var inserts []insert
+	for _, t := range tasks {
+		if id := created[t]; id != "" {
+			inserts = append(inserts, insert{t.idAt, " <!-- id: " + id + " -->"})
+		}
+	}
+	sort.Slice(inserts, func(i, j int) bool { return inserts[i].at > inserts[j].at })
+	out := slices.Clone(src)
+	for _, in := range inserts {
+		out = slices.Insert(out, in.at, []byte(in.s)...)
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlan = `---
+list: 901234
+tags: [q3, checkout]
+Sprint Goal: Ship it
+---
+
+Notes before the first heading are ignored.
+
+# Checkout redesign <!-- id: 86abc -->
+
+status: in progress
+Due date: 2026-11-01
+
+The new checkout flow.
+
+- [ ] Sign off design
+- [x] Pick a payment provider
+
+## Payment form
+
+Build the card form.
+
+- plain list stays in the description
+
+### QA
+- [ ] Safari
+- [X] Firefox
+
+## Receipts <!-- id: 86abd -->
+
+# Search
+`
+
+func TestParsePlan(t *testing.T) {
+	doc, err := parsePlan([]byte(testPlan))
+	require.NoError(t, err)
+
+	assert.Equal(t, "901234", doc.list)
+	assert.Equal(t, map[string]string{"tags": "q3, checkout", "field:Sprint Goal": "Ship it"}, doc.defaults)
+
+	require.Len(t, doc.tasks, 2)
+	epic := doc.tasks[0]
+	assert.Equal(t, "Checkout redesign", epic.name)
+	assert.Equal(t, "86abc", epic.id)
+	assert.Equal(t, map[string]string{"status": "in progress", "due_date": "2026-11-01"}, epic.fields)
+	require.NotNil(t, epic.description)
+	assert.Equal(t, "The new checkout flow.", *epic.description)
+	require.Len(t, epic.checklists, 1)
+	assert.Equal(t, defaultChecklistName, epic.checklists[0].name)
+	assert.Equal(t, []planItem{{"Sign off design", false}, {"Pick a payment provider", true}}, epic.checklists[0].items)
+
+	require.Len(t, epic.subtasks, 2)
+	form := epic.subtasks[0]
+	assert.Equal(t, "Payment form", form.name)
+	assert.Same(t, epic, form.parent)
+	assert.Empty(t, form.id)
+	assert.Nil(t, form.fields)
+	require.NotNil(t, form.description)
+	assert.Equal(t, "Build the card form.\n\n- plain list stays in the description", *form.description)
+	require.Len(t, form.checklists, 1)
+	assert.Equal(t, "QA", form.checklists[0].name)
+	assert.Equal(t, []planItem{{"Safari", false}, {"Firefox", true}}, form.checklists[0].items)
+
+	assert.Equal(t, "86abd", epic.subtasks[1].id)
+	assert.Nil(t, epic.subtasks[1].description)
+
+	assert.Equal(t, "Search", doc.tasks[1].name)
+	assert.Len(t, doc.flatten(), 4)
+}
+
+func TestParsePlan_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"unclosed front matter", "---\nlist: 1\n# Task\n", "not closed"},
+		{"structure field", "# Task\n\nparent: 123\n", "parent is set by the document"},
+		{"front matter name", "---\nname: x\n---\n# Task\n", "name is set by the document"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePlan([]byte(tt.src))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestWritePlanIDs(t *testing.T) {
+	src := []byte(testPlan)
+	doc, err := parsePlan(src)
+	require.NoError(t, err)
+
+	tasks := doc.flatten()
+	out := writePlanIDs(src, tasks, map[*planTask]string{
+		tasks[1]: "86new1",
+		tasks[3]: "86new2",
+	})
+	assert.Contains(t, string(out), "## Payment form <!-- id: 86new1 -->\n")
+	assert.Contains(t, string(out), "# Search <!-- id: 86new2 -->\n")
+
+	again, err := parsePlan(out)
+	require.NoError(t, err)
+	assert.Equal(t, "86new1", again.tasks[0].subtasks[0].id)
+	assert.Equal(t, "Payment form", again.tasks[0].subtasks[0].name)
+	assert.Equal(t, "86new2", again.tasks[1].id)
+}
@@ -106,28 +106,12 @@ func runRecent(opts *recentOptions) error {
 		fmt.Fprintf(ios.ErrOut, "Active locations: %s\n\n", strings.Join(locations, ", "))
 	}
 
-	tp := tableprinter.New(ios)
-	tp.AddField(cs.Bold("ID"))
-	tp.AddField(cs.Bold("NAME"))
-	tp.AddField(cs.Bold("STATUS"))
-	tp.AddField(cs.Bold("FOLDER"))
-	tp.AddField(cs.Bold("LIST"))
-	tp.EndRow()
-	tp.SetTruncateColumn(1)
-
-	for _, t := range tasks {
-		tp.AddField(t.ID)
-		tp.AddField(t.Name)
-		statusFn := cs.StatusColor(strings.ToLower(t.Status))
-		tp.AddField(statusFn(t.Status))
-		tp.AddField(t.FolderName)
-		tp.AddField(t.ListName)
-		tp.EndRow()
-	}
-
-	if err := tp.Render(); err != nil {
+	if err := opts.factory.PrintTable(recentTaskTable(opts.factory, tasks)); err != nil {
 		return err
 	}
+	if opts.factory.WantsFormat() {
+		return nil
+	}
 
 	// Quick actions footer
 	fmt.Fprintln(ios.Out)
@@ -141,3 +125,20 @@ func runRecent(opts *recentOptions) error {
 
 	return nil
 }
+
+// recentTaskTable builds the rows shown by 'task recent' and by 'task
+// search' without a query.
+func recentTaskTable(f *cmdutil.Factory, tasks []cmdutil.RecentTask) *tableprinter.Table {
+	cs := f.IOStreams.ColorScheme()
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "NAME", Truncate: true},
+		tableprinter.Column{Header: "STATUS", Color: func(s string) string { return cs.StatusColor(strings.ToLower(s))(s) }},
+		tableprinter.Column{Header: "FOLDER"},
+		tableprinter.Column{Header: "LIST"},
+	)
+	for _, t := range tasks {
+		tbl.AddRow(t.ID, t.Name, t.Status, t.FolderName, t.ListName)
+	}
+	return tbl
+}
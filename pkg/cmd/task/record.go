@@ -0,0 +1,425 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
+)
+
+// A task record is a task's fields as text, keyed by field name, with
+// custom fields keyed by "field:" and their name. It is the form tasks take
+// in exported files, so a record can be edited and written back.
+
+// recordFields are the standard fields of a task record, in file order.
+// id and custom_id identify the task and are never changed.
+var recordFields = []string{
+	"id", "custom_id", "name", "status", "priority", "assignees", "tags",
+	"due_date", "start_date", "points", "time_estimate", "parent", "description",
+}
+
+// recordReadOnlyFields are exported for reference and ignored when a
+// record is written back.
+var recordReadOnlyFields = []string{"list", "url", "date_created", "date_updated"}
+
+// customFieldPrefix starts the record key of a custom field, so a custom
+// field named like a standard field, such as "Status", keeps its own key.
+const customFieldPrefix = "field:"
+
+// customFieldKey returns the record key of the custom field named name.
+func customFieldKey(name string) string {
+	return customFieldPrefix + name
+}
+
+// priorityNames maps ClickUp priority levels to their names in records.
+var priorityNames = []string{"", "urgent", "high", "normal", "low"}
+
+// taskRecord returns the record of t.
+func taskRecord(t *clickup.Task) map[string]string {
+	assignees := make([]string, 0, len(t.Assignees))
+	for _, a := range t.Assignees {
+		assignees = append(assignees, a.Username)
+	}
+	var due string
+	if t.DueDate != nil {
+		if dt := t.DueDate.Time(); dt != nil {
+			due = dt.Format("2006-01-02")
+		}
+	}
+	points := t.Points.Value.String()
+	if points == "0" || strings.HasPrefix(points, "-") {
+		points = ""
+	}
+
+	rec := map[string]string{
+		"id":            t.ID,
+		"custom_id":     t.CustomID,
+		"name":          t.Name,
+		"status":        t.Status.Status,
+		"priority":      strings.ToLower(t.Priority.Priority),
+		"assignees":     strings.Join(assignees, ", "),
+		"tags":          strings.Join(tagNames(t.Tags), ", "),
+		"due_date":      due,
+		"start_date":    recordDate(t.StartDate),
+		"points":        points,
+		"time_estimate": recordDuration(t.TimeEstimate),
+		"parent":        t.Parent,
+		"description":   t.Description,
+		"list":          t.List.Name,
+		"url":           t.URL,
+		"date_created":  recordDate(t.DateCreated),
+		"date_updated":  recordDate(t.DateUpdated),
+	}
+	for _, cf := range t.CustomFields {
+		rec[customFieldKey(cf.Name)] = cmdutil.FormatCustomFieldValue(cf)
+	}
+	return rec
+}
+
+func tagNames(tags []clickup.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// recordDate formats a millisecond timestamp as YYYY-MM-DD.
+func recordDate(ms string) string {
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || n <= 0 {
+		return ""
+	}
+	return time.UnixMilli(n).Format("2006-01-02")
+}
+
+// recordDuration formats milliseconds in the form parseDuration reads,
+// e.g. 1h30m.
+func recordDuration(ms int64) string {
+	d := (time.Duration(ms) * time.Millisecond).Round(time.Minute)
+	if d <= 0 {
+		return ""
+	}
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh%dm", h, m)
+}
+
+// recordChange is a field whose value differs between a task and a record.
+type recordChange struct {
+	Field    string
+	From, To string
+}
+
+// fieldUpdate sets or clears a custom field.
+type fieldUpdate struct {
+	field *clickup.CustomField
+	value any
+	clear bool
+}
+
+// taskUpdate holds the requests that bring a task in line with a record.
+type taskUpdate struct {
+	changes []recordChange
+	// body is sent with PUT task/{id}.
+	body    map[string]any
+	tags    []string
+	setTags bool
+	fields  []fieldUpdate
+}
+
+// planUpdate works out the changes that make t match rec. Fields rec does
+// not have are left alone, and an empty value clears a field. Values are
+// checked here, so a plan that succeeds can be shown before it is applied.
+func planUpdate(t *clickup.Task, rec map[string]string, resolve UserResolver) (*taskUpdate, error) {
+	current := taskRecord(t)
+	u := &taskUpdate{body: map[string]any{}}
+
+	var custom []string
+	for key := range rec {
+		switch {
+		case strings.HasPrefix(key, customFieldPrefix):
+			custom = append(custom, key)
+		case !slices.Contains(recordFields, key) && !slices.Contains(recordReadOnlyFields, key):
+			return nil, fmt.Errorf("unknown field %q; custom fields are written as %q", key, customFieldKey(key))
+		}
+	}
+	slices.Sort(custom)
+
+	for _, key := range append(slices.Clone(recordFields), custom...) {
+		to, ok := rec[key]
+		if !ok || key == "id" || key == "custom_id" {
+			continue
+		}
+		to = strings.TrimSpace(to)
+		from := current[key]
+
+		if key == "assignees" {
+			if sameRecordValue("users", from, to) {
+				continue
+			}
+			add, rem, err := assigneeChanges(t, to, resolve)
+			if err != nil {
+				return nil, err
+			}
+			if len(add) > 0 || len(rem) > 0 {
+				u.body["assignees"] = clickup.TaskAssigneeUpdateRequest{Add: add, Rem: rem}
+				u.changes = append(u.changes, recordChange{key, from, to})
+			}
+			continue
+		}
+
+		var cf *clickup.CustomField
+		kind := key
+		if slices.Contains(custom, key) {
+			name := strings.TrimPrefix(key, customFieldPrefix)
+			if cf = resolveFieldByName(t.CustomFields, name); cf == nil {
+				return nil, fmt.Errorf("unknown custom field %q: not a custom field of the list", name)
+			}
+			from, kind = cmdutil.FormatCustomFieldValue(*cf), cf.Type
+		}
+		if sameRecordValue(kind, from, to) {
+			continue
+		}
+		u.changes = append(u.changes, recordChange{key, from, to})
+
+		if cf != nil {
+			fu, err := customFieldUpdate(cf, to, resolve)
+			if err != nil {
+				return nil, err
+			}
+			u.fields = append(u.fields, fu)
+			continue
+		}
+		if err := u.setStandard(key, from, to); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// setStandard adds the change of a standard field to the update.
+func (u *taskUpdate) setStandard(key, from, to string) error {
+	switch key {
+	case "name":
+		if to == "" {
+			return fmt.Errorf("name cannot be empty")
+		}
+		u.body["name"] = to
+	case "status":
+		if to == "" {
+			return fmt.Errorf("status cannot be empty")
+		}
+		u.body["status"] = to
+	case "description":
+		u.body["description"] = to
+	case "priority":
+		p, err := parseRecordPriority(to)
+		if err != nil {
+			return err
+		}
+		if p == 0 {
+			u.body["priority"] = nil
+		} else {
+			u.body["priority"] = p
+		}
+	case "tags":
+		u.tags, u.setTags = text.SplitAndTrim(to), true
+	case "due_date", "start_date":
+		if to == "" {
+			u.body[key] = nil
+			return nil
+		}
+		d, err := parseDate(to)
+		if err != nil {
+			return err
+		}
+		u.body[key] = d
+	case "points":
+		if to == "" {
+			u.body["points"] = -1
+			return nil
+		}
+		p, err := strconv.ParseFloat(to, 64)
+		if err != nil {
+			return fmt.Errorf("invalid points %q", to)
+		}
+		u.body["points"] = p
+	case "time_estimate":
+		if to == "" {
+			u.body["time_estimate"] = nil
+			return nil
+		}
+		ms, err := parseDuration(to)
+		if err != nil {
+			return err
+		}
+		u.body["time_estimate"] = ms
+	case "parent":
+		if to == "" {
+			return fmt.Errorf("cannot remove the parent of a subtask (was %s)", from)
+		}
+		u.body["parent"] = to
+	}
+	return nil
+}
+
+// assigneeChanges returns the user IDs to add to and remove from t's
+// assignees to leave the comma-separated users in value.
+func assigneeChanges(t *clickup.Task, value string, resolve UserResolver) (add, rem []int, err error) {
+	var want []int
+	for _, name := range text.SplitAndTrim(value) {
+		id, err := resolve(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("assignee %q: %w", name, err)
+		}
+		want = append(want, id)
+	}
+	for _, id := range want {
+		if !slices.ContainsFunc(t.Assignees, func(u clickup.User) bool { return u.ID == id }) && !slices.Contains(add, id) {
+			add = append(add, id)
+		}
+	}
+	for _, a := range t.Assignees {
+		if !slices.Contains(want, a.ID) {
+			rem = append(rem, a.ID)
+		}
+	}
+	return add, rem, nil
+}
+
+// customFieldUpdate parses value for cf. Users fields also drop the users
+// value leaves out, so the field holds exactly those given.
+func customFieldUpdate(cf *clickup.CustomField, value string, resolve UserResolver) (fieldUpdate, error) {
+	if value == "" {
+		return fieldUpdate{field: cf, clear: true}, nil
+	}
+	v, err := parseFieldValue(cf, value, resolve)
+	if err != nil {
+		return fieldUpdate{}, err
+	}
+	if m, ok := v.(map[string]interface{}); ok && cf.Type == "users" {
+		add, _ := m["add"].([]int)
+		var rem []int
+		if users, ok := cf.Value.([]interface{}); ok {
+			for _, u := range users {
+				if um, ok := u.(map[string]interface{}); ok {
+					if id, ok := um["id"].(float64); ok && !slices.Contains(add, int(id)) {
+						rem = append(rem, int(id))
+					}
+				}
+			}
+		}
+		if len(rem) > 0 {
+			m["rem"] = rem
+		}
+	}
+	return fieldUpdate{field: cf, value: v}, nil
+}
+
+// sameRecordValue reports whether two values of a field of the given kind,
+// a standard field name or a custom field type, are equal.
+func sameRecordValue(kind, a, b string) bool {
+	switch kind {
+	case "status", "drop_down", "dropdown", "checkbox":
+		return strings.EqualFold(a, b)
+	case "tags", "labels", "users", "tasks":
+		norm := func(s string) []string {
+			vals := text.SplitAndTrim(strings.ToLower(s))
+			slices.Sort(vals)
+			return vals
+		}
+		return slices.Equal(norm(a), norm(b))
+	case "priority":
+		pa, errA := parseRecordPriority(a)
+		pb, errB := parseRecordPriority(b)
+		return errA == nil && errB == nil && pa == pb
+	case "points", "number":
+		fa, errA := strconv.ParseFloat(a, 64)
+		fb, errB := strconv.ParseFloat(b, 64)
+		if errA == nil && errB == nil {
+			return fa == fb
+		}
+	case "time_estimate":
+		da, errA := time.ParseDuration(a)
+		db, errB := time.ParseDuration(b)
+		if errA == nil && errB == nil {
+			return da == db
+		}
+	case "description":
+		return strings.TrimSpace(strings.ReplaceAll(a, "\r\n", "\n")) == strings.TrimSpace(strings.ReplaceAll(b, "\r\n", "\n"))
+	}
+	return a == b
+}
+
+// parseRecordPriority reads a priority name or level; empty is no priority.
+func parseRecordPriority(s string) (int, error) {
+	if s == "" || strings.EqualFold(s, "none") {
+		return 0, nil
+	}
+	if i := slices.Index(priorityNames, strings.ToLower(s)); i > 0 {
+		return i, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= 4 {
+		return n, nil
+	}
+	return 0, fmt.Errorf("invalid priority %q (use urgent, high, normal, low or 1-4)", s)
+}
+
+// apply sends the update for task t.
+func (u *taskUpdate) apply(ctx context.Context, client *api.Client, t *clickup.Task) error {
+	if len(u.body) > 0 {
+		if _, err := apiv2.UpdateTaskLocal(ctx, client, t.ID, u.body, ""); err != nil {
+			return err
+		}
+	}
+	if u.setTags {
+		if err := setTaskTags(client, t.ID, tagNames(t.Tags), u.tags); err != nil {
+			return fmt.Errorf("failed to set tags: %w", err)
+		}
+	}
+	for _, fu := range u.fields {
+		var err error
+		if fu.clear {
+			err = apiv2.RemoveCustomFieldValueLocal(ctx, client, t.ID, fu.field.ID, "")
+		} else {
+			err = apiv2.SetCustomFieldValueLocal(ctx, client, t.ID, fu.field.ID, fu.value, "")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set custom field %q: %w", fu.field.Name, err)
+		}
+	}
+	return nil
+}
+
+// createFromUpdate creates a task in listID from an update planned
+// against a blank task, as a subtask if the update sets a parent, then
+// applies the rest of the update to it.
+func createFromUpdate(ctx context.Context, client *api.Client, listID string, u *taskUpdate) (*clickup.Task, error) {
+	req := &clickup.TaskRequest{}
+	req.Name, _ = u.body["name"].(string)
+	req.Parent, _ = u.body["parent"].(string)
+	t, err := apiv2.CreateTaskLocal(ctx, client, listID, req, "")
+	if err != nil {
+		return nil, err
+	}
+	delete(u.body, "name")
+	delete(u.body, "parent")
+	if err := u.apply(ctx, client, t); err != nil {
+		return t, err
+	}
+	return t, nil
+}
@@ -0,0 +1,200 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+func recordTestTask() *clickup.Task {
+	return &clickup.Task{
+		ID:           "abc123",
+		CustomID:     "DEV-1",
+		Name:         "Fix login",
+		Status:       clickup.TaskStatus{Status: "in progress"},
+		Priority:     clickup.TaskPriority{Priority: "High"},
+		Assignees:    []clickup.User{{ID: 1, Username: "alice"}, {ID: 2, Username: "bob"}},
+		Tags:         []clickup.Tag{{Name: "bug"}, {Name: "auth"}},
+		TimeEstimate: 90 * 60 * 1000,
+		CustomFields: []clickup.CustomField{
+			*makeDropdownField("Stage", []map[string]interface{}{
+				{"id": "opt-a", "name": "Design", "orderindex": float64(0)},
+				{"id": "opt-b", "name": "Build", "orderindex": float64(1)},
+			}),
+		},
+	}
+}
+
+func recordTestResolver(input string) (int, error) {
+	switch strings.ToLower(input) {
+	case "alice":
+		return 1, nil
+	case "bob":
+		return 2, nil
+	case "carol":
+		return 3, nil
+	}
+	return 0, fmt.Errorf("no member matching %q", input)
+}
+
+func TestTaskRecord(t *testing.T) {
+	task := recordTestTask()
+	task.CustomFields[0].Value = float64(1)
+
+	rec := taskRecord(task)
+
+	assert.Equal(t, "abc123", rec["id"])
+	assert.Equal(t, "DEV-1", rec["custom_id"])
+	assert.Equal(t, "high", rec["priority"])
+	assert.Equal(t, "alice, bob", rec["assignees"])
+	assert.Equal(t, "bug, auth", rec["tags"])
+	assert.Equal(t, "1h30m", rec["time_estimate"])
+	assert.Equal(t, "", rec["points"])
+	assert.Equal(t, "Build", rec["field:Stage"])
+}
+
+func TestRecordDuration(t *testing.T) {
+	assert.Equal(t, "", recordDuration(0))
+	assert.Equal(t, "45m", recordDuration(45*60*1000))
+	assert.Equal(t, "2h", recordDuration(2*60*60*1000))
+	assert.Equal(t, "1h5m", recordDuration(65*60*1000))
+}
+
+func TestPlanUpdate_Unchanged(t *testing.T) {
+	task := recordTestTask()
+	rec := taskRecord(task)
+	// Reordered lists and a differently spelled priority are the same value.
+	rec["tags"] = "auth, bug"
+	rec["priority"] = "2"
+	rec["url"] = "https://example.com/ignored"
+
+	u, err := planUpdate(task, rec, recordTestResolver)
+	require.NoError(t, err)
+	assert.Empty(t, u.changes)
+	assert.Empty(t, u.body)
+}
+
+func TestPlanUpdate_Changes(t *testing.T) {
+	task := recordTestTask()
+	rec := map[string]string{
+		"id":            "abc123",
+		"name":          "Fix login flow",
+		"priority":      "",
+		"assignees":     "bob, carol",
+		"tags":          "bug",
+		"time_estimate": "2h",
+		"field:Stage":   "design",
+	}
+
+	u, err := planUpdate(task, rec, recordTestResolver)
+	require.NoError(t, err)
+
+	var fields []string
+	for _, c := range u.changes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"name", "priority", "assignees", "tags", "time_estimate", "field:Stage"}, fields)
+
+	assert.Equal(t, "Fix login flow", u.body["name"])
+	assert.Contains(t, u.body, "priority")
+	assert.Nil(t, u.body["priority"])
+	assert.Equal(t, clickup.TaskAssigneeUpdateRequest{Add: []int{3}, Rem: []int{1}}, u.body["assignees"])
+	assert.Equal(t, 2*60*60*1000, u.body["time_estimate"])
+	assert.True(t, u.setTags)
+	assert.Equal(t, []string{"bug"}, u.tags)
+
+	require.Len(t, u.fields, 1)
+	assert.Equal(t, "Stage", u.fields[0].field.Name)
+	assert.Equal(t, "opt-a", u.fields[0].value)
+}
+
+func TestPlanUpdate_CustomFieldNamedLikeStandardField(t *testing.T) {
+	task := recordTestTask()
+	task.CustomFields = append(task.CustomFields, clickup.CustomField{ID: "cf-status", Name: "Status", Type: "short_text", Value: "on track"})
+
+	rec := taskRecord(task)
+	assert.Equal(t, "in progress", rec["status"])
+	assert.Equal(t, "on track", rec["field:Status"])
+
+	rec["field:Status"] = "blocked"
+	u, err := planUpdate(task, rec, recordTestResolver)
+	require.NoError(t, err)
+	assert.NotContains(t, u.body, "status")
+	require.Len(t, u.fields, 1)
+	assert.Equal(t, "cf-status", u.fields[0].field.ID)
+	assert.Equal(t, "blocked", u.fields[0].value)
+}
+
+func TestPlanUpdate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		rec  map[string]string
+		want string
+	}{
+		{"unknown field", map[string]string{"Owner": "x"}, `unknown field "Owner"; custom fields are written as "field:Owner"`},
+		{"unknown custom field", map[string]string{"field:Owner": "x"}, `unknown custom field "Owner"`},
+		{"empty name", map[string]string{"name": ""}, "name cannot be empty"},
+		{"bad priority", map[string]string{"priority": "asap"}, "invalid priority"},
+		{"unknown assignee", map[string]string{"assignees": "dave"}, `assignee "dave"`},
+		{"unknown option", map[string]string{"field:Stage": "Ship"}, "Ship"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := planUpdate(recordTestTask(), tt.rec, recordTestResolver)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestReadCSVRecords(t *testing.T) {
+	in := "\ufeffid,name,Stage\nabc123,Fix login,Build\n,,\n,New task,\n"
+	records, err := readCSVRecords(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]string{"id": "abc123", "name": "Fix login", "Stage": "Build"}, records[0])
+	assert.Equal(t, "New task", records[1]["name"])
+}
+
+func TestReadYAMLRecords(t *testing.T) {
+	in := `- id: abc123
+  tags: [bug, auth]
+  due_date: 2026-03-01
+  points: 3
+  description:
+`
+	records, err := readYAMLRecords(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "bug, auth", records[0]["tags"])
+	assert.Equal(t, "2026-03-01", records[0]["due_date"])
+	assert.Equal(t, "3", records[0]["points"])
+	assert.Equal(t, "", records[0]["description"])
+	assert.Contains(t, records[0], "description")
+}
+
+func TestRecordFormat(t *testing.T) {
+	tests := []struct {
+		format, path, want string
+		wantErr            bool
+	}{
+		{"", "tasks.csv", "csv", false},
+		{"", "tasks.YML", "yaml", false},
+		{"", "-", "csv", false},
+		{"yaml", "tasks.csv", "yaml", false},
+		{"json", "tasks.csv", "", true},
+	}
+	for _, tt := range tests {
+		got, err := recordFormat(tt.format, tt.path)
+		if tt.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +23,7 @@ import (
 
 type searchOptions struct {
 	factory         *cmdutil.Factory
+	expr            string
 	query           string
 	space           string
 	folder          string
@@ -31,6 +32,8 @@ type searchOptions struct {
 	comments        bool
 	exact           bool
 	includeSubtasks bool
+	filter          *cmdutil.TaskFilter
+	tableFlags      cmdutil.TableFlags
 	jsonFlags       cmdutil.JSONFlags
 }
 
@@ -41,16 +44,75 @@ type searchTask struct {
 	Description string `json:"description"`
 	Status      struct {
 		Status string `json:"status"`
+		Type   string `json:"type,omitempty"`
 	} `json:"status"`
 	Priority struct {
 		Priority string `json:"priority"`
 	} `json:"priority"`
 	Assignees []struct {
+		ID       int    `json:"id,omitempty"`
 		Username string `json:"username"`
 	} `json:"assignees"`
 	URL string `json:"url"`
+
+	// The fields below are only used by --columns, --sort and filters.
+	Tags         []clickup.Tag                  `json:"tags,omitempty"`
+	DueDate      *clickup.Date                  `json:"due_date,omitempty"`
+	StartDate    string                         `json:"start_date,omitempty"`
+	Points       *clickup.Point                 `json:"points,omitempty"`
+	TimeEstimate int64                          `json:"time_estimate,omitempty"`
+	TimeSpent    int64                          `json:"time_spent,omitempty"`
+	DateCreated  string                         `json:"date_created,omitempty"`
+	DateUpdated  string                         `json:"date_updated,omitempty"`
+	Parent       string                         `json:"parent,omitempty"`
+	List         *clickup.ListOfTaskBelonging   `json:"list,omitempty"`
+	Folder       *clickup.FolderOftaskBelonging `json:"folder,omitempty"`
+	Space        *clickup.SpaceOfTaskBelonging  `json:"space,omitempty"`
+	Archived     bool                           `json:"archived,omitempty"`
+	CustomFields []clickup.CustomField          `json:"custom_fields,omitempty"`
+}
+
+// toTask converts a search result for the shared task table.
+func (s searchTask) toTask() clickup.Task {
+	t := clickup.Task{
+		ID:           s.ID,
+		CustomID:     s.CustomID,
+		Name:         s.Name,
+		Status:       clickup.TaskStatus{Status: s.Status.Status, Type: s.Status.Type},
+		Priority:     clickup.TaskPriority{Priority: s.Priority.Priority},
+		URL:          s.URL,
+		Tags:         s.Tags,
+		DueDate:      s.DueDate,
+		StartDate:    s.StartDate,
+		TimeEstimate: s.TimeEstimate,
+		TimeSpent:    s.TimeSpent,
+		DateCreated:  s.DateCreated,
+		DateUpdated:  s.DateUpdated,
+		Parent:       s.Parent,
+		Archived:     s.Archived,
+		CustomFields: s.CustomFields,
+	}
+	for _, a := range s.Assignees {
+		t.Assignees = append(t.Assignees, clickup.User{ID: a.ID, Username: a.Username})
+	}
+	if s.Points != nil {
+		t.Points = *s.Points
+	}
+	if s.List != nil {
+		t.List = *s.List
+	}
+	if s.Folder != nil {
+		t.Folder = *s.Folder
+	}
+	if s.Space != nil {
+		t.Space = *s.Space
+	}
+	return t
 }
 
+// searchColumns are the columns 'task search' shows by default.
+var searchColumns = []string{"id", "name", "status", "assignee", "match"}
+
 type searchResponse struct {
 	Tasks []searchTask `json:"tasks"`
 }
@@ -63,6 +125,7 @@ const (
 	matchFuzzy                        // fuzzy match by name
 	matchDescription                  // matched via description substring
 	matchComment                      // matched via comment text
+	matchFilter                       // no search text; matched the filter alone
 )
 
 // scoredTask wraps a searchTask with match metadata for sorting.
@@ -137,6 +200,11 @@ Use --space and --folder to narrow the search scope for faster results.
 Use --comments to also search through task comments (slower).
 Use --assignee to filter by team member (name, username, ID, or "me").
 
+The query is a filter expression: its plain words are the search text,
+and the other terms filter the results, as for 'clickup task list'.
+
+` + cmdutil.TaskFilterHelp + `
+
 In interactive mode (TTY), if many results are found you will be asked
 whether to refine the search. Use --pick to interactively select a single
 task and print only its ID.
@@ -145,7 +213,10 @@ When no exact match is found, the search automatically tries individual
 words from the query and shows potentially related tasks.
 
 If search returns no results, use 'clickup task recent' to see your
-recently updated tasks and discover which folders/lists to search in.`,
+recently updated tasks and discover which folders/lists to search in.
+
+--columns and --sort work as for 'clickup task list', with an extra match
+column. --sort replaces the relevance order.`,
 		Example: `  # Search for tasks mentioning "payload"
   clickup task search payload
 
@@ -163,6 +234,15 @@ recently updated tasks and discover which folders/lists to search in.`,
   clickup task search "bug" --assignee "Isaac"
   clickup task search --assignee 54695018
 
+  # Search text combined with filters
+  clickup task search 'login status:"in review" assignee:me -tag:wontfix'
+
+  # Filters alone: my urgent tasks due this week
+  clickup task search 'assignee:me priority:urgent due<+7d'
+
+  # A filter starting with - goes after --
+  clickup task search -- -tag:wontfix assignee:me
+
   # Interactively pick a task (prints selected task ID)
   clickup task search geozone --pick
 
@@ -173,15 +253,24 @@ recently updated tasks and discover which folders/lists to search in.`,
   # Include subtasks in results
   clickup task search "Phase 1" --include-subtasks
 
+  # Show due dates and points, most urgent first
+  clickup task search "Phase 1" --columns id,name,due,points,match --sort -priority
+
   # JSON output
   clickup task search geozone --json`,
-		Args:              cobra.RangeArgs(0, 1),
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) > 0 {
-				opts.query = args[0]
+			opts.expr = strings.Join(args, " ")
+			filter, err := cmdutil.ParseTaskFilter(opts.expr)
+			if err != nil {
+				return &cmdutil.FlagError{Err: err}
+			}
+			if opts.assignee != "" {
+				filter.Add("assignee", opts.assignee)
 			}
-			if opts.query == "" && opts.assignee == "" {
+			opts.filter = filter
+			opts.query = filter.Text()
+			if opts.query == "" && !filter.HasFilters() {
 				return fmt.Errorf("query or --assignee is required")
 			}
 			return runSearch(opts)
@@ -195,6 +284,7 @@ recently updated tasks and discover which folders/lists to search in.`,
 	cmd.Flags().BoolVar(&opts.comments, "comments", false, "Also search through task comments (slower)")
 	cmd.Flags().BoolVar(&opts.exact, "exact", false, "Only show exact substring matches (no fuzzy results)")
 	cmd.Flags().BoolVar(&opts.includeSubtasks, "include-subtasks", false, "Include subtasks in search results")
+	cmdutil.AddTableFlags(cmd, &opts.tableFlags, "task-search", append(slices.Clone(cmdutil.TaskColumns), "match"))
 	cmdutil.AddJSONFlags(cmd, &opts.jsonFlags)
 
 	return cmd
@@ -292,7 +382,7 @@ func runSearch(opts *searchOptions) error {
 	}
 
 	if len(allTasks) == 0 {
-		fmt.Fprintf(ios.ErrOut, "No tasks found matching %q\n", opts.query)
+		fmt.Fprintf(ios.ErrOut, "No tasks found matching %q\n", opts.expr)
 		if interactive {
 			return noResultsPrompt(ios, opts)
 		}
@@ -333,49 +423,51 @@ func runSearch(opts *searchOptions) error {
 		return opts.jsonFlags.OutputJSON(ios.Out, allTasks)
 	}
 
-	tp := tableprinter.New(ios)
-	tp.AddField(cs.Bold("ID"))
-	tp.AddField(cs.Bold("NAME"))
-	tp.AddField(cs.Bold("STATUS"))
-	tp.AddField(cs.Bold("ASSIGNEE"))
-	tp.AddField(cs.Bold("MATCH"))
-	tp.EndRow()
-	tp.SetTruncateColumn(1)
+	cfg, err := opts.factory.Config()
+	if err != nil {
+		return err
+	}
+	tt := cmdutil.NewTaskTable(opts.factory, searchColumns, opts.tableFlags.CustomFields(cfg),
+		tableprinter.Column{Header: "MATCH", Color: func(s string) string {
+			switch s {
+			case "name":
+				return cs.Green(s)
+			case "fuzzy":
+				return cs.Yellow(s)
+			case "desc":
+				return cs.Blue(s)
+			default:
+				return cs.Cyan(s)
+			}
+		}},
+	)
 
 	for i, t := range allTasks {
-		id := t.ID
-		if t.CustomID != "" {
-			id = t.CustomID
-		}
-		tp.AddField(id)
-		tp.AddField(t.Name)
-
-		statusFn := cs.StatusColor(strings.ToLower(t.Status.Status))
-		tp.AddField(statusFn(t.Status.Status))
-
-		var names []string
-		for _, a := range t.Assignees {
-			names = append(names, a.Username)
-		}
-		tp.AddField(strings.Join(names, ", "))
-
 		// Show match type indicator.
+		var match string
 		switch matchKinds[i] {
 		case matchSubstring:
-			tp.AddField(cs.Green("name"))
+			match = "name"
 		case matchFuzzy:
-			tp.AddField(cs.Yellow("fuzzy"))
+			match = "fuzzy"
 		case matchDescription:
-			tp.AddField(cs.Blue("desc"))
+			match = "desc"
 		case matchComment:
-			tp.AddField(cs.Cyan("comment"))
+			match = "comment"
 		}
-		tp.EndRow()
+		tt.AddTask(t.toTask(), match)
 	}
 
-	if err := tp.Render(); err != nil {
+	tbl := tt.Table()
+	if err := opts.tableFlags.Apply(cfg, tbl); err != nil {
+		return err
+	}
+	if err := opts.factory.PrintTable(tbl); err != nil {
 		return err
 	}
+	if opts.factory.WantsFormat() {
+		return nil
+	}
 
 	// Quick actions footer
 	fmt.Fprintln(ios.Out)
@@ -384,7 +476,7 @@ func runSearch(opts *searchOptions) error {
 	fmt.Fprintf(ios.Out, "  %s  clickup task view <id>\n", cs.Gray("View:"))
 	fmt.Fprintf(ios.Out, "  %s  clickup task edit <id> --status <status>\n", cs.Gray("Edit:"))
 	fmt.Fprintf(ios.Out, "  %s  clickup sprint current\n", cs.Gray("Sprint:"))
-	fmt.Fprintf(ios.Out, "  %s  clickup task search %q --json\n", cs.Gray("JSON:"), opts.query)
+	fmt.Fprintf(ios.Out, "  %s  clickup task search %q --json\n", cs.Gray("JSON:"), opts.expr)
 
 	return nil
 }
@@ -406,7 +498,8 @@ func fetchTeamTasks(ctx context.Context, client *api.Client, teamID string, page
 }
 
 // searchLevel searches tasks at a given drill-down level and returns scored matches.
-func searchLevel(ctx context.Context, client *api.Client, teamID, query string, extraParams string, maxPages int, comments bool, ios *iostreams.IOStreams) ([]scoredTask, error) {
+// Tasks not matching filter are skipped.
+func searchLevel(ctx context.Context, client *api.Client, teamID, query string, extraParams string, maxPages int, comments bool, filter *cmdutil.CompiledFilter) ([]scoredTask, error) {
 	var allScored []scoredTask
 	for page := 0; page < maxPages; page++ {
 		if ctx.Err() != nil {
@@ -420,6 +513,7 @@ func searchLevel(ctx context.Context, client *api.Client, teamID, query string,
 		if len(tasks) == 0 {
 			break
 		}
+		tasks = slices.DeleteFunc(tasks, func(t searchTask) bool { return !filter.Match(t.toTask()) })
 
 		matched, unmatched := filterTasks(query, tasks)
 		allScored = append(allScored, matched...)
@@ -436,94 +530,45 @@ func searchLevel(ctx context.Context, client *api.Client, teamID, query string,
 	return allScored, nil
 }
 
-// resolveAssignee resolves a user input (name, username, numeric ID, or "me")
-// to a numeric user ID and display name. It uses the workspace members list.
-func resolveAssignee(ctx context.Context, client *api.Client, input string) (int, string, error) {
-	members, err := fetchWorkspaceMembers(ctx, client)
-	if err != nil {
-		return 0, "", err
-	}
-
-	var currentUserID int
-	if strings.EqualFold(input, "me") {
-		id, err := cmdutil.GetCurrentUserID(client)
-		if err != nil {
-			return 0, "", fmt.Errorf("failed to get current user: %w", err)
-		}
-		currentUserID = id
-	}
-
-	return resolveAssigneeFromMembers(members, input, currentUserID)
-}
+// doSearch searches for the query and keeps the tasks that match the rest
+// of the filter expression.
+func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
+	ios := opts.factory.IOStreams
 
-// fetchWorkspaceMembers returns the flattened list of members across all teams.
-func fetchWorkspaceMembers(ctx context.Context, client *api.Client) ([]clickup.TeamUser, error) {
-	teams, err := apiv2.GetTeamsLocal(ctx, client)
+	client, err := opts.factory.ApiClient()
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch workspace members: %w", err)
-	}
-	var members []clickup.TeamUser
-	for _, team := range teams {
-		for _, m := range team.Members {
-			members = append(members, m.User)
-		}
-	}
-	return members, nil
-}
-
-// resolveAssigneeFromMembers matches input (name, username, numeric ID, or
-// "me") against a pre-fetched member list. If input is "me", currentUserID
-// must be pre-resolved by the caller.
-func resolveAssigneeFromMembers(members []clickup.TeamUser, input string, currentUserID int) (int, string, error) {
-	if strings.EqualFold(input, "me") {
-		for _, m := range members {
-			if m.ID == currentUserID {
-				return currentUserID, m.Username, nil
-			}
-		}
-		return currentUserID, "me", nil
+		return nil, err
 	}
 
-	if id, err := strconv.Atoi(input); err == nil {
-		for _, m := range members {
-			if m.ID == id {
-				return id, m.Username, nil
+	// Compile the filter expression, resolving assignees to IDs.
+	filter, err := opts.filter.Compile(cmdutil.FilterOptions{
+		ResolveUser: func(input string) (int, error) {
+			id, name, err := cmdutil.ResolveAssignee(ctx, client, input)
+			if err == nil {
+				fmt.Fprintf(ios.ErrOut, "  assignee: %s (ID: %d)\n", name, id)
 			}
-		}
-		return 0, "", fmt.Errorf("no workspace member found with ID %d", id)
-	}
-
-	for _, m := range members {
-		if strings.EqualFold(m.Username, input) {
-			return m.ID, m.Username, nil
-		}
-	}
-
-	lowerInput := strings.ToLower(input)
-	var matches []clickup.TeamUser
-	for _, m := range members {
-		if strings.Contains(strings.ToLower(m.Username), lowerInput) {
-			matches = append(matches, m)
-		}
+			return id, err
+		},
+		Team:       true,
+		SearchText: true,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if len(matches) == 1 {
-		return matches[0].ID, matches[0].Username, nil
-	}
-	if len(matches) > 1 {
-		var names []string
-		for _, m := range matches {
-			names = append(names, fmt.Sprintf("%s (ID: %d)", m.Username, m.ID))
-		}
-		return 0, "", fmt.Errorf("ambiguous match, did you mean: %s", strings.Join(names, ", "))
+	scored, err := searchTasks(ctx, opts, filter)
+	if err != nil {
+		return nil, err
 	}
-
-	return 0, "", fmt.Errorf("no workspace member found matching %q", input)
+	return slices.DeleteFunc(scored, func(s scoredTask) bool {
+		return !filter.Match(s.toTask())
+	}), nil
 }
 
-// doSearch performs the actual search using progressive drill-down or
+// searchTasks performs the actual search using progressive drill-down or
 // the space/folder hierarchy (when --space or --folder is specified).
-func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
+// filter's parameters narrow the drill-down requests.
+func searchTasks(ctx context.Context, opts *searchOptions, filter *cmdutil.CompiledFilter) ([]scoredTask, error) {
 	ios := opts.factory.IOStreams
 
 	client, err := opts.factory.ApiClient()
@@ -541,32 +586,20 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 		return nil, fmt.Errorf("workspace ID required. Set with 'clickup auth login'")
 	}
 
-	// Resolve --assignee to a numeric ID if provided.
-	var assigneeParam string
-	var assigneeName string
-	if opts.assignee != "" {
-		assigneeID, name, err := resolveAssignee(ctx, client, opts.assignee)
-		if err != nil {
-			return nil, err
-		}
-		assigneeParam = fmt.Sprintf("assignees[]=%d", assigneeID)
-		assigneeName = name
-		fmt.Fprintf(ios.ErrOut, "  assignee: %s (ID: %d)\n", assigneeName, assigneeID)
-	}
-
 	// If --space or --folder is specified, go directly to targeted search.
 	if opts.space != "" || opts.folder != "" {
 		return searchViaSpaces(ctx, opts)
 	}
 
-	// Build extra params combining assignee filter and subtasks toggle if present.
+	// Build extra params combining the filter and subtasks toggle if present.
+	filterParams := filter.Params.Encode()
 	buildParams := func(base string) string {
 		parts := make([]string, 0, 3)
 		if base != "" {
 			parts = append(parts, base)
 		}
-		if assigneeParam != "" {
-			parts = append(parts, assigneeParam)
+		if filterParams != "" {
+			parts = append(parts, filterParams)
 		}
 		if opts.includeSubtasks {
 			parts = append(parts, "subtasks=true")
@@ -574,16 +607,26 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 		return strings.Join(parts, "&")
 	}
 
-	// If --assignee with no query: fetch all tasks for that assignee.
-	if opts.query == "" && assigneeParam != "" {
-		fmt.Fprintf(ios.ErrOut, "  fetching tasks for %s...\n", assigneeName)
-		tasks, err := fetchTeamTasks(ctx, client, teamID, 0, buildParams(""))
-		if err != nil {
-			return nil, err
+	// A filter with no query: fetch the matching tasks. Filters ClickUp
+	// cannot apply may need more than one page to find matches.
+	if opts.query == "" {
+		fmt.Fprintf(ios.ErrOut, "  fetching matching tasks...\n")
+		maxPages := 1
+		if filter.Local() {
+			maxPages = 10
 		}
 		var scored []scoredTask
-		for _, t := range tasks {
-			scored = append(scored, scoredTask{searchTask: t, kind: matchSubstring})
+		for page := 0; page < maxPages; page++ {
+			tasks, err := fetchTeamTasks(ctx, client, teamID, page, buildParams(""))
+			if err != nil {
+				return nil, err
+			}
+			if len(tasks) == 0 {
+				break
+			}
+			for _, t := range tasks {
+				scored = append(scored, scoredTask{searchTask: t, kind: matchFilter})
+			}
 		}
 		return scored, nil
 	}
@@ -594,7 +637,7 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 
 	// Level 0: Server-side search (fastest — single API call).
 	fmt.Fprintf(ios.ErrOut, "  searching (server-side)...\n")
-	scored, err := searchLevel(ctx, client, teamID, query, buildParams("search="+url.QueryEscape(opts.query)), 1, opts.comments, ios)
+	scored, err := searchLevel(ctx, client, teamID, query, buildParams("search="+url.QueryEscape(opts.query)), 1, opts.comments, filter)
 	if err == nil && len(scored) > 0 {
 		return scored, nil
 	}
@@ -604,7 +647,7 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 		fmt.Fprintf(ios.ErrOut, "  searching sprint...\n")
 		listID, err := cmdutil.ResolveCurrentSprintListID(ctx, client, cfg.SprintFolder)
 		if err == nil && listID != "" {
-			scored, err := searchLevel(ctx, client, teamID, query, buildParams("list_ids[]="+listID), 1, opts.comments, ios)
+			scored, err := searchLevel(ctx, client, teamID, query, buildParams("list_ids[]="+listID), 1, opts.comments, filter)
 			if err != nil {
 				return nil, err
 			}
@@ -618,7 +661,7 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 	fmt.Fprintf(ios.ErrOut, "  searching your tasks...\n")
 	userID, err := cmdutil.GetCurrentUserID(client)
 	if err == nil {
-		scored, err := searchLevel(ctx, client, teamID, query, buildParams(fmt.Sprintf("assignees[]=%d", userID)), 1, opts.comments, ios)
+		scored, err := searchLevel(ctx, client, teamID, query, buildParams(fmt.Sprintf("assignees[]=%d", userID)), 1, opts.comments, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -630,7 +673,7 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 	// Level 3: Configured space.
 	if cfg.Space != "" {
 		fmt.Fprintf(ios.ErrOut, "  searching space...\n")
-		scored, err := searchLevel(ctx, client, teamID, query, buildParams("space_ids[]="+cfg.Space), 3, opts.comments, ios)
+		scored, err := searchLevel(ctx, client, teamID, query, buildParams("space_ids[]="+cfg.Space), 3, opts.comments, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -641,7 +684,7 @@ func doSearch(ctx context.Context, opts *searchOptions) ([]scoredTask, error) {
 
 	// Level 4: Full workspace (up to 10 pages).
 	fmt.Fprintf(ios.ErrOut, "  searching workspace...\n")
-	scored, err = searchLevel(ctx, client, teamID, query, buildParams(""), 10, opts.comments, ios)
+	scored, err = searchLevel(ctx, client, teamID, query, buildParams(""), 10, opts.comments, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -783,7 +826,11 @@ func noResultsPrompt(ios *iostreams.IOStreams, opts *searchOptions) error {
 			return err
 		}
 		if newQuery != "" {
-			opts.query = newQuery
+			filter, err := cmdutil.ParseTaskFilter(newQuery)
+			if err != nil {
+				return err
+			}
+			opts.expr, opts.query, opts.filter = newQuery, filter.Text(), filter
 			return runSearch(opts)
 		}
 	}
@@ -839,27 +886,7 @@ func showRecentTasksInteractive(ios *iostreams.IOStreams, opts *searchOptions) e
 		return nil
 	}
 
-	// Display as table.
-	tp := tableprinter.New(ios)
-	tp.AddField(cs.Bold("ID"))
-	tp.AddField(cs.Bold("NAME"))
-	tp.AddField(cs.Bold("STATUS"))
-	tp.AddField(cs.Bold("FOLDER"))
-	tp.AddField(cs.Bold("LIST"))
-	tp.EndRow()
-	tp.SetTruncateColumn(1)
-
-	for _, t := range tasks {
-		tp.AddField(t.ID)
-		tp.AddField(t.Name)
-		statusFn := cs.StatusColor(strings.ToLower(t.Status))
-		tp.AddField(statusFn(t.Status))
-		tp.AddField(t.FolderName)
-		tp.AddField(t.ListName)
-		tp.EndRow()
-	}
-
-	return tp.Render()
+	return opts.factory.PrintTable(recentTaskTable(opts.factory, tasks))
 }
 
 func pickTask(ios *iostreams.IOStreams, allTasks []searchTask) error {
@@ -1042,7 +1069,7 @@ func searchViaSpaces(ctx context.Context, opts *searchOptions) ([]scoredTask, er
 			if query == "" {
 				var scored []scoredTask
 				for _, t := range taskResp.Tasks {
-					scored = append(scored, scoredTask{searchTask: t, kind: matchSubstring})
+					scored = append(scored, scoredTask{searchTask: t, kind: matchFilter})
 				}
 				results[idx] = listResult{scored: scored}
 				return
@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
 func TestNewCmdSearch_Flags(t *testing.T) {
@@ -220,7 +222,7 @@ func TestResolveAssignee_Me(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	id, name, err := resolveAssignee(t.Context(), client, "me")
+	id, name, err := cmdutil.ResolveAssignee(t.Context(), client, "me")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -236,7 +238,7 @@ func TestResolveAssignee_NumericID(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	id, name, err := resolveAssignee(t.Context(), client, "54695018")
+	id, name, err := cmdutil.ResolveAssignee(t.Context(), client, "54695018")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -252,7 +254,7 @@ func TestResolveAssignee_ExactUsername(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	id, name, err := resolveAssignee(t.Context(), client, "alice")
+	id, name, err := cmdutil.ResolveAssignee(t.Context(), client, "alice")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -268,7 +270,7 @@ func TestResolveAssignee_Substring(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	id, name, err := resolveAssignee(t.Context(), client, "Rown")
+	id, name, err := cmdutil.ResolveAssignee(t.Context(), client, "Rown")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -284,7 +286,7 @@ func TestResolveAssignee_Ambiguous(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	_, _, err := resolveAssignee(t.Context(), client, "Isaac")
+	_, _, err := cmdutil.ResolveAssignee(t.Context(), client, "Isaac")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "ambiguous match")
 	assert.Contains(t, err.Error(), "Isaac Smith")
@@ -299,7 +301,7 @@ func TestResolveAssignee_NotFound(t *testing.T) {
 	)
 
 	client, _ := tf.Factory.ApiClient()
-	_, _, err := resolveAssignee(t.Context(), client, "nonexistent")
+	_, _, err := cmdutil.ResolveAssignee(t.Context(), client, "nonexistent")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no workspace member found")
 }
@@ -363,3 +365,58 @@ func TestSearchIncludeSubtasks(t *testing.T) {
 	assert.True(t, strings.Contains(capturedURL, "subtasks=true"),
 		"expected subtasks=true in URL, got: %s", capturedURL)
 }
+
+func TestSearchFilterExpression(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	setupTeamAndUser(tf, 100, makeMember(100, "isaac"))
+
+	var capturedURL string
+	tf.HandleFunc("team/12345/task", func(w http.ResponseWriter, r *http.Request) {
+		capturedURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Write([]byte(`{"tasks":[
+			{"id":"a1","name":"Fix login","status":{"status":"in review"},"assignees":[{"id":100,"username":"isaac"}],"tags":[{"name":"backend"}]},
+			{"id":"a2","name":"Fix login again","status":{"status":"in review"},"assignees":[{"id":100,"username":"isaac"}],"tags":[{"name":"wontfix"}]}
+		]}`))
+	})
+
+	err := testutil.RunCommand(t, NewCmdSearch(tf.Factory), `login status:"in review" assignee:me -tag:wontfix`)
+	require.NoError(t, err)
+
+	assert.Contains(t, capturedURL, "search=login")
+	assert.Contains(t, capturedURL, "statuses%5B%5D=in+review")
+	assert.Contains(t, capturedURL, "assignees%5B%5D=100")
+	out := tf.OutBuf.String()
+	assert.Contains(t, out, "a1")
+	assert.NotContains(t, out, "a2")
+	assert.Contains(t, tf.ErrBuf.String(), "assignee: isaac (ID: 100)")
+}
+
+func TestSearchFilterOnly(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+
+	var capturedURL string
+	tf.HandleFunc("team/12345/task", func(w http.ResponseWriter, r *http.Request) {
+		capturedURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Write([]byte(`{"tasks":[{"id":"a1","name":"Deploy","status":{"status":"open"},"assignees":[],"tags":[{"name":"infra"}]}]}`))
+	})
+
+	err := testutil.RunCommand(t, NewCmdSearch(tf.Factory), "tag:infra")
+	require.NoError(t, err)
+	assert.Contains(t, capturedURL, "tags%5B%5D=infra")
+	assert.NotContains(t, capturedURL, "search=")
+	assert.Contains(t, tf.OutBuf.String(), "Deploy")
+
+	// With no search text there is no match to show.
+	tf.OutBuf.Reset()
+	tf.Factory.Format = "csv"
+	err = testutil.RunCommand(t, NewCmdSearch(tf.Factory), "tag:infra", "--columns", "id,match")
+	require.NoError(t, err)
+	assert.Equal(t, "ID,MATCH\na1,\n", tf.OutBuf.String())
+
+	err = testutil.RunCommand(t, NewCmdSearch(tf.Factory), "priority:soon")
+	assert.ErrorContains(t, err, "priority: expects")
+}
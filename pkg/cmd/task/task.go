@@ -27,6 +27,10 @@ func NewCmdTask(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(NewCmdListAdd(f))
 	cmd.AddCommand(NewCmdListRemove(f))
 	cmd.AddCommand(NewCmdMove(f))
+	cmd.AddCommand(NewCmdBrowse(f))
+	cmd.AddCommand(NewCmdExport(f))
+	cmd.AddCommand(NewCmdImport(f))
+	cmd.AddCommand(NewCmdApply(f))
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package task
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -196,6 +197,9 @@ func runTimeLog(f *cmdutil.Factory, opts *timeLogOptions) error {
 		return fmt.Errorf("request failed: %w", err)
 	}
 	entryID := logResult.Data.ID
+	if f.DryRun {
+		return nil
+	}
 
 	fmt.Fprintf(ios.Out, "%s Logged %s to task %s",
 		cs.Green("✓"),
@@ -336,6 +340,9 @@ func runBulkTimeLog(f *cmdutil.Factory, opts *timeLogOptions) error {
 		}
 
 		logged++
+		if f.DryRun {
+			continue
+		}
 		fmt.Fprintf(ios.Out, "%s (%d/%d) Logged %s to task %s",
 			cs.Green("✓"), i+1, total,
 			formatDuration(strconv.FormatInt(durationMs, 10)),
@@ -351,7 +358,9 @@ func runBulkTimeLog(f *cmdutil.Factory, opts *timeLogOptions) error {
 		fmt.Fprintln(ios.Out)
 	}
 
-	fmt.Fprintf(ios.Out, "\n%s Logged %d/%d entries\n", cs.Green("!"), logged, total)
+	if !f.DryRun {
+		fmt.Fprintf(ios.Out, "\n%s Logged %d/%d entries\n", cs.Green("!"), logged, total)
+	}
 	return nil
 }
 
@@ -774,8 +783,10 @@ func filterTimeEntriesByTags(client *api.Client, entries []timeEntry, tags []str
 			}
 			tasks, err := apiv2.GetTasksLocal(ctx, client, listID, q)
 			if err != nil {
-				// If we get a permission error for a list, skip it rather than failing.
-				if strings.Contains(err.Error(), "401") || strings.Contains(err.Error(), "ECODE") {
+				// Skip lists we can't read, or that ClickUp rejects with an
+				// ECODE, rather than failing.
+				var apiErr *api.APIError
+				if errors.Is(err, api.ErrPermission) || (errors.As(err, &apiErr) && apiErr.Code != "") {
 					break
 				}
 				return nil, fmt.Errorf("failed to get tasks for list %s: %w", listID, err)
@@ -805,24 +816,15 @@ func filterTimeEntriesByTags(client *api.Client, entries []timeEntry, tags []str
 func printTimesheetTable(f *cmdutil.Factory, entries []timeEntry, startDate, endDate string) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
-	tp := tableprinter.New(ios)
 
-	fmt.Fprintf(ios.Out, "%s  %s to %s\n\n",
-		cs.Bold("Timesheet"),
-		cs.Cyan(startDate),
-		cs.Cyan(endDate),
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "DATE"},
+		tableprinter.Column{Header: "TASK"},
+		tableprinter.Column{Header: "USER"},
+		tableprinter.Column{Header: "DURATION"},
+		tableprinter.Column{Header: "DESCRIPTION", Truncate: true},
 	)
 
-	// Header row.
-	tp.AddField(cs.Bold("DATE"))
-	tp.AddField(cs.Bold("TASK"))
-	tp.AddField(cs.Bold("USER"))
-	tp.AddField(cs.Bold("DURATION"))
-	tp.AddField(cs.Bold("DESCRIPTION"))
-	tp.EndRow()
-
-	tp.SetTruncateColumn(4) // Truncate description column if table is too wide.
-
 	var totalMs int64
 	for _, e := range entries {
 		// Convert start ms to date.
@@ -830,26 +832,30 @@ func printTimesheetTable(f *cmdutil.Factory, entries []timeEntry, startDate, end
 		if t, err := parseUnixMillis(e.Start); err == nil {
 			dateStr = t.Format("2006-01-02")
 		}
-		tp.AddField(dateStr)
 
 		taskName := ""
 		if e.Task != nil {
 			taskName = e.Task.Name
 		}
-		tp.AddField(taskName)
-
-		tp.AddField(e.User.Username)
-		tp.AddField(formatDuration(e.Duration))
-		tp.AddField(e.Description)
 
-		tp.EndRow()
+		tbl.AddRow(dateStr, taskName, e.User.Username, formatDuration(e.Duration), e.Description)
 
 		if ms, err := strconv.ParseInt(e.Duration, 10, 64); err == nil {
 			totalMs += ms
 		}
 	}
 
-	if err := tp.Render(); err != nil {
+	if f.WantsFormat() {
+		return f.PrintTable(tbl)
+	}
+
+	fmt.Fprintf(ios.Out, "%s  %s to %s\n\n",
+		cs.Bold("Timesheet"),
+		cs.Cyan(startDate),
+		cs.Cyan(endDate),
+	)
+
+	if err := f.PrintTable(tbl); err != nil {
 		return err
 	}
 
@@ -874,45 +880,37 @@ func printTimesheetTable(f *cmdutil.Factory, entries []timeEntry, startDate, end
 func printTimeEntryTable(f *cmdutil.Factory, entries []timeEntry, taskID string) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
-	tp := tableprinter.New(ios)
 
-	// Header row.
-	tp.AddField(cs.Bold("ID"))
-	tp.AddField(cs.Bold("DATE"))
-	tp.AddField(cs.Bold("USER"))
-	tp.AddField(cs.Bold("DURATION"))
-	tp.AddField(cs.Bold("DESCRIPTION"))
-	tp.AddField(cs.Bold("BILLABLE"))
-	tp.EndRow()
-
-	tp.SetTruncateColumn(4) // Truncate description column if table is too wide.
+	tbl := tableprinter.NewTable(
+		tableprinter.Column{Header: "ID"},
+		tableprinter.Column{Header: "DATE"},
+		tableprinter.Column{Header: "USER"},
+		tableprinter.Column{Header: "DURATION"},
+		tableprinter.Column{Header: "DESCRIPTION", Truncate: true},
+		tableprinter.Column{Header: "BILLABLE"},
+	)
 
 	for _, e := range entries {
-		tp.AddField(e.ID)
-
 		// Convert start ms to date.
 		dateStr := e.Start
 		if t, err := parseUnixMillis(e.Start); err == nil {
 			dateStr = t.Format("2006-01-02")
 		}
-		tp.AddField(dateStr)
-
-		tp.AddField(e.User.Username)
-		tp.AddField(formatDuration(e.Duration))
-		tp.AddField(e.Description)
 
 		billableStr := "No"
 		if e.Billable {
 			billableStr = "Yes"
 		}
-		tp.AddField(billableStr)
 
-		tp.EndRow()
+		tbl.AddRow(e.ID, dateStr, e.User.Username, formatDuration(e.Duration), e.Description, billableStr)
 	}
 
-	if err := tp.Render(); err != nil {
+	if err := f.PrintTable(tbl); err != nil {
 		return err
 	}
+	if f.WantsFormat() {
+		return nil
+	}
 
 	// Quick actions footer
 	fmt.Fprintln(ios.Out)
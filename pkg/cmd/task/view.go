@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 	"sync"
@@ -13,8 +14,10 @@ import (
 	"github.com/triptechtravel/clickup-cli/internal/api"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
 	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/config"
 	"github.com/triptechtravel/clickup-cli/internal/git"
 	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/text"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
@@ -151,25 +154,12 @@ func runView(f *cmdutil.Factory, opts *viewOptions) error {
 		return err
 	}
 
-	qs := cmdutil.CustomIDTaskQueryWithSubtasks(cfg, isCustomID)
-
 	ctx := context.Background()
-	task, err := apiv2.GetTaskLocal(ctx, client, taskID, qs)
+	task, subtasks, err := fetchTaskWithExtras(ctx, client, cfg, taskID, isCustomID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch task %s: %w", taskID, err)
-	}
-
-	// Fetch markdown description and subtasks (the standard GetTask doesn't include these).
-	var extras taskWithExtras
-	mdqs := cmdutil.CustomIDTaskQueryMD(cfg, isCustomID)
-	extrasPath := fmt.Sprintf("task/%s/%s", task.ID, mdqs)
-	if err := apiv2.Do(ctx, client, "GET", extrasPath, nil, &extras); err == nil {
-		if extras.MarkdownDescription != "" {
-			task.MarkdownDescription = extras.MarkdownDescription
-		}
+		return err
 	}
 
-	subtasks := extras.Subtasks
 	if opts.recursive && len(subtasks) > 0 {
 		fetchSubtasksRecursive(ctx, client, subtasks, ios)
 	}
@@ -274,11 +264,54 @@ func runViewBulk(f *cmdutil.Factory, opts *viewOptions, rawIDs []string) error {
 	return opts.jsonFlags.OutputJSON(ios.Out, output)
 }
 
+// fetchTaskWithExtras fetches a task along with its markdown description
+// and subtasks, which the standard GetTask doesn't include.
+func fetchTaskWithExtras(ctx context.Context, client *api.Client, cfg *config.Config, taskID string, isCustomID bool) (*clickup.Task, []subtaskInfo, error) {
+	qs := cmdutil.CustomIDTaskQueryWithSubtasks(cfg, isCustomID)
+	task, err := apiv2.GetTaskLocal(ctx, client, taskID, qs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch task %s: %w", taskID, err)
+	}
+
+	var extras taskWithExtras
+	mdqs := cmdutil.CustomIDTaskQueryMD(cfg, isCustomID)
+	extrasPath := fmt.Sprintf("task/%s/%s", task.ID, mdqs)
+	if err := apiv2.Do(ctx, client, "GET", extrasPath, nil, &extras); err == nil {
+		if extras.MarkdownDescription != "" {
+			task.MarkdownDescription = extras.MarkdownDescription
+		}
+	}
+	return task, extras.Subtasks, nil
+}
+
 func printTaskView(f *cmdutil.Factory, task *clickup.Task, subtasks []subtaskInfo) error {
 	ios := f.IOStreams
 	cs := ios.ColorScheme()
 	out := ios.Out
 
+	writeTaskView(out, cs, task, subtasks)
+
+	id := task.ID
+	if task.CustomID != "" {
+		id = task.CustomID
+	}
+
+	// Quick actions footer
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, cs.Gray("---"))
+	fmt.Fprintln(out, cs.Gray("Quick actions:"))
+	fmt.Fprintf(out, "  %s  clickup task edit %s --status <status>\n", cs.Gray("Edit:"), id)
+	fmt.Fprintf(out, "  %s  clickup status set <status> %s\n", cs.Gray("Status:"), id)
+	fmt.Fprintf(out, "  %s  clickup comment add %s \"@user text\" (supports @mentions)\n", cs.Gray("Comment:"), id)
+	fmt.Fprintf(out, "  %s  clickup link pr --task %s\n", cs.Gray("Link PR:"), id)
+	fmt.Fprintf(out, "  %s  clickup task view %s --json\n", cs.Gray("JSON:"), id)
+
+	return nil
+}
+
+// writeTaskView writes the body of the task view, everything but the quick
+// actions footer, to out. The task browser renders it into its detail pane.
+func writeTaskView(out io.Writer, cs *iostreams.ColorScheme, task *clickup.Task, subtasks []subtaskInfo) {
 	// Title with type badge
 	id := task.ID
 	if task.CustomID != "" {
@@ -485,7 +518,7 @@ func printTaskView(f *cmdutil.Factory, task *clickup.Task, subtasks []subtaskInf
 	if len(task.CustomFields) > 0 {
 		var hasValues bool
 		for _, cf := range task.CustomFields {
-			if v := formatCustomFieldValue(cf); v != "" {
+			if v := cmdutil.FormatCustomFieldValue(cf); v != "" {
 				hasValues = true
 				break
 			}
@@ -493,7 +526,7 @@ func printTaskView(f *cmdutil.Factory, task *clickup.Task, subtasks []subtaskInf
 		if hasValues {
 			fmt.Fprintf(out, "\n%s\n", cs.Bold("Custom Fields:"))
 			for _, cf := range task.CustomFields {
-				if v := formatCustomFieldValue(cf); v != "" {
+				if v := cmdutil.FormatCustomFieldValue(cf); v != "" {
 					fmt.Fprintf(out, "  %s: %s\n", cf.Name, v)
 				}
 			}
@@ -509,18 +542,6 @@ func printTaskView(f *cmdutil.Factory, task *clickup.Task, subtasks []subtaskInf
 		fmt.Fprintf(out, "\n%s\n", cs.Bold("Description:"))
 		fmt.Fprintf(out, "%s\n", text.IndentLines(desc, "  "))
 	}
-
-	// Quick actions footer
-	fmt.Fprintln(out)
-	fmt.Fprintln(out, cs.Gray("---"))
-	fmt.Fprintln(out, cs.Gray("Quick actions:"))
-	fmt.Fprintf(out, "  %s  clickup task edit %s --status <status>\n", cs.Gray("Edit:"), id)
-	fmt.Fprintf(out, "  %s  clickup status set <status> %s\n", cs.Gray("Status:"), id)
-	fmt.Fprintf(out, "  %s  clickup comment add %s \"@user text\" (supports @mentions)\n", cs.Gray("Comment:"), id)
-	fmt.Fprintf(out, "  %s  clickup link pr --task %s\n", cs.Gray("Link PR:"), id)
-	fmt.Fprintf(out, "  %s  clickup task view %s --json\n", cs.Gray("JSON:"), id)
-
-	return nil
 }
 
 // fetchSubtasksRecursive walks a slice of subtasks, fetching each one's children
@@ -623,21 +644,20 @@ func findTaskViaPR(f *cmdutil.Factory, ios *iostreams.IOStreams) (string, bool,
 			break
 		}
 		fmt.Fprintf(ios.ErrOut, "  searching %s for PR #%d...\n", lvl.label, prNum)
-		for page := 0; page < lvl.maxPages; page++ {
-			tasks, err := apiv2.FetchTeamTasks(ctx, client, teamID, page, lvl.extraParams)
-			if err != nil || len(tasks) == 0 {
+		tasks := pager.Pages(ctx, pager.Options{MaxPages: lvl.maxPages},
+			apiv2.TeamTaskPages(client, teamID, lvl.extraParams))
+		for t, err := range tasks {
+			if err != nil {
 				break
 			}
-			for _, t := range tasks {
-				if strings.Contains(t.Description, prURL) {
-					id := t.ID
-					isCustom := false
-					if t.CustomID != "" {
-						id = t.CustomID
-						isCustom = true
-					}
-					return id, isCustom, prNum
+			if strings.Contains(t.Description, prURL) {
+				id := t.ID
+				isCustom := false
+				if t.CustomID != "" {
+					id = t.CustomID
+					isCustom = true
 				}
+				return id, isCustom, prNum
 			}
 		}
 	}
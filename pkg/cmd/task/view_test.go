@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
 var sampleTaskJSON = `{
@@ -83,6 +84,8 @@ func TestViewCommand_NotFound(t *testing.T) {
 	err := testutil.RunCommand(t, cmd, "nonexistent")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to fetch task")
+	assert.Contains(t, err.Error(), "Task not found (ITEM_015)")
+	assert.Equal(t, cmdutil.ExitNotFound, cmdutil.ExitCode(err))
 }
 
 func TestViewCommand_WithSubtasks(t *testing.T) {
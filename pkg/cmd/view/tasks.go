@@ -2,34 +2,56 @@ package view
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
 	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
 	"github.com/triptechtravel/clickup-cli/pkg/cmdutil"
 )
 
+// viewColumns are the columns 'view tasks' shows by default.
+var viewColumns = []string{"id", "name", "status", "assignee", "due"}
+
 // NewCmdViewTasks returns the view tasks command.
 func NewCmdViewTasks(f *cmdutil.Factory) *cobra.Command {
 	var (
-		page      int
-		jsonFlags cmdutil.JSONFlags
+		page       int
+		pageFlags  cmdutil.PageFlags
+		tableFlags cmdutil.TableFlags
+		jsonFlags  cmdutil.JSONFlags
 	)
 
 	cmd := &cobra.Command{
 		Use:   "tasks <view-id>",
 		Short: "List tasks in a view",
-		Long:  "List all tasks visible in a ClickUp view.",
+		Long: `List all tasks visible in a ClickUp view.
+
+--columns and --sort work as for 'clickup task list'. Sorting applies to
+the tasks fetched.
+
+A single page is fetched by default; --limit fetches pages until that many
+tasks are found and --all fetches every page. Tasks are kept as returned
+by the API, so --json shows every field. With --format ndjson and no
+--sort, rows are written as each page arrives.`,
 		Example: `  # List tasks in a view
   clickup view tasks 3v-abc123
 
   # Page through results
   clickup view tasks 3v-abc123 --page 1
 
+  # Fetch every page
+  clickup view tasks 3v-abc123 --all
+
+  # Choose columns and sort by due date
+  clickup view tasks 3v-abc123 --columns id,name,due,field:Team --sort due
+
   # Output as JSON
   clickup view tasks 3v-abc123 --json`,
-		Args:    cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
 		PersistentPreRunE: cmdutil.NeedsAuth(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viewID := args[0]
@@ -38,55 +60,108 @@ func NewCmdViewTasks(f *cmdutil.Factory) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+
+			// TODO: swap to generated wrapper — GetViewTasks decodes tasks
+			// into pointer fields without custom fields, which the columns
+			// below need.
+			fetch := apiv2.TaskPages[json.RawMessage](client, fmt.Sprintf("view/%s/task", viewID))
+			more := false
+			if !pageFlags.Paging() {
+				// Note whether the single page fetched was the last, for
+				// the hint below.
+				next := fetch
+				fetch = func(ctx context.Context, page int) ([]json.RawMessage, bool, error) {
+					items, last, err := next(ctx, page)
+					more = err == nil && !last
+					return items, last, err
+				}
+			}
+			pageOpts := pageFlags.Options()
+			pageOpts.Start = page
+			seq := pager.Pages(context.Background(), pageOpts, fetch)
+
+			fields := tableFlags.CustomFields(cfg)
+			if !jsonFlags.WantsJSON() && f.Streams(tableFlags.Sort != "") {
+				n, err := cmdutil.StreamTable(f, seq, func(raw json.RawMessage) (*tableprinter.Table, error) {
+					tt := cmdutil.NewTaskTable(f, viewColumns, fields)
+					if err := addViewTask(tt, raw); err != nil {
+						return nil, err
+					}
+					tbl := tt.Table()
+					return tbl, tableFlags.Apply(cfg, tbl)
+				})
+				if err != nil {
+					return fmt.Errorf("failed to fetch view tasks: %w", err)
+				}
+				if n == 0 {
+					fmt.Fprintln(f.IOStreams.ErrOut, "No tasks found.")
+				}
+				return nil
+			}
 
-			resp, err := apiv2.GetViewTasks(context.Background(), client, viewID, apiv2.GetViewTasksParams{
-				Page: page,
-			})
+			raws, err := pager.Collect(seq)
 			if err != nil {
 				return fmt.Errorf("failed to fetch view tasks: %w", err)
 			}
 
 			if jsonFlags.WantsJSON() {
-				return jsonFlags.OutputJSON(f.IOStreams.Out, resp.Tasks)
+				// Decode the tasks so --template sees their fields.
+				tasks := make([]interface{}, len(raws))
+				for i, raw := range raws {
+					if err := json.Unmarshal(raw, &tasks[i]); err != nil {
+						return fmt.Errorf("failed to parse view task: %w", err)
+					}
+				}
+				return jsonFlags.OutputJSON(f.IOStreams.Out, tasks)
 			}
 
-			if len(resp.Tasks) == 0 {
+			if len(raws) == 0 {
 				fmt.Fprintln(f.IOStreams.Out, "No tasks found.")
 				return nil
 			}
 
-			tp := tableprinter.New(f.IOStreams)
-
-			for _, t := range resp.Tasks {
-				id := ""
-				if t.ID != nil {
-					id = *t.ID
-				}
-				name := ""
-				if t.Name != nil {
-					name = *t.Name
+			tt := cmdutil.NewTaskTable(f, viewColumns, fields)
+			for _, raw := range raws {
+				if err := addViewTask(tt, raw); err != nil {
+					return err
 				}
-				tp.AddField(id)
-				tp.AddField(name)
-				tp.EndRow()
 			}
-
-			if err := tp.Render(); err != nil {
+			tbl := tt.Table()
+			if err := tableFlags.Apply(cfg, tbl); err != nil {
+				return err
+			}
+			if err := f.PrintTable(tbl); err != nil {
 				return err
 			}
 
-			if !resp.LastPage {
+			if more && !f.WantsFormat() {
 				cs := f.IOStreams.ColorScheme()
 				fmt.Fprintln(f.IOStreams.Out)
-				fmt.Fprintf(f.IOStreams.Out, "%s More results available. Use --page %d\n", cs.Gray("..."), page+1)
+				fmt.Fprintf(f.IOStreams.Out, "%s More results available. Use --page %d or --all\n", cs.Gray("..."), page+1)
 			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().IntVar(&page, "page", 0, "Page number (0-indexed)")
+	cmd.Flags().IntVar(&page, "page", 0, "Page number to start from (0-indexed)")
+	cmdutil.AddPageFlags(cmd, &pageFlags, "tasks")
+	cmdutil.AddTableFlags(cmd, &tableFlags, "view-tasks", cmdutil.TaskColumns)
 	cmdutil.AddJSONFlags(cmd, &jsonFlags)
 
 	return cmd
 }
+
+// addViewTask adds a task, kept as returned by the API, to tt.
+func addViewTask(tt *cmdutil.TaskTable, raw json.RawMessage) error {
+	var t clickup.Task
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return fmt.Errorf("failed to parse view task: %w", err)
+	}
+	tt.AddTask(t)
+	return nil
+}
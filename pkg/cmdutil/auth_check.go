@@ -1,8 +1,11 @@
 package cmdutil
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/triptechtravel/clickup-cli/internal/auth"
+	"github.com/triptechtravel/clickup-cli/internal/config"
 )
 
 // NeedsAuth returns a pre-run function that validates authentication before command execution.
@@ -12,8 +15,15 @@ func NeedsAuth(f *Factory) func(cmd *cobra.Command, args []string) error {
 		if f.apiClientOverride != nil {
 			return nil
 		}
-		token, err := auth.GetToken()
+		cfg, err := f.Config()
+		if err != nil {
+			return err
+		}
+		token, err := auth.GetProfileToken(cfg.Profile())
 		if err != nil || token == "" {
+			if profile := cfg.Profile(); profile != config.DefaultProfile {
+				return &AuthError{Message: fmt.Sprintf("authentication required for profile %q. Run 'clickup auth login --profile %s' to authenticate", profile, profile)}
+			}
 			return &AuthError{}
 		}
 		return nil
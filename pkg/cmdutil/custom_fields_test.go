@@ -0,0 +1,190 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+// ---------------------------------------------------------------------------
+// FormatCustomFieldValue
+// ---------------------------------------------------------------------------
+
+func TestFormatCustomFieldValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		field clickup.CustomField
+		want  string
+	}{
+		{
+			name:  "nil value",
+			field: clickup.CustomField{Type: "text", Value: nil},
+			want:  "",
+		},
+		{
+			name:  "text string",
+			field: clickup.CustomField{Type: "text", Value: "hello"},
+			want:  "hello",
+		},
+		{
+			name:  "url string",
+			field: clickup.CustomField{Type: "url", Value: "https://example.com"},
+			want:  "https://example.com",
+		},
+		{
+			name:  "number integer-like",
+			field: clickup.CustomField{Type: "number", Value: float64(42)},
+			want:  "42",
+		},
+		{
+			name:  "number with decimal",
+			field: clickup.CustomField{Type: "number", Value: float64(3.14)},
+			want:  "3.14",
+		},
+		{
+			name: "currency USD",
+			field: clickup.CustomField{
+				Type:       "currency",
+				Value:      float64(19.99),
+				TypeConfig: map[string]interface{}{"currency_type": "USD"},
+			},
+			want: "$19.99",
+		},
+		{
+			name: "currency EUR",
+			field: clickup.CustomField{
+				Type:       "currency",
+				Value:      float64(10),
+				TypeConfig: map[string]interface{}{"currency_type": "EUR"},
+			},
+			want: "EUR 10.00",
+		},
+		{
+			name:  "checkbox true",
+			field: clickup.CustomField{Type: "checkbox", Value: true},
+			want:  "Yes",
+		},
+		{
+			name:  "checkbox false",
+			field: clickup.CustomField{Type: "checkbox", Value: false},
+			want:  "No",
+		},
+		{
+			name:  "checkbox string true",
+			field: clickup.CustomField{Type: "checkbox", Value: "true"},
+			want:  "Yes",
+		},
+		{
+			name:  "emoji float",
+			field: clickup.CustomField{Type: "emoji", Value: float64(5)},
+			want:  "5",
+		},
+		{
+			name: "date float64 millis",
+			field: clickup.CustomField{
+				Type:  "date",
+				Value: float64(1718409600000), // 2024-06-15 UTC
+			},
+			want: "2024-06-15",
+		},
+		{
+			name:  "date string millis",
+			field: clickup.CustomField{Type: "date", Value: "1718409600000"},
+			want:  "2024-06-15",
+		},
+		{
+			name: "location with address",
+			field: clickup.CustomField{
+				Type: "location",
+				Value: map[string]interface{}{
+					"location": map[string]interface{}{
+						"formatted_address": "Auckland",
+					},
+				},
+			},
+			want: "Auckland",
+		},
+		{
+			name:  "formula float",
+			field: clickup.CustomField{Type: "formula", Value: float64(99)},
+			want:  "99",
+		},
+		{
+			name:  "formula string",
+			field: clickup.CustomField{Type: "formula", Value: "calculated"},
+			want:  "calculated",
+		},
+		{
+			name:  "manual_progress float",
+			field: clickup.CustomField{Type: "manual_progress", Value: float64(75)},
+			want:  "75%",
+		},
+		{
+			name: "manual_progress map with percent",
+			field: clickup.CustomField{
+				Type:  "manual_progress",
+				Value: map[string]interface{}{"percent_completed": float64(50)},
+			},
+			want: "50%",
+		},
+		{
+			name:  "unknown type with string fallback",
+			field: clickup.CustomField{Type: "some_new_type", Value: "raw"},
+			want:  "raw",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatCustomFieldValue(tt.field)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// formatDateFieldValue
+// ---------------------------------------------------------------------------
+
+func TestFormatDateFieldValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"float64 millis", float64(1718409600000), "2024-06-15"},
+		{"string millis", "1718409600000", "2024-06-15"},
+		{"invalid string", "not-a-number", "not-a-number"},
+		{"unsupported type", 42, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatDateFieldValue(tt.value)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// currencyPrefix
+// ---------------------------------------------------------------------------
+
+func TestCurrencyPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		typeConfig interface{}
+		want       string
+	}{
+		{"USD", map[string]interface{}{"currency_type": "USD"}, "$"},
+		{"EUR", map[string]interface{}{"currency_type": "EUR"}, "EUR "},
+		{"NZD", map[string]interface{}{"currency_type": "NZD"}, "NZ$"},
+		{"AUD", map[string]interface{}{"currency_type": "AUD"}, "A$"},
+		{"unknown currency", map[string]interface{}{"currency_type": "JPY"}, "JPY "},
+		{"nil TypeConfig", nil, "$"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, currencyPrefix(tt.typeConfig))
+		})
+	}
+}
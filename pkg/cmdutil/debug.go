@@ -0,0 +1,42 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// debugOutput returns where HTTP traces are written, or nil when tracing is
+// off. Tracing is enabled by --debug or CLICKUP_DEBUG; CLICKUP_DEBUG_FILE
+// redirects it from stderr to a file and CLICKUP_DEBUG_BODIES adds
+// redacted request and response bodies.
+func (f *Factory) debugOutput() (io.Writer, bool, error) {
+	if !f.Debug && !envFlag("CLICKUP_DEBUG") {
+		return nil, false, nil
+	}
+	f.debugOnce.Do(func() {
+		path := os.Getenv("CLICKUP_DEBUG_FILE")
+		if path == "" {
+			f.debugOut = f.IOStreams.ErrOut
+			return
+		}
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			f.debugErr = fmt.Errorf("failed to open CLICKUP_DEBUG_FILE: %w", err)
+			return
+		}
+		f.debugOut = file
+	})
+	return f.debugOut, envFlag("CLICKUP_DEBUG_BODIES"), f.debugErr
+}
+
+// envFlag reports whether the boolean environment variable name is set to
+// anything other than "", "0" or "false".
+func envFlag(name string) bool {
+	switch strings.ToLower(os.Getenv(name)) {
+	case "", "0", "false":
+		return false
+	}
+	return true
+}
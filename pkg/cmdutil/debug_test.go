@@ -0,0 +1,53 @@
+package cmdutil
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+)
+
+func TestDebugOutput_Off(t *testing.T) {
+	t.Setenv("CLICKUP_DEBUG", "0")
+	f := NewFactory(&iostreams.IOStreams{ErrOut: &bytes.Buffer{}})
+
+	w, _, err := f.debugOutput()
+	require.NoError(t, err)
+	assert.Nil(t, w)
+}
+
+func TestDebugOutput_FlagUsesStderr(t *testing.T) {
+	t.Setenv("CLICKUP_DEBUG", "")
+	t.Setenv("CLICKUP_DEBUG_BODIES", "1")
+	errOut := &bytes.Buffer{}
+	f := NewFactory(&iostreams.IOStreams{ErrOut: errOut})
+	f.Debug = true
+
+	w, bodies, err := f.debugOutput()
+	require.NoError(t, err)
+	assert.Same(t, errOut, w)
+	assert.True(t, bodies)
+}
+
+func TestDebugOutput_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.log")
+	t.Setenv("CLICKUP_DEBUG", "true")
+	t.Setenv("CLICKUP_DEBUG_FILE", path)
+	f := NewFactory(&iostreams.IOStreams{ErrOut: &bytes.Buffer{}})
+
+	w, bodies, err := f.debugOutput()
+	require.NoError(t, err)
+	assert.False(t, bodies)
+
+	_, err = w.Write([]byte("> GET x\n"))
+	require.NoError(t, err)
+	w.(*os.File).Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "> GET x\n", string(data))
+}
@@ -38,3 +38,16 @@ func IsAuthError(err error) bool {
 	var ae *AuthError
 	return errors.As(err, &ae)
 }
+
+// FlagError indicates invalid command-line flags or arguments.
+type FlagError struct {
+	Err error
+}
+
+func (e *FlagError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FlagError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,80 @@
+package cmdutil
+
+import (
+	"errors"
+	"net"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+)
+
+// Exit codes of the clickup command. Scripts branch on these, so the
+// values must not change.
+const (
+	ExitOK          = 0
+	ExitError       = 1  // any error not covered below
+	ExitUsage       = 2  // invalid flags or arguments
+	ExitAuth        = 4  // not logged in, or the token expired or was revoked
+	ExitNotFound    = 5  // the task, list, doc or other resource does not exist
+	ExitPermission  = 6  // the token may not access the resource
+	ExitRateLimited = 7  // rate limited, even after retrying
+	ExitValidation  = 8  // ClickUp rejected the request's input
+	ExitNetwork     = 9  // ClickUp could not be reached, or timed out
+	ExitServer      = 10 // ClickUp returned a 5xx error
+)
+
+// ErrorInfo describes an error for scripts. It is written to stderr as
+// JSON when a command that was asked for --json fails.
+type ErrorInfo struct {
+	Kind     string `json:"kind"`
+	Message  string `json:"message"`
+	ExitCode int    `json:"exit_code"`
+	// Status and Code are the HTTP status and ClickUp ECODE of API errors.
+	Status int    `json:"status,omitempty"`
+	Code   string `json:"code,omitempty"`
+}
+
+// DescribeError classifies err and returns its exit code and kind.
+func DescribeError(err error) ErrorInfo {
+	info := ErrorInfo{Kind: "error", ExitCode: ExitError}
+	if err == nil {
+		return ErrorInfo{Kind: "ok", ExitCode: ExitOK}
+	}
+	info.Message = err.Error()
+
+	var (
+		apiErr      *api.APIError
+		authExpired *api.AuthExpiredError
+		authErr     *AuthError
+		flagErr     *FlagError
+		netErr      net.Error
+	)
+	switch {
+	case errors.As(err, &authExpired), errors.As(err, &authErr):
+		info.Kind, info.ExitCode = "auth", ExitAuth
+	case errors.As(err, &flagErr):
+		info.Kind, info.ExitCode = "usage", ExitUsage
+	case errors.As(err, &apiErr):
+		info.Status, info.Code = apiErr.StatusCode, apiErr.Code
+		info.Kind = "api"
+		switch apiErr.Kind() {
+		case api.ErrNotFound:
+			info.Kind, info.ExitCode = "not_found", ExitNotFound
+		case api.ErrPermission:
+			info.Kind, info.ExitCode = "permission", ExitPermission
+		case api.ErrRateLimited:
+			info.Kind, info.ExitCode = "rate_limited", ExitRateLimited
+		case api.ErrValidation:
+			info.Kind, info.ExitCode = "validation", ExitValidation
+		case api.ErrServer:
+			info.Kind, info.ExitCode = "server", ExitServer
+		}
+	case errors.As(err, &netErr):
+		info.Kind, info.ExitCode = "network", ExitNetwork
+	}
+	return info
+}
+
+// ExitCode returns the exit code for err; see the Exit constants.
+func ExitCode(err error) int {
+	return DescribeError(err).ExitCode
+}
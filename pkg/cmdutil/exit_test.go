@@ -0,0 +1,50 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/triptechtravel/clickup-cli/internal/api"
+)
+
+func TestDescribeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantKind string
+		wantCode int
+	}{
+		{"nil", nil, "ok", ExitOK},
+		{"plain", errors.New("boom"), "error", ExitError},
+		{"flag", &FlagError{Err: errors.New("unknown flag: --nope")}, "usage", ExitUsage},
+		{"not logged in", &AuthError{}, "auth", ExitAuth},
+		{"token expired", fmt.Errorf("x: %w", &api.AuthExpiredError{}), "auth", ExitAuth},
+		{"not found", fmt.Errorf("x: %w", &api.APIError{StatusCode: 404}), "not_found", ExitNotFound},
+		{"permission", &api.APIError{StatusCode: 401, Code: "OAUTH_027"}, "permission", ExitPermission},
+		{"rate limited", &api.APIError{StatusCode: 429}, "rate_limited", ExitRateLimited},
+		{"validation", &api.APIError{StatusCode: 400, Code: "INPUT_005"}, "validation", ExitValidation},
+		{"server", &api.APIError{StatusCode: 503}, "server", ExitServer},
+		{"other API error", &api.APIError{StatusCode: 409}, "api", ExitError},
+		{"network", fmt.Errorf("x: %w", &url.Error{Op: "Get", URL: "https://api.clickup.com", Err: &net.DNSError{Err: "no such host"}}), "network", ExitNetwork},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := DescribeError(tt.err)
+			assert.Equal(t, tt.wantKind, info.Kind)
+			assert.Equal(t, tt.wantCode, info.ExitCode)
+			assert.Equal(t, tt.wantCode, ExitCode(tt.err))
+		})
+	}
+}
+
+func TestDescribeError_APIDetails(t *testing.T) {
+	info := DescribeError(fmt.Errorf("failed: %w", &api.APIError{StatusCode: 404, Err: "Task not found", Code: "ITEM_015"}))
+
+	assert.Equal(t, 404, info.Status)
+	assert.Equal(t, "ITEM_015", info.Code)
+	assert.Equal(t, "failed: ClickUp API error (HTTP 404): Task not found (ITEM_015)", info.Message)
+}
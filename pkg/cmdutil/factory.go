@@ -1,23 +1,60 @@
 package cmdutil
 
 import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/triptechtravel/clickup-cli/internal/api"
 	"github.com/triptechtravel/clickup-cli/internal/auth"
+	"github.com/triptechtravel/clickup-cli/internal/cassette"
 	"github.com/triptechtravel/clickup-cli/internal/config"
 	gitpkg "github.com/triptechtravel/clickup-cli/internal/git"
 	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/journal"
+	"github.com/triptechtravel/clickup-cli/internal/prompter"
 )
 
 // Factory provides lazy-initialized dependencies to commands.
 type Factory struct {
 	IOStreams *iostreams.IOStreams
 
+	// Debug enables HTTP tracing, as if CLICKUP_DEBUG were set. It is bound
+	// to the global --debug flag.
+	Debug bool
+
+	// Profile selects a named auth profile. It is bound to the global
+	// --profile flag and takes precedence over CLICKUP_PROFILE, directory
+	// bindings and the active profile.
+	Profile string
+
+	// DryRun prints the requests that would modify ClickUp instead of
+	// sending them; reads still go through. It is bound to the global
+	// --dry-run flag.
+	DryRun bool
+
+	// Format selects how list commands print their rows: table (the
+	// default), csv, tsv, markdown, yaml or ndjson. It is bound to the
+	// global --format flag.
+	Format string
+
+	// CommandLine is the command being run, as recorded in the journal
+	// and shown by "clickup history".
+	CommandLine string
+
 	// Test overrides — when set, skip real initialization.
 	apiClientOverride  *api.Client
 	configOverride     *config.Config
 	gitContextOverride *gitpkg.RepoContext
+	journalOverride    *journal.Journal
 
 	configOnce sync.Once
 	config     *config.Config
@@ -27,9 +64,20 @@ type Factory struct {
 	client     *api.Client
 	clientErr  error
 
+	debugOnce sync.Once
+	debugOut  io.Writer
+	debugErr  error
+
 	gitOnce sync.Once
 	gitCtx  *gitpkg.RepoContext
 	gitErr  error
+
+	journalOnce   sync.Once
+	journal       *journal.Journal
+	journalAttach sync.Once
+
+	dryRunMu      sync.Mutex
+	dryRunClients []*api.Client
 }
 
 // NewFactory creates a new Factory with the given IOStreams.
@@ -46,26 +94,175 @@ func (f *Factory) Config() (*config.Config, error) {
 	}
 	f.configOnce.Do(func() {
 		f.config, f.configErr = config.Load()
+		if f.configErr == nil {
+			f.configErr = f.config.UseProfile(f.profileName(f.config))
+		}
+		if f.configErr == nil {
+			f.config.ApplyEnv()
+		}
 	})
 	return f.config, f.configErr
 }
 
+// profileName picks the profile to use: the --profile flag, then
+// CLICKUP_PROFILE, then the current directory's binding, then the active
+// profile.
+func (f *Factory) profileName(cfg *config.Config) string {
+	if f.Profile != "" {
+		return f.Profile
+	}
+	if name := os.Getenv("CLICKUP_PROFILE"); name != "" {
+		return name
+	}
+	dir, _ := os.Getwd()
+	return cfg.ProfileForDir(dir)
+}
+
 // ApiClient returns an authenticated API client (cached after first call).
 func (f *Factory) ApiClient() (*api.Client, error) {
 	if f.apiClientOverride != nil {
+		f.applyDryRun(f.apiClientOverride)
+		if f.journalOverride != nil {
+			f.journalAttach.Do(func() { f.attachJournal(f.apiClientOverride) })
+		}
 		return f.apiClientOverride, nil
 	}
 	f.clientOnce.Do(func() {
-		token, err := auth.GetToken()
+		cfg, err := f.Config()
 		if err != nil {
 			f.clientErr = err
 			return
 		}
-		f.client = api.NewClient(token)
+		profile := cfg.Profile()
+		creds, err := auth.LoadCredentials(profile)
+		if err != nil {
+			f.clientErr = err
+			return
+		}
+		// Refresh up front when the expiry is known to have passed; the
+		// transport still refreshes on a 401 if this fails.
+		if auth.Expired(creds) && creds.RefreshToken != "" {
+			if token, err := auth.RefreshProfileToken(context.Background(), profile, creds.Token); err == nil {
+				creds.Token = token
+			}
+		}
+		f.client, f.clientErr = f.NewAPIClient(creds.Token)
+		if f.clientErr == nil && creds.RefreshToken != "" {
+			f.client.SetTokenRefresher(func(ctx context.Context, expired string) (string, error) {
+				return auth.RefreshProfileToken(ctx, profile, expired)
+			})
+		}
 	})
 	return f.client, f.clientErr
 }
 
+// NewAPIClient builds a client for token with the configured retry policy,
+// the shared rate limit budget and debug tracing applied. Use ApiClient for
+// the stored credentials; this is for tokens obtained mid-command.
+func (f *Factory) NewAPIClient(token string) (*api.Client, error) {
+	cfg, _ := f.Config()
+	policy, err := RetryPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := api.NewClient(token)
+	if host := os.Getenv("CLICKUP_API_URL"); host != "" {
+		client.SetBaseURL(host)
+	}
+	if path := os.Getenv("CLICKUP_RECORD"); path != "" {
+		client.WrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+			return cassette.NewRecorder(rt, path)
+		})
+	}
+	if w, bodies, err := f.debugOutput(); err != nil {
+		return nil, err
+	} else if w != nil {
+		client.EnableTrace(w, bodies)
+	}
+	f.applyDryRun(client)
+	f.attachJournal(client)
+	client.SetRetryPolicy(policy)
+	client.RateLimiter.ShareState(rateLimitStateFile(token))
+	return client, nil
+}
+
+// applyDryRun holds back client's modifying requests under --dry-run.
+// Commands check DryRun themselves to leave out success messages for
+// changes that were not made.
+func (f *Factory) applyDryRun(client *api.Client) {
+	if !f.DryRun {
+		return
+	}
+	client.EnableDryRun(f.IOStreams.ErrOut)
+	f.dryRunMu.Lock()
+	if !slices.Contains(f.dryRunClients, client) {
+		f.dryRunClients = append(f.dryRunClients, client)
+	}
+	f.dryRunMu.Unlock()
+}
+
+// DryRunCount returns the number of requests --dry-run held back, across
+// every client the command used.
+func (f *Factory) DryRunCount() int {
+	f.dryRunMu.Lock()
+	defer f.dryRunMu.Unlock()
+	n := 0
+	for _, client := range f.dryRunClients {
+		n += client.DryRunCount()
+	}
+	return n
+}
+
+// Journal returns the journal of changes made by commands.
+func (f *Factory) Journal() *journal.Journal {
+	if f.journalOverride != nil {
+		return f.journalOverride
+	}
+	f.journalOnce.Do(func() {
+		f.journal = journal.Open(journal.DefaultPath())
+	})
+	return f.journal
+}
+
+// attachJournal records the changes made through client in the journal,
+// so they can be undone. Nothing is changed under --dry-run, and while
+// recording a cassette the journal's reads would be recorded with it.
+func (f *Factory) attachJournal(client *api.Client) {
+	if f.DryRun || os.Getenv("CLICKUP_RECORD") != "" {
+		return
+	}
+	command := f.CommandLine
+	if command == "" {
+		command = "clickup"
+	}
+	journal.Attach(client, f.Journal(), command)
+}
+
+// CommandLine formats args as the command line of a clickup invocation,
+// quoting arguments that contain spaces and masking API tokens.
+func CommandLine(args []string) string {
+	parts := []string{"clickup"}
+	for _, arg := range args {
+		if i := strings.Index(arg, "pk_"); i >= 0 {
+			arg = arg[:i] + "pk_***"
+		}
+		if arg == "" || strings.ContainsAny(arg, " \t\n\"'") {
+			arg = strconv.Quote(arg)
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// rateLimitStateFile returns the shared rate limit state file for token.
+// ClickUp budgets requests per token, so each token gets its own file.
+func rateLimitStateFile(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	name := fmt.Sprintf("ratelimit-%x.json", sum[:6])
+	return filepath.Join(config.ConfigDir(), "state", name)
+}
+
 // GitContext returns the detected git context (cached after first call).
 func (f *Factory) GitContext() (*gitpkg.RepoContext, error) {
 	if f.gitContextOverride != nil {
@@ -77,6 +274,15 @@ func (f *Factory) GitContext() (*gitpkg.RepoContext, error) {
 	return f.gitCtx, f.gitErr
 }
 
+// Prompter returns a prompter whose editor is the configured one.
+func (f *Factory) Prompter() *prompter.Prompter {
+	p := prompter.New(f.IOStreams)
+	if cfg, err := f.Config(); err == nil {
+		p.SetEditor(cfg.Editor)
+	}
+	return p
+}
+
 // SetAPIClient sets a test override for the API client.
 func (f *Factory) SetAPIClient(c *api.Client) {
 	f.apiClientOverride = c
@@ -87,6 +293,12 @@ func (f *Factory) SetConfig(c *config.Config) {
 	f.configOverride = c
 }
 
+// SetJournal sets a test override for the journal. Without one, changes
+// made through a test API client are not journaled.
+func (f *Factory) SetJournal(j *journal.Journal) {
+	f.journalOverride = j
+}
+
 // SetGitContext sets a test override for the git context.
 func (f *Factory) SetGitContext(c *gitpkg.RepoContext) {
 	f.gitContextOverride = c
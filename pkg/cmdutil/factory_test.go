@@ -0,0 +1,115 @@
+package cmdutil
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+)
+
+func TestFactoryConfig_ProfilePrecedence(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CLICKUP_CONFIG_DIR", dir)
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	data := `workspace: "1"
+active_profile: active
+profiles:
+  active: {workspace: "2"}
+  env: {workspace: "3"}
+  flag: {workspace: "4"}
+  bound: {workspace: "5"}
+directory_defaults:
+  ` + cwd + `: {profile: bound}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yml"), []byte(data), 0o644))
+
+	tests := []struct {
+		name     string
+		flag     string
+		env      string
+		wantName string
+		wantWS   string
+	}{
+		{"directory binding", "", "", "bound", "5"},
+		{"env beats directory", "", "env", "env", "3"},
+		{"flag beats env", "flag", "env", "flag", "4"},
+		{"flag selects default", "default", "env", "default", "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CLICKUP_PROFILE", tt.env)
+			f := NewFactory(&iostreams.IOStreams{})
+			f.Profile = tt.flag
+
+			cfg, err := f.Config()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, cfg.Profile())
+			assert.Equal(t, tt.wantWS, cfg.Workspace)
+		})
+	}
+}
+
+func TestFactory_EnvOverrides(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CLICKUP_CONFIG_DIR", dir)
+	t.Setenv("CLICKUP_PROFILE", "")
+	t.Setenv("CLICKUP_WORKSPACE", "99")
+	t.Setenv("CLICKUP_TOKEN", "pk_from_env")
+
+	data := `workspace: "1"
+active_profile: work
+profiles:
+  work: {workspace: "2"}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yml"), []byte(data), 0o644))
+
+	f := NewFactory(&iostreams.IOStreams{})
+	cfg, err := f.Config()
+	require.NoError(t, err)
+	assert.Equal(t, "work", cfg.Profile())
+	assert.Equal(t, "99", cfg.Workspace, "CLICKUP_WORKSPACE beats the profile")
+
+	// No stored credentials are needed when CLICKUP_TOKEN is set.
+	client, err := f.ApiClient()
+	require.NoError(t, err)
+	assert.Equal(t, "pk_from_env", client.Token())
+}
+
+func TestCommandLine(t *testing.T) {
+	got := CommandLine([]string{"task", "edit", "86a1", "--status", "in review", "--token=pk_123_abc", ""})
+	assert.Equal(t, `clickup task edit 86a1 --status "in review" --token=pk_*** ""`, got)
+}
+
+func TestFactory_DryRunCountsEveryClient(t *testing.T) {
+	t.Setenv("CLICKUP_CONFIG_DIR", t.TempDir())
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	t.Setenv("CLICKUP_API_URL", srv.URL)
+
+	f := NewFactory(&iostreams.IOStreams{Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}})
+	f.DryRun = true
+	for _, token := range []string{"pk_one", "pk_two"} {
+		client, err := f.NewAPIClient(token)
+		require.NoError(t, err)
+		req, _ := http.NewRequest("POST", client.URL("list/1/task"), strings.NewReader(`{"name":"x"}`))
+		resp, err := client.DoRequest(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Empty(t, methods)
+	assert.Equal(t, 2, f.DryRunCount())
+}
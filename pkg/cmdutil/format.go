@@ -0,0 +1,54 @@
+package cmdutil
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+)
+
+// formatValue is a flag value that only accepts tableprinter.Formats.
+type formatValue struct {
+	target *string
+}
+
+func (v *formatValue) String() string { return *v.target }
+func (v *formatValue) Type() string   { return "format" }
+
+func (v *formatValue) Set(s string) error {
+	s = strings.ToLower(s)
+	if s == "md" {
+		s = tableprinter.FormatMarkdown
+	}
+	if !slices.Contains(tableprinter.Formats, s) {
+		return fmt.Errorf("valid formats are %s", strings.Join(tableprinter.Formats, ", "))
+	}
+	*v.target = s
+	return nil
+}
+
+// AddFormatFlag adds the global --format flag, bound to f.Format.
+func AddFormatFlag(cmd *cobra.Command, f *Factory) {
+	cmd.PersistentFlags().Var(&formatValue{target: &f.Format}, "format",
+		"Output format for lists: "+strings.Join(tableprinter.Formats, ", "))
+	_ = cmd.RegisterFlagCompletionFunc("format", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return tableprinter.Formats, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// WantsFormat reports whether --format asked for an encoding other than
+// the default table. Commands skip hints and footers in that case so the
+// output can be piped as is.
+func (f *Factory) WantsFormat() bool {
+	return f.Format != "" && f.Format != tableprinter.FormatTable
+}
+
+// PrintTable writes t in the format selected by --format.
+func (f *Factory) PrintTable(t *tableprinter.Table) error {
+	if !f.WantsFormat() {
+		return t.Print(f.IOStreams)
+	}
+	return tableprinter.Encode(f.IOStreams.Out, f.Format, t)
+}
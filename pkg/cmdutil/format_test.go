@@ -0,0 +1,29 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+)
+
+func TestFormatFlag(t *testing.T) {
+	f := NewFactory(&iostreams.IOStreams{})
+	cmd := &cobra.Command{Use: "x", RunE: func(*cobra.Command, []string) error { return nil }}
+	AddFormatFlag(cmd, f)
+
+	cmd.SetArgs([]string{"--format", "MD"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "markdown", f.Format)
+	assert.True(t, f.WantsFormat())
+
+	cmd.SetArgs([]string{"--format", "table"})
+	require.NoError(t, cmd.Execute())
+	assert.False(t, f.WantsFormat())
+
+	cmd.SetArgs([]string{"--format", "xml"})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "valid formats are table, csv, tsv, markdown, yaml, ndjson")
+}
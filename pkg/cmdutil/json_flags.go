@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"text/template"
 
 	"github.com/itchyny/gojq"
 	"github.com/spf13/cobra"
@@ -24,7 +23,7 @@ func AddJSONFlags(cmd *cobra.Command, flags *JSONFlags) {
 	cmd.Flags().BoolVar(&flags.JSON, "json", false, "Output JSON")
 	cmd.Flags().StringVar(&flags.JQ, "jq", "", "Filter JSON output using a jq expression")
 	cmd.Flags().BoolVarP(&flags.Raw, "raw", "r", false, "Output raw strings instead of JSON-encoded (use with --jq)")
-	cmd.Flags().StringVar(&flags.Template, "template", "", "Format JSON output using a Go template")
+	cmd.Flags().StringVar(&flags.Template, "template", "", "Format JSON output using a Go template (@file to read it from a file)")
 }
 
 // OutputJSON writes data as JSON, optionally filtered by jq or formatted by a template.
@@ -97,11 +96,3 @@ func applyJQ(w io.Writer, jsonBytes []byte, expr string, raw bool) error {
 	}
 	return nil
 }
-
-func applyTemplate(w io.Writer, data interface{}, tmplStr string) error {
-	tmpl, err := template.New("output").Parse(tmplStr)
-	if err != nil {
-		return fmt.Errorf("invalid template: %w", err)
-	}
-	return tmpl.Execute(w, data)
-}
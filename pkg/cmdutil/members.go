@@ -0,0 +1,109 @@
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/apiv2"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+// ResolveAssignee resolves a user input (name, username, numeric ID, or "me")
+// to a numeric user ID and display name. It uses the workspace members list.
+func ResolveAssignee(ctx context.Context, client *api.Client, input string) (int, string, error) {
+	members, err := FetchWorkspaceMembers(ctx, client)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var currentUserID int
+	if strings.EqualFold(input, "me") {
+		id, err := GetCurrentUserID(client)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to get current user: %w", err)
+		}
+		currentUserID = id
+	}
+
+	return ResolveAssigneeFromMembers(members, input, currentUserID)
+}
+
+// FilterUserResolver resolves the assignees of a filter expression.
+// Numeric IDs are used as they are.
+func FilterUserResolver(ctx context.Context, client *api.Client) func(string) (int, error) {
+	return func(input string) (int, error) {
+		if id, err := strconv.Atoi(input); err == nil {
+			return id, nil
+		}
+		id, _, err := ResolveAssignee(ctx, client, input)
+		return id, err
+	}
+}
+
+// FetchWorkspaceMembers returns the flattened list of members across all teams.
+func FetchWorkspaceMembers(ctx context.Context, client *api.Client) ([]clickup.TeamUser, error) {
+	teams, err := apiv2.GetTeamsLocal(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workspace members: %w", err)
+	}
+	var members []clickup.TeamUser
+	for _, team := range teams {
+		for _, m := range team.Members {
+			members = append(members, m.User)
+		}
+	}
+	return members, nil
+}
+
+// ResolveAssigneeFromMembers matches input (name, username, numeric ID, or
+// "me") against a pre-fetched member list. If input is "me", currentUserID
+// must be pre-resolved by the caller.
+func ResolveAssigneeFromMembers(members []clickup.TeamUser, input string, currentUserID int) (int, string, error) {
+	if strings.EqualFold(input, "me") {
+		for _, m := range members {
+			if m.ID == currentUserID {
+				return currentUserID, m.Username, nil
+			}
+		}
+		return currentUserID, "me", nil
+	}
+
+	if id, err := strconv.Atoi(input); err == nil {
+		for _, m := range members {
+			if m.ID == id {
+				return id, m.Username, nil
+			}
+		}
+		return 0, "", fmt.Errorf("no workspace member found with ID %d", id)
+	}
+
+	for _, m := range members {
+		if strings.EqualFold(m.Username, input) {
+			return m.ID, m.Username, nil
+		}
+	}
+
+	lowerInput := strings.ToLower(input)
+	var matches []clickup.TeamUser
+	for _, m := range members {
+		if strings.Contains(strings.ToLower(m.Username), lowerInput) {
+			matches = append(matches, m)
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0].ID, matches[0].Username, nil
+	}
+	if len(matches) > 1 {
+		var names []string
+		for _, m := range matches {
+			names = append(names, fmt.Sprintf("%s (ID: %d)", m.Username, m.ID))
+		}
+		return 0, "", fmt.Errorf("ambiguous match, did you mean: %s", strings.Join(names, ", "))
+	}
+
+	return 0, "", fmt.Errorf("no workspace member found matching %q", input)
+}
@@ -0,0 +1,75 @@
+package cmdutil
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/pager"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+)
+
+// allConcurrency is the number of pages fetched at once with --all.
+const allConcurrency = 4
+
+// PageFlags holds the --limit and --all flags of a paginated list command.
+// Without either, a command fetches a single page.
+type PageFlags struct {
+	Limit int
+	All   bool
+}
+
+// AddPageFlags adds --limit and --all to cmd. noun names the listed items
+// in the help text, e.g. "tasks".
+func AddPageFlags(cmd *cobra.Command, flags *PageFlags, noun string) {
+	cmd.Flags().IntVar(&flags.Limit, "limit", 0, fmt.Sprintf("Maximum number of %s to fetch, across pages", noun))
+	cmd.Flags().BoolVar(&flags.All, "all", false, fmt.Sprintf("Fetch all %s, following every page", noun))
+	cmd.MarkFlagsMutuallyExclusive("limit", "all")
+}
+
+// Paging reports whether the flags ask for more than one page.
+func (p *PageFlags) Paging() bool {
+	return p.Limit > 0 || p.All
+}
+
+// Options returns the pager options for the flags: one page by default,
+// up to Limit items with --limit, and every page, several at a time,
+// with --all.
+func (p *PageFlags) Options() pager.Options {
+	switch {
+	case p.All:
+		return pager.Options{Concurrency: allConcurrency}
+	case p.Limit > 0:
+		return pager.Options{Limit: p.Limit}
+	default:
+		return pager.Options{MaxPages: 1}
+	}
+}
+
+// Streams reports whether a list command can write rows as pages arrive
+// rather than after the last one: NDJSON has no header or column widths
+// to work out first, and unsorted rows do not depend on later ones.
+func (f *Factory) Streams(sorted bool) bool {
+	return f.Format == tableprinter.FormatNDJSON && !sorted
+}
+
+// StreamTable writes each item of seq as soon as it is fetched, using row
+// to build a one-row table for it. It returns the number of items
+// written, and stops at the first fetch or print error.
+func StreamTable[T any](f *Factory, seq iter.Seq2[T, error], row func(T) (*tableprinter.Table, error)) (int, error) {
+	n := 0
+	for item, err := range seq {
+		if err != nil {
+			return n, err
+		}
+		tbl, err := row(item)
+		if err != nil {
+			return n, err
+		}
+		if err := f.PrintTable(tbl); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,70 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+)
+
+// RetryPolicy resolves the API retry policy. It starts from
+// api.DefaultRetryPolicy, applies the "retry" section of cfg (which may be
+// nil), then the CLICKUP_RETRY_* environment variables.
+func RetryPolicy(cfg *config.Config) (api.RetryPolicy, error) {
+	p := api.DefaultRetryPolicy()
+
+	var rc config.RetryConfig
+	if cfg != nil {
+		rc = cfg.Retry
+	}
+
+	attempts := strconv.Itoa(rc.MaxAttempts)
+	if rc.MaxAttempts == 0 {
+		attempts = ""
+	}
+	fields := []struct {
+		key, env, value string
+		apply           func(string) error
+	}{
+		{"retry.max_attempts", "CLICKUP_RETRY_MAX_ATTEMPTS", attempts, func(v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return fmt.Errorf("must be a positive integer")
+			}
+			p.MaxAttempts = n
+			return nil
+		}},
+		{"retry.base_delay", "CLICKUP_RETRY_BASE_DELAY", rc.BaseDelay, durationSetter(&p.BaseDelay)},
+		{"retry.max_delay", "CLICKUP_RETRY_MAX_DELAY", rc.MaxDelay, durationSetter(&p.MaxDelay)},
+		{"retry.max_elapsed", "CLICKUP_RETRY_MAX_ELAPSED", rc.MaxElapsed, durationSetter(&p.MaxElapsed)},
+	}
+
+	for _, f := range fields {
+		if f.value != "" {
+			if err := f.apply(f.value); err != nil {
+				return p, fmt.Errorf("invalid %s %q in config: %w", f.key, f.value, err)
+			}
+		}
+		if v := os.Getenv(f.env); v != "" {
+			if err := f.apply(v); err != nil {
+				return p, fmt.Errorf("invalid %s %q: %w", f.env, v, err)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func durationSetter(dst *time.Duration) func(string) error {
+	return func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return fmt.Errorf("must be a non-negative duration such as 500ms or 30s")
+		}
+		*dst = d
+		return nil
+	}
+}
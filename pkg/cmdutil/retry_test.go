@@ -0,0 +1,43 @@
+package cmdutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triptechtravel/clickup-cli/internal/api"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+)
+
+func TestRetryPolicy_Defaults(t *testing.T) {
+	p, err := RetryPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, api.DefaultRetryPolicy(), p)
+}
+
+func TestRetryPolicy_ConfigAndEnv(t *testing.T) {
+	cfg := &config.Config{Retry: config.RetryConfig{
+		MaxAttempts: 6,
+		BaseDelay:   "1s",
+		MaxElapsed:  "5m",
+	}}
+	t.Setenv("CLICKUP_RETRY_MAX_ELAPSED", "10s")
+
+	p, err := RetryPolicy(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 6, p.MaxAttempts)
+	assert.Equal(t, time.Second, p.BaseDelay)
+	assert.Equal(t, 30*time.Second, p.MaxDelay)
+	assert.Equal(t, 10*time.Second, p.MaxElapsed, "env overrides config")
+}
+
+func TestRetryPolicy_Invalid(t *testing.T) {
+	_, err := RetryPolicy(&config.Config{Retry: config.RetryConfig{BaseDelay: "soon"}})
+	assert.ErrorContains(t, err, "retry.base_delay")
+
+	t.Setenv("CLICKUP_RETRY_MAX_ATTEMPTS", "0")
+	_, err = RetryPolicy(nil)
+	assert.ErrorContains(t, err, "CLICKUP_RETRY_MAX_ATTEMPTS")
+}
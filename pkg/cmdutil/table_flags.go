@@ -0,0 +1,119 @@
+package cmdutil
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+)
+
+// TableFlags holds the --columns and --sort flags of a list command.
+type TableFlags struct {
+	Columns string
+	Sort    string
+
+	// command names the command in "columns.<command>" config keys.
+	command string
+}
+
+// AddTableFlags adds --columns and --sort to cmd. command is the name
+// under which a default column set can be saved in config, and columns
+// are offered for completion.
+func AddTableFlags(cmd *cobra.Command, flags *TableFlags, command string, columns []string) {
+	flags.command = command
+	cmd.Flags().StringVar(&flags.Columns, "columns", "", `Columns to show, comma-separated (use field:"Name" for custom fields)`)
+	cmd.Flags().StringVar(&flags.Sort, "sort", "", "Columns to sort by, comma-separated (prefix with - for descending)")
+
+	complete := func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		// Complete the last entry of the list typed so far.
+		var prefix string
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			prefix = toComplete[:i+1]
+		}
+		out := make([]string, len(columns))
+		for i, c := range columns {
+			out[i] = prefix + c
+		}
+		return out, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+	}
+	_ = cmd.RegisterFlagCompletionFunc("columns", complete)
+	_ = cmd.RegisterFlagCompletionFunc("sort", complete)
+}
+
+// ColumnList returns the columns asked for with --columns, or else the
+// default saved in config. It is empty when neither is set.
+func (t *TableFlags) ColumnList(cfg *config.Config) []string {
+	if t.Columns != "" {
+		return SplitColumns(t.Columns)
+	}
+	if cfg != nil && t.command != "" {
+		return SplitColumns(cfg.Columns[t.command])
+	}
+	return nil
+}
+
+// SortList returns the columns given to --sort.
+func (t *TableFlags) SortList() []string {
+	return SplitColumns(t.Sort)
+}
+
+// Customized reports whether a column set or sort order applies.
+func (t *TableFlags) Customized(cfg *config.Config) bool {
+	return len(t.ColumnList(cfg)) > 0 || t.Sort != ""
+}
+
+// CustomFields returns the names of the custom fields referenced as
+// field:<name> in the columns or sort order.
+func (t *TableFlags) CustomFields(cfg *config.Config) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, c := range append(t.ColumnList(cfg), t.SortList()...) {
+		c = strings.TrimLeft(c, "-+")
+		if len(c) > len("field:") && strings.EqualFold(c[:len("field:")], "field:") {
+			name := c[len("field:"):]
+			if !seen[strings.ToLower(name)] {
+				seen[strings.ToLower(name)] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// Apply selects and sorts the columns of tbl.
+func (t *TableFlags) Apply(cfg *config.Config, tbl *tableprinter.Table) error {
+	if err := tbl.Select(t.ColumnList(cfg)); err != nil {
+		return err
+	}
+	return tbl.Sort(t.SortList())
+}
+
+// SplitColumns splits a comma-separated column list. Commas inside double
+// quotes do not split, and the quotes are removed, so field:"Story Type"
+// names the custom field Story Type.
+func SplitColumns(s string) []string {
+	var (
+		out     []string
+		b       strings.Builder
+		inQuote bool
+	)
+	flush := func() {
+		if v := strings.TrimSpace(b.String()); v != "" {
+			out = append(out, v)
+		}
+		b.Reset()
+	}
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == ',' && !inQuote:
+			flush()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush()
+	return out
+}
@@ -0,0 +1,29 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/triptechtravel/clickup-cli/internal/config"
+)
+
+func TestSplitColumns(t *testing.T) {
+	assert.Equal(t, []string{"id", "name", "field:Story Type", "field:a,b"},
+		SplitColumns(`id, name,field:"Story Type",field:"a,b",`))
+	assert.Empty(t, SplitColumns(""))
+}
+
+func TestTableFlags_ConfigDefault(t *testing.T) {
+	cfg := &config.Config{Columns: map[string]string{"task-list": `id,field:"Sprint Goal"`}}
+	flags := &TableFlags{command: "task-list", Sort: "-field:Points,due"}
+
+	assert.Equal(t, []string{"id", "field:Sprint Goal"}, flags.ColumnList(cfg))
+	assert.Equal(t, []string{"Sprint Goal", "Points"}, flags.CustomFields(cfg))
+	assert.True(t, flags.Customized(cfg))
+
+	flags.Columns = "id,name"
+	assert.Equal(t, []string{"id", "name"}, flags.ColumnList(cfg), "--columns wins over config")
+	assert.Equal(t, []string{"Points"}, flags.CustomFields(cfg))
+
+	assert.False(t, (&TableFlags{command: "task-search"}).Customized(cfg))
+}
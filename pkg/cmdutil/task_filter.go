@@ -0,0 +1,812 @@
+package cmdutil
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+// TaskFilterHelp describes the filter language for the help of the
+// commands that accept it.
+const TaskFilterHelp = `Filter expressions combine terms separated by spaces; a task must match
+all of them. Prefix a term with - to negate it, and separate values with
+commas to match any of them. Quote values containing spaces.
+
+A filter that starts with - would be read as a flag, so put it after --:
+'-- -tag:wontfix', or '-- -status:blocked assignee:me'.
+
+  status:"in review"       status:open,"to do"    -status:blocked
+  assignee:me              assignee:Isaac         assignee:none
+  tag:backend              -tag:wontfix           tag:none
+  priority:urgent,high     priority<=high         priority:none
+  points>=3                points:none
+  due<+3d                  due:today              due:none
+  created>-1w              updated>=2025-01-01    start<tomorrow
+  list:<id|name>           folder:<id|name>       space:<id>
+  field:"Story Type"=Bug   field:Estimate>2       -field:"Story Type"
+  is:open                  is:closed              is:subtask
+  is:archived              name:login
+
+Dates are YYYY-MM-DD, today, tomorrow, yesterday, now, or an offset from
+now such as +3d, -2w or +12h. Day values cover the whole day, so due<=today
+includes tasks due later today. Priorities compare by ClickUp's numbering,
+1 (urgent) to 4 (low). Other words are matched against the task name.
+
+Terms ClickUp can filter on are sent with the request; the rest are
+applied to the fetched tasks.`
+
+// TaskFilter is a parsed filter expression, e.g.
+//
+//	status:"in review" assignee:me due<+3d -tag:wontfix points>=3
+type TaskFilter struct {
+	Terms []FilterTerm
+}
+
+// FilterTerm is one term of a filter expression.
+type FilterTerm struct {
+	// Key is the filtered attribute, e.g. "status". It is empty for a
+	// free-text word.
+	Key string
+	// Field is the custom field name of a field: term.
+	Field string
+	// Op is ":", "=", "<", "<=", ">" or ">=". It is empty for a bare
+	// field:"Name", which matches tasks with the field set.
+	Op string
+	// Values are alternatives: the term matches if any of them does.
+	Values []string
+	// Negate inverts the term.
+	Negate bool
+}
+
+type filterKind int
+
+const (
+	// kindMatch keys compare by equality only.
+	kindMatch filterKind = iota
+	// kindOrdered keys also compare with < and >.
+	kindOrdered
+	// kindDate keys hold dates.
+	kindDate
+	// kindField is a custom field, compared by its type.
+	kindField
+)
+
+var filterKeys = map[string]filterKind{
+	"status":   kindMatch,
+	"assignee": kindMatch,
+	"tag":      kindMatch,
+	"list":     kindMatch,
+	"folder":   kindMatch,
+	"space":    kindMatch,
+	"is":       kindMatch,
+	"name":     kindMatch,
+	"priority": kindOrdered,
+	"points":   kindOrdered,
+	"due":      kindDate,
+	"start":    kindDate,
+	"created":  kindDate,
+	"updated":  kindDate,
+	"field":    kindField,
+}
+
+// ParseTaskFilter parses a filter expression. Words that do not start with
+// a known key, such as "login" or "Fix:", are free text.
+func ParseTaskFilter(s string) (*TaskFilter, error) {
+	p := &filterParser{s: s}
+	f := &TaskFilter{}
+	for {
+		p.skipSpace()
+		if p.done() {
+			return f, nil
+		}
+		t, err := p.term()
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter: %w", err)
+		}
+		f.Terms = append(f.Terms, t)
+	}
+}
+
+// Add appends a key:values term, as given by a flag such as --status.
+func (f *TaskFilter) Add(key string, values ...string) {
+	f.Terms = append(f.Terms, FilterTerm{Key: key, Op: ":", Values: values})
+}
+
+// Text returns the free-text words that are not negated, joined by spaces.
+func (f *TaskFilter) Text() string {
+	var words []string
+	for _, t := range f.Terms {
+		if t.Key == "" && !t.Negate {
+			words = append(words, t.Values...)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// HasFilters reports whether f has any term other than free-text words.
+func (f *TaskFilter) HasFilters() bool {
+	return slices.ContainsFunc(f.Terms, func(t FilterTerm) bool { return t.Key != "" || t.Negate })
+}
+
+type filterParser struct {
+	s   string
+	pos int
+}
+
+func (p *filterParser) done() bool { return p.pos >= len(p.s) }
+
+func (p *filterParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *filterParser) skipSpace() {
+	for !p.done() && isFilterSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func isFilterSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (p *filterParser) term() (FilterTerm, error) {
+	var t FilterTerm
+	if p.peek() == '-' && p.pos+1 < len(p.s) && !isFilterSpace(p.s[p.pos+1]) {
+		t.Negate = true
+		p.pos++
+	}
+
+	start := p.pos
+	for !p.done() && (p.peek() >= 'a' && p.peek() <= 'z' || p.peek() >= 'A' && p.peek() <= 'Z') {
+		p.pos++
+	}
+	key := strings.ToLower(p.s[start:p.pos])
+	kind, known := filterKeys[key]
+	if !known || p.operator(false) == "" {
+		p.pos = start
+		word, err := p.word()
+		t.Values = []string{word}
+		return t, err
+	}
+	t.Key = key
+
+	if kind == kindField {
+		if p.operator(true) != ":" {
+			return t, fmt.Errorf(`field needs a name, e.g. field:"Story Type"=Bug`)
+		}
+		name, err := p.value(":=!<>")
+		if err != nil {
+			return t, err
+		}
+		if name == "" {
+			return t, fmt.Errorf(`field needs a name, e.g. field:"Story Type"=Bug`)
+		}
+		t.Field = name
+		if p.done() || isFilterSpace(p.peek()) {
+			return t, nil
+		}
+	}
+
+	op := p.operator(true)
+	switch {
+	case op == "":
+		return t, fmt.Errorf("expected an operator after %q", p.s[start:p.pos])
+	case op == "!=":
+		t.Negate, op = !t.Negate, "="
+	case kind == kindMatch && op != ":" && op != "=":
+		return t, fmt.Errorf("%s does not support %q", key, op)
+	}
+	t.Op = op
+
+	for {
+		v, err := p.value("")
+		if err != nil {
+			return t, err
+		}
+		if v == "" {
+			return t, fmt.Errorf("missing value after %q", p.s[start:p.pos])
+		}
+		t.Values = append(t.Values, v)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+	if !p.done() && !isFilterSpace(p.peek()) {
+		return t, fmt.Errorf("unexpected %q after %q", p.s[p.pos:p.pos+1], p.s[start:p.pos])
+	}
+
+	// Check the values now, so that mistakes are reported while parsing.
+	// Assignees can only be checked against the workspace.
+	_, err := compileTerm(t, FilterOptions{
+		Now:         time.Now(),
+		ResolveUser: func(string) (int, error) { return 0, nil },
+	})
+	return t, err
+}
+
+// operator returns the comparison operator at the current position, and
+// moves past it if consume is set.
+func (p *filterParser) operator(consume bool) string {
+	for _, op := range []string{"!=", "<=", ">=", ":", "=", "<", ">"} {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			if consume {
+				p.pos += len(op)
+			}
+			return op
+		}
+	}
+	return ""
+}
+
+// word reads a free-text word: a quoted phrase or everything up to the
+// next space.
+func (p *filterParser) word() (string, error) {
+	if p.peek() == '"' {
+		return p.quoted()
+	}
+	start := p.pos
+	for !p.done() && !isFilterSpace(p.peek()) {
+		p.pos++
+	}
+	return p.s[start:p.pos], nil
+}
+
+// value reads a quoted value, or a bare one ending at a space, a comma or
+// any byte in stop.
+func (p *filterParser) value(stop string) (string, error) {
+	if p.peek() == '"' {
+		return p.quoted()
+	}
+	start := p.pos
+	for !p.done() && !isFilterSpace(p.peek()) && p.peek() != ',' && !strings.ContainsRune(stop, rune(p.peek())) {
+		p.pos++
+	}
+	return p.s[start:p.pos], nil
+}
+
+func (p *filterParser) quoted() (string, error) {
+	start := p.pos
+	p.pos++
+	var b strings.Builder
+	for !p.done() {
+		c := p.s[p.pos]
+		p.pos++
+		switch {
+		case c == '"':
+			return b.String(), nil
+		case c == '\\' && !p.done():
+			b.WriteByte(p.s[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated quote in %s", p.s[start:])
+}
+
+// FilterOptions controls how a filter is compiled.
+type FilterOptions struct {
+	// Now anchors relative dates. The zero value means the current time.
+	Now time.Time
+	// ResolveUser turns an assignee ("me", a name or an ID) into a user
+	// ID. Without it only numeric IDs are accepted.
+	ResolveUser func(string) (int, error)
+	// Team is set when the query goes to the workspace task endpoint,
+	// which also filters by list, folder and space.
+	Team bool
+	// SearchText is set when the caller matches the free text itself, as
+	// 'task search' does. Negated words are still applied.
+	SearchText bool
+}
+
+// CompiledFilter is a filter ready to run: query parameters for the task
+// endpoint, and a check for the fetched tasks.
+type CompiledFilter struct {
+	// Params are the query parameters ClickUp filters on.
+	Params url.Values
+
+	checks []func(clickup.Task) bool
+	local  bool
+}
+
+// Match reports whether t matches every term. It checks the terms sent
+// as Params too, so it also holds for tasks fetched without them.
+func (c *CompiledFilter) Match(t clickup.Task) bool {
+	for _, check := range c.checks {
+		if !check(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// Local reports whether some terms can only be applied to fetched tasks,
+// so a page of results may hold fewer matches than it fetched.
+func (c *CompiledFilter) Local() bool {
+	return c.local
+}
+
+// dateParams are the query parameter prefixes of the dates ClickUp
+// filters on.
+var dateParams = map[string]string{
+	"due":     "due_date",
+	"created": "date_created",
+	"updated": "date_updated",
+}
+
+// Compile turns f into query parameters and a check for fetched tasks.
+func (f *TaskFilter) Compile(opts FilterOptions) (*CompiledFilter, error) {
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	c := &CompiledFilter{Params: url.Values{}}
+	pushed := map[string]bool{}
+	// Date bounds in milliseconds: tasks from gt (inclusive) to lt
+	// (exclusive).
+	type bounds struct{ gt, lt int64 }
+	dates := map[string]*bounds{}
+
+	for _, t := range f.Terms {
+		if t.Key == "" && !t.Negate && opts.SearchText {
+			continue
+		}
+		m, err := compileTerm(t, opts)
+		if err != nil {
+			return nil, err
+		}
+		check := m.check
+		if t.Negate {
+			check = func(task clickup.Task) bool { return !m.check(task) }
+		}
+		c.checks = append(c.checks, check)
+
+		// Send what ClickUp can filter on. Only the first term of a key
+		// becomes parameters, since ClickUp ORs repeated parameters.
+		sent := false
+		switch {
+		case t.Negate || len(m.params) == 0 && m.from == nil:
+		case m.from != nil:
+			b := dates[t.Key]
+			if b == nil {
+				b = &bounds{}
+				dates[t.Key] = b
+			}
+			if ms := m.from.UnixMilli(); !m.from.IsZero() && (b.gt == 0 || ms > b.gt) {
+				b.gt = ms
+			}
+			if ms := m.to.UnixMilli(); !m.to.IsZero() && (b.lt == 0 || ms < b.lt) {
+				b.lt = ms
+			}
+			sent = true
+		case !pushed[t.Key]:
+			pushed[t.Key] = true
+			for k, vs := range m.params {
+				if !strings.HasSuffix(k, "[]") {
+					c.Params.Set(k, vs[0])
+					continue
+				}
+				for _, v := range vs {
+					c.Params.Add(k, v)
+				}
+			}
+			sent = m.exact
+		}
+		if !sent {
+			c.local = true
+		}
+	}
+
+	for key, b := range dates {
+		prefix := dateParams[key]
+		if b.gt != 0 {
+			c.Params.Set(prefix+"_gt", strconv.FormatInt(b.gt-1, 10))
+		}
+		if b.lt != 0 {
+			c.Params.Set(prefix+"_lt", strconv.FormatInt(b.lt, 10))
+		}
+	}
+	return c, nil
+}
+
+// compiledTerm is a term ready to check, with the parameters that narrow
+// the request for it.
+type compiledTerm struct {
+	check  func(clickup.Task) bool
+	params url.Values
+	// exact is set when params filter exactly as check does.
+	exact bool
+	// from and to bound a date term ClickUp can filter on.
+	from, to *time.Time
+}
+
+func compileTerm(t FilterTerm, opts FilterOptions) (compiledTerm, error) {
+	anyOf := func(match func(clickup.Task, string) bool) func(clickup.Task) bool {
+		return func(task clickup.Task) bool {
+			return slices.ContainsFunc(t.Values, func(v string) bool { return match(task, v) })
+		}
+	}
+	hasNone := slices.ContainsFunc(t.Values, func(v string) bool { return strings.EqualFold(v, "none") })
+
+	switch t.Key {
+	case "", "name":
+		return compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			return strings.Contains(strings.ToLower(task.Name), strings.ToLower(v))
+		})}, nil
+
+	case "status":
+		params := url.Values{"statuses[]": t.Values, "include_closed": {"true"}}
+		return compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			return strings.EqualFold(task.Status.Status, v)
+		}), params: params, exact: true}, nil
+
+	case "is":
+		m := compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			switch strings.ToLower(v) {
+			case "open":
+				return !isClosedStatus(task.Status.Type)
+			case "closed":
+				return isClosedStatus(task.Status.Type)
+			case "subtask":
+				return task.Parent != ""
+			default:
+				return task.Archived
+			}
+		}), params: url.Values{}}
+		for _, v := range t.Values {
+			switch strings.ToLower(v) {
+			case "open":
+			case "closed":
+				m.params.Set("include_closed", "true")
+			case "subtask":
+				m.params.Set("subtasks", "true")
+			case "archived":
+				m.params.Set("archived", "true")
+			default:
+				return m, fmt.Errorf("is: expects open, closed, subtask or archived, not %q", v)
+			}
+		}
+		m.exact = len(t.Values) == 1 && strings.EqualFold(t.Values[0], "archived")
+		return m, nil
+
+	case "assignee":
+		ids := map[string]int{}
+		params := url.Values{}
+		for _, v := range t.Values {
+			if strings.EqualFold(v, "none") {
+				continue
+			}
+			id, err := resolveFilterUser(v, opts.ResolveUser)
+			if err != nil {
+				return compiledTerm{}, err
+			}
+			ids[v] = id
+			params.Add("assignees[]", strconv.Itoa(id))
+		}
+		m := compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			if strings.EqualFold(v, "none") {
+				return len(task.Assignees) == 0
+			}
+			return slices.ContainsFunc(task.Assignees, func(u clickup.User) bool { return u.ID == ids[v] })
+		})}
+		if !hasNone {
+			m.params, m.exact = params, true
+		}
+		return m, nil
+
+	case "tag":
+		m := compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			if strings.EqualFold(v, "none") {
+				return len(task.Tags) == 0
+			}
+			return slices.ContainsFunc(task.Tags, func(tag clickup.Tag) bool { return strings.EqualFold(tag.Name, v) })
+		})}
+		if !hasNone {
+			m.params, m.exact = url.Values{"tags[]": t.Values}, true
+		}
+		return m, nil
+
+	case "list", "folder", "space":
+		m := compiledTerm{check: anyOf(func(task clickup.Task, v string) bool {
+			id, name := task.List.ID, task.List.Name
+			switch t.Key {
+			case "folder":
+				id, name = task.Folder.ID, task.Folder.Name
+			case "space":
+				id, name = task.Space.ID, ""
+			}
+			return id == v || name != "" && strings.EqualFold(name, v)
+		})}
+		numeric := !slices.ContainsFunc(t.Values, func(v string) bool {
+			_, err := strconv.ParseUint(v, 10, 64)
+			return err != nil
+		})
+		if opts.Team && numeric {
+			param := map[string]string{"list": "list_ids[]", "folder": "project_ids[]", "space": "space_ids[]"}[t.Key]
+			m.params, m.exact = url.Values{param: t.Values}, true
+		}
+		return m, nil
+
+	case "priority":
+		want := make([]int, len(t.Values))
+		for i, v := range t.Values {
+			n, ok := priorityNumber(v)
+			if !ok && !strings.EqualFold(v, "none") {
+				return compiledTerm{}, fmt.Errorf("priority: expects urgent, high, normal, low, none or 1-4, not %q", v)
+			}
+			want[i] = n
+		}
+		return compiledTerm{check: func(task clickup.Task) bool {
+			got, ok := priorityNumber(task.Priority.Priority)
+			for i, v := range t.Values {
+				if strings.EqualFold(v, "none") {
+					if !ok {
+						return true
+					}
+					continue
+				}
+				if ok && compareNumbers(float64(got), t.Op, float64(want[i])) {
+					return true
+				}
+			}
+			return false
+		}}, nil
+
+	case "points":
+		want := make([]float64, len(t.Values))
+		for i, v := range t.Values {
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil && !strings.EqualFold(v, "none") {
+				return compiledTerm{}, fmt.Errorf("points: expects a number or none, not %q", v)
+			}
+			want[i] = n
+		}
+		return compiledTerm{check: func(task clickup.Task) bool {
+			// Tasks without points have none, null or 0, as in the table.
+			got, err := strconv.ParseFloat(task.Points.Value.String(), 64)
+			set := err == nil && got != 0
+			for i, v := range t.Values {
+				if strings.EqualFold(v, "none") {
+					if !set {
+						return true
+					}
+					continue
+				}
+				if set && compareNumbers(got, t.Op, want[i]) {
+					return true
+				}
+			}
+			return false
+		}}, nil
+
+	case "due", "start", "created", "updated":
+		ranges := make([][2]time.Time, len(t.Values))
+		for i, v := range t.Values {
+			if strings.EqualFold(v, "none") {
+				continue
+			}
+			from, to, err := parseFilterDate(v, opts.Now)
+			if err != nil {
+				return compiledTerm{}, fmt.Errorf("%s: %w", t.Key, err)
+			}
+			ranges[i] = [2]time.Time{from, to}
+		}
+		m := compiledTerm{check: func(task clickup.Task) bool {
+			got := taskDate(task, t.Key)
+			for i, v := range t.Values {
+				if strings.EqualFold(v, "none") {
+					if got.IsZero() {
+						return true
+					}
+					continue
+				}
+				if !got.IsZero() && inDateRange(got, t.Op, ranges[i][0], ranges[i][1]) {
+					return true
+				}
+			}
+			return false
+		}}
+		if _, ok := dateParams[t.Key]; ok && len(t.Values) == 1 && !hasNone {
+			from, to := dateBounds(t.Op, ranges[0][0], ranges[0][1])
+			m.from, m.to = &from, &to
+		}
+		return m, nil
+
+	case "field":
+		return compileFieldTerm(t, opts)
+	}
+	return compiledTerm{}, fmt.Errorf("unknown filter key %q", t.Key)
+}
+
+func compileFieldTerm(t FilterTerm, opts FilterOptions) (compiledTerm, error) {
+	find := func(task clickup.Task) (clickup.CustomField, bool) {
+		for _, cf := range task.CustomFields {
+			if strings.EqualFold(cf.Name, t.Field) {
+				return cf, true
+			}
+		}
+		return clickup.CustomField{}, false
+	}
+	if t.Op == "" {
+		return compiledTerm{check: func(task clickup.Task) bool {
+			cf, ok := find(task)
+			return ok && cf.Value != nil && FormatCustomFieldValue(cf) != ""
+		}}, nil
+	}
+	return compiledTerm{check: func(task clickup.Task) bool {
+		cf, ok := find(task)
+		if !ok || cf.Value == nil {
+			return false
+		}
+		display := FormatCustomFieldValue(cf)
+		for _, v := range t.Values {
+			if matchFieldValue(cf, display, t.Op, v, opts.Now) {
+				return true
+			}
+		}
+		return false
+	}}, nil
+}
+
+// matchFieldValue compares a custom field with a filter value: dates and
+// numbers by value, anything else by its display form, where a value
+// matches any of several labels.
+func matchFieldValue(cf clickup.CustomField, display, op, v string, now time.Time) bool {
+	raw := customFieldSortKey(cf)
+	if cf.Type == "date" {
+		ms, err := strconv.ParseInt(strings.Split(raw, ".")[0], 10, 64)
+		from, to, derr := parseFilterDate(v, now)
+		return err == nil && derr == nil && inDateRange(time.UnixMilli(ms), op, from, to)
+	}
+	if cf.Type == "number" || cf.Type == "currency" {
+		got, err := strconv.ParseFloat(raw, 64)
+		want, werr := strconv.ParseFloat(v, 64)
+		return err == nil && werr == nil && compareNumbers(got, op, want)
+	}
+	if op != ":" && op != "=" {
+		return false
+	}
+	if strings.EqualFold(display, v) {
+		return true
+	}
+	return slices.ContainsFunc(strings.Split(display, ", "), func(s string) bool { return strings.EqualFold(s, v) })
+}
+
+func resolveFilterUser(v string, resolve func(string) (int, error)) (int, error) {
+	if resolve != nil {
+		return resolve(v)
+	}
+	id, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("assignee: expects a numeric user ID, not %q", v)
+	}
+	return id, nil
+}
+
+func isClosedStatus(statusType string) bool {
+	return statusType == "closed" || statusType == "done"
+}
+
+// priorityNumber returns ClickUp's number for a priority name or number,
+// 1 (urgent) to 4 (low).
+func priorityNumber(p string) (int, bool) {
+	switch strings.ToLower(p) {
+	case "urgent", "1":
+		return 1, true
+	case "high", "2":
+		return 2, true
+	case "normal", "3":
+		return 3, true
+	case "low", "4":
+		return 4, true
+	}
+	return 0, false
+}
+
+func compareNumbers(got float64, op string, want float64) bool {
+	switch op {
+	case "<":
+		return got < want
+	case "<=":
+		return got <= want
+	case ">":
+		return got > want
+	case ">=":
+		return got >= want
+	}
+	return got == want
+}
+
+// taskDate returns the date of t that key filters on, or the zero time
+// if it is not set.
+func taskDate(t clickup.Task, key string) time.Time {
+	switch key {
+	case "due":
+		if t.DueDate != nil {
+			if d := t.DueDate.Time(); d != nil {
+				return *d
+			}
+		}
+		return time.Time{}
+	case "start":
+		return ParseMSTimestamp(t.StartDate)
+	case "created":
+		return ParseMSTimestamp(t.DateCreated)
+	}
+	return ParseMSTimestamp(t.DateUpdated)
+}
+
+// dateBounds returns the times from (inclusive) and to (exclusive) that
+// op selects for a value covering from..to. A zero time is unbounded.
+func dateBounds(op string, from, to time.Time) (time.Time, time.Time) {
+	switch op {
+	case "<":
+		return time.Time{}, from
+	case "<=":
+		return time.Time{}, to
+	case ">":
+		return to, time.Time{}
+	case ">=":
+		return from, time.Time{}
+	}
+	return from, to
+}
+
+func inDateRange(t time.Time, op string, from, to time.Time) bool {
+	lo, hi := dateBounds(op, from, to)
+	return (lo.IsZero() || !t.Before(lo)) && (hi.IsZero() || t.Before(hi))
+}
+
+var relativeDate = regexp.MustCompile(`^([+-])(\d+)([hdw])$`)
+
+// parseFilterDate parses a filter date into the span it covers: a whole
+// day for dates and day offsets, a millisecond for times.
+func parseFilterDate(s string, now time.Time) (time.Time, time.Time, error) {
+	day := func(t time.Time) (time.Time, time.Time, error) {
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 0, 1), nil
+	}
+	instant := func(t time.Time) (time.Time, time.Time, error) {
+		return t, t.Add(time.Millisecond), nil
+	}
+
+	switch strings.ToLower(s) {
+	case "now":
+		return instant(now)
+	case "today":
+		return day(now)
+	case "tomorrow":
+		return day(now.AddDate(0, 0, 1))
+	case "yesterday":
+		return day(now.AddDate(0, 0, -1))
+	}
+	if m := relativeDate.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		if m[1] == "-" {
+			n = -n
+		}
+		switch m[3] {
+		case "h":
+			return instant(now.Add(time.Duration(n) * time.Hour))
+		case "w":
+			return day(now.AddDate(0, 0, 7*n))
+		}
+		return day(now.AddDate(0, 0, n))
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return day(t)
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return instant(t)
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD, today, tomorrow, yesterday, now, or an offset like +3d, -2w or +12h)", s)
+}
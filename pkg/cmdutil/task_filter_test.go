@@ -0,0 +1,177 @@
+package cmdutil
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+func TestParseTaskFilter(t *testing.T) {
+	f, err := ParseTaskFilter(`status:"in review",open assignee:me due<+3d tag:backend field:"Story Type"=Bug -tag:wontfix points>=3 login "Fix: it" priority!=low`)
+	require.NoError(t, err)
+	assert.Equal(t, []FilterTerm{
+		{Key: "status", Op: ":", Values: []string{"in review", "open"}},
+		{Key: "assignee", Op: ":", Values: []string{"me"}},
+		{Key: "due", Op: "<", Values: []string{"+3d"}},
+		{Key: "tag", Op: ":", Values: []string{"backend"}},
+		{Key: "field", Field: "Story Type", Op: "=", Values: []string{"Bug"}},
+		{Key: "tag", Op: ":", Values: []string{"wontfix"}, Negate: true},
+		{Key: "points", Op: ">=", Values: []string{"3"}},
+		{Values: []string{"login"}},
+		{Values: []string{"Fix: it"}},
+		{Key: "priority", Op: "=", Values: []string{"low"}, Negate: true},
+	}, f.Terms)
+	assert.Equal(t, "login Fix: it", f.Text())
+	assert.True(t, f.HasFilters())
+
+	f, err = ParseTaskFilter(`Fix: login -field:Estimate http://x`)
+	require.NoError(t, err)
+	assert.Equal(t, []FilterTerm{
+		{Values: []string{"Fix:"}},
+		{Values: []string{"login"}},
+		{Key: "field", Field: "Estimate", Negate: true},
+		{Values: []string{"http://x"}},
+	}, f.Terms)
+}
+
+func TestParseTaskFilter_Errors(t *testing.T) {
+	for expr, want := range map[string]string{
+		`status:`:           `missing value after "status:"`,
+		`tag<x`:             `tag does not support "<"`,
+		`status:"in review`: "unterminated quote",
+		`field:=Bug`:        "field needs a name",
+		`status:"a"b`:       `unexpected "b" after "status:\"a\""`,
+	} {
+		_, err := ParseTaskFilter(expr)
+		assert.ErrorContains(t, err, want, expr)
+	}
+}
+
+func TestTaskFilter_Compile(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	ms := func(day int) int64 {
+		return time.Date(2025, 3, day, 0, 0, 0, 0, time.UTC).UnixMilli()
+	}
+
+	f, err := ParseTaskFilter(`status:review assignee:me tag:api due<+3d due>=today list:900 -tag:wontfix`)
+	require.NoError(t, err)
+	c, err := f.Compile(FilterOptions{
+		Now:         now,
+		ResolveUser: func(string) (int, error) { return 7, nil },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"review"}, c.Params["statuses[]"])
+	assert.Equal(t, "true", c.Params.Get("include_closed"))
+	assert.Equal(t, []string{"7"}, c.Params["assignees[]"])
+	assert.Equal(t, []string{"api"}, c.Params["tags[]"])
+	assert.Equal(t, strconv.FormatInt(ms(10)-1, 10), c.Params.Get("due_date_gt"))
+	assert.Equal(t, strconv.FormatInt(ms(13), 10), c.Params.Get("due_date_lt"))
+	assert.Empty(t, c.Params["list_ids[]"], "the list endpoint cannot filter by list")
+	assert.True(t, c.Local())
+
+	c, err = f.Compile(FilterOptions{Now: now, ResolveUser: func(string) (int, error) { return 7, nil }, Team: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"900"}, c.Params["list_ids[]"])
+
+	f, err = ParseTaskFilter(`status:open assignee:Isaac`)
+	require.NoError(t, err)
+	_, err = f.Compile(FilterOptions{})
+	assert.ErrorContains(t, err, "expects a numeric user ID")
+
+	f, err = ParseTaskFilter(`status:open status:closed`)
+	require.NoError(t, err)
+	c, err = f.Compile(FilterOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open"}, c.Params["statuses[]"], "only the first term of a key is sent")
+	assert.True(t, c.Local())
+}
+
+func TestCompiledFilter_Match(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	task := clickup.Task{
+		Name:      "Fix login timeout",
+		Status:    clickup.TaskStatus{Status: "in review", Type: "custom"},
+		Priority:  clickup.TaskPriority{Priority: "high"},
+		Assignees: []clickup.User{{ID: 7, Username: "isaac"}},
+		Tags:      []clickup.Tag{{Name: "backend"}},
+		DueDate:   clickup.NewDate(time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC)),
+		Points:    clickup.Point{Value: "5"},
+		List:      clickup.ListOfTaskBelonging{ID: "900", Name: "Sprint 4"},
+		CustomFields: []clickup.CustomField{
+			{Name: "Story Type", Type: "short_text", Value: "Bug"},
+			{Name: "Estimate", Type: "number", Value: 2.5},
+		},
+		DateCreated: "1741000000000",
+	}
+
+	for expr, want := range map[string]bool{
+		`status:"In Review"`:           true,
+		`status:open,"to do"`:          false,
+		`is:open`:                      true,
+		`is:closed`:                    false,
+		`assignee:7`:                   true,
+		`assignee:none`:                false,
+		`tag:backend -tag:wontfix`:     true,
+		`-tag:backend`:                 false,
+		`tag:none`:                     false,
+		`priority<=high`:               true,
+		`priority:urgent`:              false,
+		`priority:none`:                false,
+		`points>=3`:                    true,
+		`points<5`:                     false,
+		`due<+3d`:                      true,
+		`due:2025-03-12`:               true,
+		`due<=tomorrow`:                false,
+		`due:none`:                     false,
+		`created<today`:                true,
+		`start:none`:                   true,
+		`list:"sprint 4"`:              true,
+		`list:901`:                     false,
+		`field:"Story Type"=bug`:       true,
+		`field:"story type"!=Bug`:      false,
+		`field:Estimate>2`:             true,
+		`field:Estimate`:               true,
+		`-field:Sprint`:                true,
+		`login timeout`:                true,
+		`-login`:                       false,
+		`name:logout`:                  false,
+		`is:subtask`:                   false,
+		`assignee:7 points>10`:         false,
+		`status:"in review" due>-1w`:   true,
+		`priority:high,urgent due>+1w`: false,
+	} {
+		f, err := ParseTaskFilter(expr)
+		require.NoError(t, err, expr)
+		c, err := f.Compile(FilterOptions{Now: now})
+		require.NoError(t, err, expr)
+		assert.Equal(t, want, c.Match(task), expr)
+	}
+}
+
+func TestCompiledFilter_SearchText(t *testing.T) {
+	f, err := ParseTaskFilter(`login -draft`)
+	require.NoError(t, err)
+	c, err := f.Compile(FilterOptions{SearchText: true})
+	require.NoError(t, err)
+	assert.True(t, c.Match(clickup.Task{Name: "Something else"}), "the caller matches the search text")
+	assert.False(t, c.Match(clickup.Task{Name: "Draft login"}))
+}
+
+func TestParseFilterDate(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+	from, to, err := parseFilterDate("-1w", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), to)
+
+	from, _, err = parseFilterDate("+12h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(12*time.Hour), from)
+
+	_, _, err = parseFilterDate("3d", now)
+	assert.ErrorContains(t, err, "invalid date")
+}
@@ -0,0 +1,226 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+)
+
+// TaskColumns lists the built-in columns of a task table, by the names
+// accepted by --columns and --sort. Custom fields are added as
+// field:<name>.
+var TaskColumns = []string{
+	"id", "name", "status", "priority", "assignee", "tags", "due", "start",
+	"points", "estimate", "spent", "list", "folder", "created", "updated",
+	"url", "parent",
+}
+
+// priorityRank orders priorities so that "-priority" puts urgent first.
+var priorityRank = map[string]string{"urgent": "4", "high": "3", "normal": "2", "low": "1"}
+
+// TaskTable collects tasks for the commands that list them, with every
+// built-in column available to --columns and --sort.
+type TaskTable struct {
+	cols     []tableprinter.Column
+	ids      []string
+	defaults []string
+	fields   []string
+	rows     []taskRow
+}
+
+type taskRow struct {
+	values   map[string]string
+	sortKeys map[string]string
+}
+
+// NewTaskTable returns an empty task table that shows the defaults
+// columns. A column is added for each custom field in fields, and the
+// extra columns follow; AddTask takes their values.
+func NewTaskTable(f *Factory, defaults, fields []string, extra ...tableprinter.Column) *TaskTable {
+	cs := f.IOStreams.ColorScheme()
+	tt := &TaskTable{defaults: defaults, fields: fields}
+	add := func(id string, c tableprinter.Column) {
+		tt.ids = append(tt.ids, id)
+		tt.cols = append(tt.cols, c)
+	}
+
+	add("id", tableprinter.Column{Header: "ID"})
+	add("name", tableprinter.Column{Header: "NAME", Truncate: true})
+	add("status", tableprinter.Column{Header: "STATUS", Color: func(s string) string { return cs.StatusColor(strings.ToLower(s))(s) }})
+	add("priority", tableprinter.Column{Header: "PRIORITY"})
+	add("assignee", tableprinter.Column{Header: "ASSIGNEE", Aliases: []string{"assignees"}})
+	add("tags", tableprinter.Column{Header: "TAGS"})
+	add("due", tableprinter.Column{Header: "DUE", Key: "due_date"})
+	add("start", tableprinter.Column{Header: "START", Key: "start_date"})
+	add("points", tableprinter.Column{Header: "POINTS"})
+	add("estimate", tableprinter.Column{Header: "ESTIMATE", Key: "time_estimate"})
+	add("spent", tableprinter.Column{Header: "SPENT", Key: "time_spent"})
+	add("list", tableprinter.Column{Header: "LIST"})
+	add("folder", tableprinter.Column{Header: "FOLDER"})
+	add("created", tableprinter.Column{Header: "CREATED", Key: "date_created"})
+	add("updated", tableprinter.Column{Header: "UPDATED", Key: "date_updated"})
+	add("url", tableprinter.Column{Header: "URL"})
+	add("parent", tableprinter.Column{Header: "PARENT"})
+	for _, name := range fields {
+		add("field:"+strings.ToLower(name), tableprinter.Column{
+			Header:  strings.ToUpper(name),
+			Key:     strings.ReplaceAll(strings.ToLower(name), " ", "_"),
+			Aliases: []string{"field:" + name},
+		})
+	}
+	for i, c := range extra {
+		add("extra:"+strconv.Itoa(i), c)
+	}
+	return tt
+}
+
+// AddTask adds a row for t, followed by the values of the extra columns.
+func (tt *TaskTable) AddTask(t clickup.Task, extra ...string) {
+	id := t.ID
+	if t.CustomID != "" {
+		id = t.CustomID
+	}
+	assignees := make([]string, 0, len(t.Assignees))
+	for _, a := range t.Assignees {
+		assignees = append(assignees, a.Username)
+	}
+	tags := make([]string, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		tags = append(tags, tag.Name)
+	}
+	var due, dueKey string
+	if t.DueDate != nil {
+		if dt := t.DueDate.Time(); dt != nil {
+			due, dueKey = dt.Format("Jan 02"), strconv.FormatInt(dt.UnixMilli(), 10)
+		}
+	}
+	var points string
+	if p := t.Points.Value.String(); p != "" && p != "0" {
+		points = p
+	}
+
+	row := taskRow{
+		values: map[string]string{
+			"id":       id,
+			"name":     t.Name,
+			"status":   t.Status.Status,
+			"priority": t.Priority.Priority,
+			"assignee": strings.Join(assignees, ", "),
+			"tags":     strings.Join(tags, ", "),
+			"due":      due,
+			"start":    formatMillisDate(t.StartDate),
+			"points":   points,
+			"estimate": formatMillisDuration(t.TimeEstimate),
+			"spent":    formatMillisDuration(t.TimeSpent),
+			"list":     t.List.Name,
+			"folder":   t.Folder.Name,
+			"created":  formatMillisDate(t.DateCreated),
+			"updated":  formatMillisDate(t.DateUpdated),
+			"url":      t.URL,
+			"parent":   t.Parent,
+		},
+		sortKeys: map[string]string{
+			"priority": priorityRank[strings.ToLower(t.Priority.Priority)],
+			"due":      dueKey,
+			"start":    t.StartDate,
+			"estimate": millisKey(t.TimeEstimate),
+			"spent":    millisKey(t.TimeSpent),
+			"created":  t.DateCreated,
+			"updated":  t.DateUpdated,
+		},
+	}
+	for _, name := range tt.fields {
+		for _, cf := range t.CustomFields {
+			if strings.EqualFold(cf.Name, name) {
+				key := "field:" + strings.ToLower(name)
+				row.values[key] = FormatCustomFieldValue(cf)
+				row.sortKeys[key] = customFieldSortKey(cf)
+				break
+			}
+		}
+	}
+	for i, v := range extra {
+		row.values["extra:"+strconv.Itoa(i)] = v
+	}
+	tt.rows = append(tt.rows, row)
+}
+
+// Len returns the number of tasks added.
+func (tt *TaskTable) Len() int {
+	return len(tt.rows)
+}
+
+// Table returns the rows as a table showing the default columns.
+func (tt *TaskTable) Table() *tableprinter.Table {
+	tbl := tableprinter.NewTable(tt.cols...)
+	for _, r := range tt.rows {
+		fields := make([]string, len(tt.ids))
+		for i, id := range tt.ids {
+			fields[i] = r.values[id]
+		}
+		tbl.AddRow(fields...)
+		for i, id := range tt.ids {
+			if v := r.sortKeys[id]; v != "" {
+				tbl.SetSortKey(tt.cols[i].FieldKey(), v)
+			}
+		}
+	}
+	// The defaults are picked by the calling command from the columns
+	// above, so Select cannot fail on them.
+	_ = tbl.Select(tt.defaults)
+	return tbl
+}
+
+// customFieldSortKey returns the raw value of numeric and date fields so
+// they sort by value rather than by their display form.
+func customFieldSortKey(cf clickup.CustomField) string {
+	switch v := cf.Value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		if cf.Type == "date" || cf.Type == "number" || cf.Type == "currency" {
+			return v
+		}
+	}
+	return ""
+}
+
+// formatMillisDate formats a millisecond timestamp string as a date.
+func formatMillisDate(ms string) string {
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || n <= 0 {
+		return ""
+	}
+	return time.UnixMilli(n).Format("2006-01-02")
+}
+
+func millisKey(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(ms, 10)
+}
+
+// formatMillisDuration converts milliseconds to a human-readable duration string.
+func formatMillisDuration(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+	d := time.Duration(ms) * time.Millisecond
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	if h > 0 && m > 0 {
+		return fmt.Sprintf("%dh %dm", h, m)
+	}
+	if h > 0 {
+		return fmt.Sprintf("%dh", h)
+	}
+	if m > 0 {
+		return fmt.Sprintf("%dm", m)
+	}
+	return "< 1m"
+}
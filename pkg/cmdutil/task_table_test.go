@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/tableprinter"
+)
+
+func TestTaskTable_ColumnsAndSort(t *testing.T) {
+	f := &Factory{IOStreams: iostreams.Test()}
+
+	tt := NewTaskTable(f, []string{"id", "name"}, []string{"Story Type"},
+		tableprinter.Column{Header: "MATCH"})
+	tt.AddTask(clickup.Task{
+		ID: "a", Name: "Low", Priority: clickup.TaskPriority{Priority: "low"},
+		TimeEstimate: 5400000,
+		CustomFields: []clickup.CustomField{{Name: "Story Type", Type: "short_text", Value: "bug"}},
+	}, "name")
+	tt.AddTask(clickup.Task{
+		ID: "b", CustomID: "ENG-2", Name: "Urgent", Priority: clickup.TaskPriority{Priority: "urgent"},
+	}, "fuzzy")
+	tt.AddTask(clickup.Task{ID: "c", Name: "None"}, "desc")
+
+	tbl := tt.Table()
+	require.NoError(t, tbl.Select([]string{"id", "field:story type", "estimate", "match"}))
+	require.NoError(t, tbl.Sort([]string{"-priority"}))
+
+	var buf bytes.Buffer
+	require.NoError(t, tableprinter.Encode(&buf, tableprinter.FormatCSV, tbl))
+	assert.Equal(t, "ID,STORY TYPE,ESTIMATE,MATCH\n"+
+		"ENG-2,,,fuzzy\n"+
+		"a,bug,1h 30m,name\n"+
+		"c,,,desc\n", buf.String())
+}
@@ -0,0 +1,280 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+	"github.com/triptechtravel/clickup-cli/internal/iostreams"
+	"github.com/triptechtravel/clickup-cli/internal/text"
+	"golang.org/x/term"
+)
+
+// applyTemplate renders data with a Go template. A template starting with
+// "@" is read from the named file.
+func applyTemplate(w io.Writer, data interface{}, tmplStr string) error {
+	name := "output"
+	if path, ok := strings.CutPrefix(tmplStr, "@"); ok {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		name, tmplStr = filepath.Base(path), string(b)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	funcs := templateFuncs(colorSchemeFor(w))
+	funcs["tablerow"] = func(fields ...interface{}) (string, error) {
+		cells := make([]string, len(fields))
+		for i, f := range fields {
+			cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(toString(f))
+		}
+		_, err := fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		return "", err
+	}
+	funcs["tablerender"] = func() (string, error) {
+		return "", tw.Flush()
+	}
+
+	tmpl, err := template.New(name).Funcs(funcs).Parse(tmplStr)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		return err
+	}
+	// Rows added after the last tablerender are flushed at the end.
+	return tw.Flush()
+}
+
+// colorSchemeFor enables colors only when w is a terminal, so templates
+// written to files and pipes stay plain.
+func colorSchemeFor(w io.Writer) *iostreams.ColorScheme {
+	f, ok := w.(*os.File)
+	enabled := ok && term.IsTerminal(int(f.Fd())) && os.Getenv("NO_COLOR") == ""
+	return iostreams.NewColorScheme(enabled)
+}
+
+// templateFuncs returns the functions available to --template, in
+// addition to tablerow and tablerender which applyTemplate binds to its
+// writer.
+func templateFuncs(cs *iostreams.ColorScheme) template.FuncMap {
+	return template.FuncMap{
+		"timeago": func(v interface{}) string {
+			t, ok := toTime(v)
+			if !ok {
+				return ""
+			}
+			return text.RelativeTime(t)
+		},
+		"date": func(layout string, v interface{}) string {
+			t, ok := toTime(v)
+			if !ok {
+				return ""
+			}
+			return t.Format(layout)
+		},
+		"duration": func(v interface{}) string {
+			ms, ok := toInt(v)
+			if !ok {
+				return ""
+			}
+			return formatMillisDuration(ms)
+		},
+		"priority": templatePriority,
+		"truncate": func(n int, v interface{}) string {
+			return text.Truncate(toString(v), n)
+		},
+		"pluralize": func(n interface{}, word string) string {
+			count, _ := toInt(n)
+			return text.Pluralize(int(count), word)
+		},
+		"join": func(sep string, v interface{}) string {
+			return strings.Join(toStrings(v), sep)
+		},
+		"color": func(name string, v interface{}) (string, error) {
+			s := toString(v)
+			switch strings.ToLower(name) {
+			case "red":
+				return cs.Red(s), nil
+			case "green":
+				return cs.Green(s), nil
+			case "yellow":
+				return cs.Yellow(s), nil
+			case "blue":
+				return cs.Blue(s), nil
+			case "cyan":
+				return cs.Cyan(s), nil
+			case "gray", "grey":
+				return cs.Gray(s), nil
+			case "bold":
+				return cs.Bold(s), nil
+			case "status":
+				return cs.StatusColor(strings.ToLower(s))(s), nil
+			}
+			return "", fmt.Errorf("unknown color %q", name)
+		},
+		"mdescape": func(v interface{}) string {
+			return markdownEscaper.Replace(toString(v))
+		},
+		"mdlink": func(label, url interface{}) string {
+			return "[" + markdownEscaper.Replace(toString(label)) + "](" + toString(url) + ")"
+		},
+	}
+}
+
+// markdownEscaper escapes the characters that change meaning inline or in
+// a table cell, and keeps a value on one line.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "|", `\|`,
+	"\r\n", " ", "\n", " ",
+)
+
+// templatePriority names a priority given as a ClickUp priority object,
+// a level from 1 (urgent) to 4 (low), or a name.
+func templatePriority(v interface{}) string {
+	if m, ok := v.(map[string]interface{}); ok {
+		return templatePriority(m["priority"])
+	}
+	if rv := indirect(v); rv.IsValid() && rv.Kind() == reflect.Struct {
+		if f := rv.FieldByName("Priority"); f.IsValid() {
+			return templatePriority(f.Interface())
+		}
+		return ""
+	}
+	if n, ok := toInt(v); ok {
+		return text.PriorityName(int(n))
+	}
+	s := toString(v)
+	if s == "" {
+		return ""
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value
+// for nil.
+func indirect(v interface{}) reflect.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// toTime reads a timestamp in any form ClickUp types use: Unix
+// milliseconds as a number or string, an RFC 3339 string, a
+// clickup.Date or a time.Time.
+func toTime(v interface{}) (time.Time, bool) {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return time.Time{}, false
+	}
+	switch x := rv.Interface().(type) {
+	case time.Time:
+		return x, !x.IsZero()
+	case clickup.Date:
+		if t := x.Time(); t != nil {
+			return *t, true
+		}
+		return time.Time{}, false
+	case string:
+		if t, err := time.Parse(time.RFC3339, x); err == nil {
+			return t, true
+		}
+	}
+	ms, ok := toInt(v)
+	if !ok || ms <= 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms), true
+}
+
+// toInt reads an integer from a number or a numeric string.
+func toInt(v interface{}) (int64, bool) {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return 0, false
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), true
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, true
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return int64(f), true
+		}
+	}
+	return 0, false
+}
+
+// toString formats a template value, dereferencing pointers and showing
+// nil as empty.
+func toString(v interface{}) string {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return ""
+	}
+	switch x := rv.Interface().(type) {
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	case fmt.Stringer:
+		return x.String()
+	}
+	if rv.Kind() == reflect.Struct {
+		for _, field := range []string{"Name", "Username", "Status"} {
+			if f := rv.FieldByName(field); f.IsValid() {
+				return toString(f.Interface())
+			}
+		}
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+// toStrings formats each element of a slice. Tags, users and other
+// objects are shown by their name, and maps decoded from JSON by their
+// "name" or "username" key.
+func toStrings(v interface{}) []string {
+	rv := indirect(v)
+	if !rv.IsValid() {
+		return nil
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []string{toString(v)}
+	}
+	out := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i).Interface()
+		if m, ok := elem.(map[string]interface{}); ok {
+			for _, key := range []string{"name", "username", "status"} {
+				if s, ok := m[key].(string); ok {
+					elem = s
+					break
+				}
+			}
+		}
+		out = append(out, toString(elem))
+	}
+	return out
+}
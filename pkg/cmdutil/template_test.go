@@ -0,0 +1,83 @@
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/clickup"
+)
+
+func render(t *testing.T, tmpl string, data interface{}) string {
+	t.Helper()
+	var buf bytes.Buffer
+	flags := &JSONFlags{Template: tmpl}
+	require.NoError(t, flags.OutputJSON(&buf, data))
+	return buf.String()
+}
+
+func TestTemplate_Helpers(t *testing.T) {
+	var due clickup.Date
+	require.NoError(t, json.Unmarshal([]byte(`"1700000000000"`), &due))
+	task := clickup.Task{
+		Name:         "Fix the login flow",
+		Priority:     clickup.TaskPriority{Priority: "urgent"},
+		DueDate:      &due,
+		DateCreated:  "1700000000000",
+		TimeEstimate: 5400000,
+		Tags:         []clickup.Tag{{Name: "auth"}, {Name: "bug"}},
+		Assignees:    []clickup.User{{Username: "ana"}},
+	}
+
+	cases := map[string]string{
+		`{{.Name | truncate 12}}`:                                         "Fix the l...",
+		`{{priority .Priority}}`:                                          "Urgent",
+		`{{priority 2}}`:                                                  "High",
+		`{{.DueDate | date "2006-01-02"}}`:                                time.UnixMilli(1700000000000).Format("2006-01-02"),
+		`{{.DateCreated | date "Jan 02"}}`:                                time.UnixMilli(1700000000000).Format("Jan 02"),
+		`{{.TimeEstimate | duration}}`:                                    "1h 30m",
+		`{{.Tags | join ", "}}`:                                           "auth, bug",
+		`{{.Assignees | join "/"}}`:                                       "ana",
+		`{{pluralize (len .Tags) "tag"}}`:                                 "2 tags",
+		`{{.Name | color "green"}}`:                                       "Fix the login flow",
+		`{{mdlink "a|b [x]" "https://x.test"}}`:                           `[a\|b \[x\]](https://x.test)`,
+		`{{"two\nlines_" | mdescape}}`:                                    `two lines\_`,
+		`{{tablerow "ID" "NAME"}}{{tablerow "1" "x"}}{{tablerender}}done`: "ID  NAME\n1   x\ndone",
+	}
+	for tmpl, want := range cases {
+		assert.Equal(t, want, render(t, tmpl, task), tmpl)
+	}
+	assert.Contains(t, render(t, `{{.DueDate | timeago}}`, task), "ago")
+}
+
+func TestTemplate_JSONMaps(t *testing.T) {
+	var data []interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name": "a", "priority": {"priority": "low"}, "tags": [{"name": "x"}, {"name": "y"}], "date_updated": "1700000000000"}
+	]`), &data))
+
+	got := render(t, `{{range .}}{{.name}} {{priority .priority}} {{join "," .tags}} {{date "2006" .date_updated}}{{end}}`, data)
+	assert.Equal(t, "a Low x,y "+time.UnixMilli(1700000000000).Format("2006"), got)
+}
+
+func TestTemplate_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "standup.tmpl")
+	require.NoError(t, os.WriteFile(path, []byte("{{range .}}- {{.}}\n{{end}}"), 0o644))
+
+	assert.Equal(t, "- a\n- b\n", render(t, "@"+path, []string{"a", "b"}))
+
+	err := (&JSONFlags{Template: "@" + path + ".missing"}).OutputJSON(&bytes.Buffer{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read template")
+}
+
+func TestTemplate_UnknownColor(t *testing.T) {
+	err := (&JSONFlags{Template: `{{"x" | color "purple"}}`}).OutputJSON(&bytes.Buffer{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown color "purple"`)
+}
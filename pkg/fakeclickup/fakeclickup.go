@@ -0,0 +1,686 @@
+// Package fakeclickup is a stateful, in-memory fake of the ClickUp v2 and v3
+// APIs for tests and local development.
+//
+// A Server holds workspaces, spaces, folders, lists, tasks, comments, time
+// entries, tags, custom fields and docs, and serves them over HTTP with the
+// same paths and wire shapes as api.clickup.com. Writes change the state, so
+// a create followed by an edit and a view round-trips just as it would
+// against a real workspace — without network access.
+//
+//	fake := fakeclickup.New()
+//	ws := fake.AddWorkspace(fakeclickup.Team{ID: "12345", Name: "Acme"})
+//	space := fake.AddSpace(ws.ID, fakeclickup.Space{Name: "Engineering"})
+//	list := fake.AddList(space.ID, "", fakeclickup.List{Name: "Backlog"})
+//
+//	srv := httptest.NewServer(fake)
+//	defer srv.Close()
+//	// Point a client at srv.URL + "/api/v2" (or "/api/v3" for docs).
+//
+// Tasks and hierarchy objects are the fake's own types, shaped like ClickUp's
+// responses, and docs and pages use the generated clickupv3 types. Request
+// bodies are decoded into the generated clickupv2 and clickupv3 types, or
+// the fake's own where the spec lacks one. The CLI's internal model is not
+// used, so the fake checks the wire format rather than mirroring it. Only the
+// endpoints the CLI uses are implemented; anything else returns 404 with a
+// ClickUp-style error body.
+package fakeclickup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/triptechtravel/clickup-cli/api/clickupv3"
+)
+
+// Docs and pages are served as the generated v3 types.
+type (
+	Doc  = clickupv3.PublicDocsDocDto
+	Page = clickupv3.PublicDocsPageV3Dto
+)
+
+// Comment is a task comment or a threaded reply.
+type Comment struct {
+	ID          string `json:"id"`
+	CommentText string `json:"comment_text"`
+	User        User   `json:"user"`
+	Date        string `json:"date"`
+	ReplyCount  int    `json:"reply_count"`
+
+	// TaskID and ParentID locate the comment; they are not part of the
+	// wire format. ParentID is set for threaded replies.
+	TaskID   string `json:"-"`
+	ParentID string `json:"-"`
+}
+
+// TimeEntry is a tracked time entry. A running timer has End == 0.
+type TimeEntry struct {
+	ID          string
+	TaskID      string
+	Description string
+	Start       int64 // unix ms
+	End         int64 // unix ms; 0 while running
+	Billable    bool
+	User        User
+	Tags        []string
+}
+
+// Duration returns the entry's length in milliseconds. Like the real API, a
+// running entry reports a negative duration (minus its start time).
+func (e TimeEntry) Duration() int64 {
+	if e.End == 0 {
+		return -e.Start
+	}
+	return e.End - e.Start
+}
+
+// DefaultStatuses are given to spaces created without statuses.
+var DefaultStatuses = []string{"to do", "in progress", "complete"}
+
+// Server is an in-memory ClickUp. It implements http.Handler; mount it on an
+// httptest.Server or any listener. All methods are safe for concurrent use.
+type Server struct {
+	// Token, when set, is the only Authorization header value accepted.
+	// Otherwise any non-empty token is accepted.
+	Token string
+
+	// Now returns the current time. Replace it for deterministic timestamps.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	seq      int
+	user     User
+	teams    []*Team
+	spaces   []*spaceState
+	folders  []*Folder
+	lists    []*listState
+	tasks    []*taskState
+	comments []*Comment
+	entries  []*TimeEntry
+	docs     []*docState
+
+	mux *http.ServeMux
+}
+
+type spaceState struct {
+	Space
+	teamID string
+	tags   []Tag
+}
+
+type listState struct {
+	List
+	fields []CustomField
+}
+
+type taskState struct {
+	Task
+	extraLists []string
+	values     map[string]any
+}
+
+type docState struct {
+	Doc
+	workspaceID string
+	pages       []*pageState
+}
+
+type pageState struct {
+	Page
+	parentID string
+}
+
+// New returns an empty fake server. The authenticated user is
+// {ID: 1, Username: "Fake User"}; use SetUser to change it.
+func New() *Server {
+	s := &Server{
+		Now:  time.Now,
+		user: User{ID: 1, Username: "Fake User", Email: "fake@example.com", Initials: "FU"},
+	}
+	s.routes()
+	return s
+}
+
+// ServeHTTP serves the ClickUp API under /api/v2 and /api/v3.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if token := r.Header.Get("Authorization"); token == "" || (s.Token != "" && token != s.Token) {
+		// No ECODE, so the CLI reports it as an expired token.
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"err": "Token invalid"})
+		return
+	}
+	// ClickUp tolerates trailing slashes, and the CLI sends some.
+	if p := r.URL.Path; len(p) > 1 && strings.HasSuffix(p, "/") {
+		r2 := r.Clone(r.Context())
+		r2.URL.Path = strings.TrimRight(p, "/")
+		r2.URL.RawPath = ""
+		r = r2
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// SetUser replaces the authenticated user, who is reported by GET /user and
+// recorded as the creator of new objects.
+func (s *Server) SetUser(u User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = u
+}
+
+// --- Seeding ---
+
+// AddWorkspace adds a workspace. The authenticated user is added as a
+// member if t has no members. An empty ID is assigned.
+func (s *Server) AddWorkspace(t Team) Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.ID == "" {
+		t.ID = s.nextID()
+	}
+	if len(t.Members) == 0 {
+		t.Members = []TeamMember{{User: TeamUser{
+			ID: s.user.ID, Username: s.user.Username, Email: s.user.Email, Initials: s.user.Initials,
+		}}}
+	}
+	s.teams = append(s.teams, &t)
+	return t
+}
+
+// AddSpace adds a space to a workspace. Spaces without statuses get
+// DefaultStatuses. An empty ID is assigned.
+func (s *Server) AddSpace(teamID string, sp Space) Space {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addSpace(teamID, sp).Space
+}
+
+// AddFolder adds a folder to a space. An empty ID is assigned.
+func (s *Server) AddFolder(spaceID string, f Folder) Folder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addFolder(spaceID, f)
+}
+
+// AddList adds a list to a folder, or directly to the space when folderID
+// is empty. The list inherits the space's statuses unless it has its own.
+// An empty ID is assigned.
+func (s *Server) AddList(spaceID, folderID string, l List) List {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addList(spaceID, folderID, l).List
+}
+
+// AddField adds a custom field to a list. An empty ID is assigned.
+func (s *Server) AddField(listID string, f CustomField) CustomField {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.list(listID)
+	if l == nil {
+		panic("fakeclickup: AddField: no list " + listID)
+	}
+	if f.ID == "" {
+		f.ID = s.nextUUID()
+	}
+	if f.DateCreated == "" {
+		f.DateCreated = s.stamp()
+	}
+	f.Value = nil
+	l.fields = append(l.fields, f)
+	return f
+}
+
+// AddTag adds a tag to a space's tag list.
+func (s *Server) AddTag(spaceID string, t Tag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sp := s.space(spaceID)
+	if sp == nil {
+		panic("fakeclickup: AddTag: no space " + spaceID)
+	}
+	sp.tags = append(sp.tags, t)
+}
+
+// AddTask adds a task to a list. Unset status, creator and timestamps are
+// filled in. Empty IDs are assigned to the task and its checklists.
+func (s *Server) AddTask(listID string, t Task) Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts, err := s.addTask(listID, t)
+	if err != nil {
+		panic("fakeclickup: AddTask: " + err.Error())
+	}
+	return s.render(ts)
+}
+
+// AddComment adds a comment to a task, by the authenticated user.
+func (s *Server) AddComment(taskID, text string) Comment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addComment(taskID, "", text)
+}
+
+// AddTimeEntry adds a time entry. An empty ID is assigned.
+func (s *Server) AddTimeEntry(e TimeEntry) TimeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e.ID == "" {
+		e.ID = s.nextID()
+	}
+	if e.User.ID == 0 {
+		e.User = s.user
+	}
+	s.entries = append(s.entries, &e)
+	return e
+}
+
+// AddDoc adds a doc to a workspace. An empty ID is assigned.
+func (s *Server) AddDoc(workspaceID string, d Doc) Doc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addDoc(workspaceID, d).Doc
+}
+
+// AddPage adds a page to a doc, nested under parentPageID if non-empty.
+// An empty ID is assigned.
+func (s *Server) AddPage(docID, parentPageID string, p Page) Page {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.doc(docID)
+	if d == nil {
+		panic("fakeclickup: AddPage: no doc " + docID)
+	}
+	return s.addPage(d, parentPageID, p).Page
+}
+
+// --- Inspection ---
+
+// Task returns a task as GET /task/{id} would, with custom field values.
+func (s *Server) Task(id string) (Task, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(id, false)
+	if t == nil {
+		return Task{}, false
+	}
+	return s.render(t), true
+}
+
+// Tasks returns every task in creation order.
+func (s *Server) Tasks() []Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Task, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		out = append(out, s.render(t))
+	}
+	return out
+}
+
+// Comments returns the top-level comments on a task, oldest first.
+func (s *Server) Comments(taskID string) []Comment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Comment
+	for _, c := range s.comments {
+		if c.TaskID == taskID && c.ParentID == "" {
+			out = append(out, *c)
+		}
+	}
+	return out
+}
+
+// TimeEntries returns every time entry, including a running timer.
+func (s *Server) TimeEntries() []TimeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]TimeEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		out = append(out, *e)
+	}
+	return out
+}
+
+// Page returns a doc page.
+func (s *Server) Page(docID, pageID string) (Page, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.doc(docID)
+	if d == nil {
+		return Page{}, false
+	}
+	p := d.page(pageID)
+	if p == nil {
+		return Page{}, false
+	}
+	return p.Page, true
+}
+
+// --- State helpers; callers hold s.mu ---
+
+func (s *Server) nextID() string {
+	s.seq++
+	return strconv.Itoa(900000 + s.seq)
+}
+
+// nextTaskID returns an alphanumeric ID shaped like ClickUp's task IDs.
+func (s *Server) nextTaskID() string {
+	s.seq++
+	return fmt.Sprintf("86f%05x", s.seq)
+}
+
+func (s *Server) nextUUID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.seq)
+}
+
+func (s *Server) stamp() string {
+	return strconv.FormatInt(s.Now().UnixMilli(), 10)
+}
+
+func (s *Server) team(id string) *Team {
+	for _, t := range s.teams {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) space(id string) *spaceState {
+	for _, sp := range s.spaces {
+		if sp.ID == id {
+			return sp
+		}
+	}
+	return nil
+}
+
+func (s *Server) folder(id string) *Folder {
+	for _, f := range s.folders {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) list(id string) *listState {
+	for _, l := range s.lists {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+// task looks a task up by ID, or by custom ID when custom is true.
+func (s *Server) task(id string, custom bool) *taskState {
+	for _, t := range s.tasks {
+		if custom && t.CustomID == id || !custom && t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) comment(id string) *Comment {
+	for _, c := range s.comments {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) doc(id string) *docState {
+	for _, d := range s.docs {
+		if d.ID == id {
+			return d
+		}
+	}
+	return nil
+}
+
+func (d *docState) page(id string) *pageState {
+	for _, p := range d.pages {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// teamOfSpace returns the workspace a space belongs to.
+func (s *Server) teamOfSpace(spaceID string) string {
+	if sp := s.space(spaceID); sp != nil {
+		return sp.teamID
+	}
+	return ""
+}
+
+func (s *Server) addSpace(teamID string, sp Space) *spaceState {
+	if sp.ID == "" {
+		sp.ID = s.nextID()
+	}
+	if len(sp.Statuses) == 0 {
+		for i, name := range DefaultStatuses {
+			typ := "custom"
+			switch i {
+			case 0:
+				typ = "open"
+			case len(DefaultStatuses) - 1:
+				typ = "closed"
+			}
+			sp.Statuses = append(sp.Statuses, Status{ID: "st" + sp.ID + "_" + strconv.Itoa(i), Status: name, Type: typ, Orderindex: i, Color: "#87909e"})
+		}
+	}
+	st := &spaceState{Space: sp, teamID: teamID}
+	s.spaces = append(s.spaces, st)
+	return st
+}
+
+func (s *Server) addFolder(spaceID string, f Folder) *Folder {
+	sp := s.space(spaceID)
+	if sp == nil {
+		panic("fakeclickup: no space " + spaceID)
+	}
+	if f.ID == "" {
+		f.ID = s.nextID()
+	}
+	f.Space.ID, f.Space.Name = sp.ID, sp.Name
+	s.folders = append(s.folders, &f)
+	return &f
+}
+
+func (s *Server) addList(spaceID, folderID string, l List) *listState {
+	sp := s.space(spaceID)
+	if sp == nil {
+		panic("fakeclickup: no space " + spaceID)
+	}
+	if l.ID == "" {
+		l.ID = s.nextID()
+	}
+	l.Space.ID, l.Space.Name, l.Space.Access = sp.ID, sp.Name, true
+	if folderID != "" {
+		f := s.folder(folderID)
+		if f == nil {
+			panic("fakeclickup: no folder " + folderID)
+		}
+		l.Folder.ID, l.Folder.Name, l.Folder.Access = f.ID, f.Name, true
+	} else {
+		l.Folder.ID, l.Folder.Name, l.Folder.Hidden, l.Folder.Access = "none", "hidden", true, true
+	}
+	if len(l.Statuses) == 0 {
+		for _, st := range sp.Statuses {
+			st.ID = ""
+			l.Statuses = append(l.Statuses, st)
+		}
+	}
+	ls := &listState{List: l}
+	s.lists = append(s.lists, ls)
+	return ls
+}
+
+func (s *Server) addTask(listID string, t Task) (*taskState, error) {
+	l := s.list(listID)
+	if l == nil {
+		return nil, fmt.Errorf("no list %s", listID)
+	}
+	if t.ID == "" {
+		t.ID = s.nextTaskID()
+	}
+	now := s.stamp()
+	if t.DateCreated == "" {
+		t.DateCreated = now
+	}
+	if t.DateUpdated == "" {
+		t.DateUpdated = t.DateCreated
+	}
+	if t.Creator.ID == 0 {
+		t.Creator = s.user
+	}
+	if t.Status.Status == "" {
+		t.Status = l.status("")
+	}
+	t.List = Location{ID: l.ID, Name: l.Name, Access: true}
+	t.Folder = Location{ID: l.Folder.ID, Name: l.Folder.Name, Hidden: l.Folder.Hidden, Access: true}
+	t.Space = Location{ID: l.Space.ID}
+	t.Checklists = slices.Clone(t.Checklists)
+	for i := range t.Checklists {
+		cl := &t.Checklists[i]
+		if cl.ID == "" {
+			cl.ID = s.nextUUID()
+		}
+		cl.TaskID = t.ID
+		cl.Items = slices.Clone(cl.Items)
+		for j := range cl.Items {
+			if cl.Items[j].ID == "" {
+				cl.Items[j].ID = s.nextUUID()
+			}
+		}
+	}
+	t.TeamID = s.teamOfSpace(l.Space.ID)
+	t.URL = "https://app.clickup.com/t/" + t.ID
+	t.Orderindex = len(s.tasks) + 1
+	ts := &taskState{Task: t, values: map[string]any{}}
+	s.tasks = append(s.tasks, ts)
+	return ts, nil
+}
+
+// status resolves a status name against the list's statuses, case
+// insensitively. An empty name selects the first (open) status.
+func (l *listState) status(name string) Status {
+	for i, st := range l.Statuses {
+		if name == "" && i == 0 || strings.EqualFold(st.Status, name) {
+			st.ID = "st" + l.ID + "_" + strconv.Itoa(i)
+			return st
+		}
+	}
+	return Status{}
+}
+
+func (s *Server) addComment(taskID, parentID, text string) *Comment {
+	c := &Comment{
+		ID:          s.nextID(),
+		CommentText: text,
+		User:        s.user,
+		Date:        s.stamp(),
+		TaskID:      taskID,
+		ParentID:    parentID,
+	}
+	s.comments = append(s.comments, c)
+	if parentID != "" {
+		if p := s.comment(parentID); p != nil {
+			p.ReplyCount++
+		}
+	}
+	return c
+}
+
+func (s *Server) addDoc(workspaceID string, d Doc) *docState {
+	if d.ID == "" {
+		s.seq++
+		d.ID = "8cf" + strconv.FormatInt(int64(s.seq), 36)
+	}
+	if d.DateCreated == 0 {
+		d.DateCreated = float32(s.Now().UnixMilli())
+	}
+	ds := &docState{Doc: d, workspaceID: workspaceID}
+	s.docs = append(s.docs, ds)
+	return ds
+}
+
+func (s *Server) addPage(d *docState, parentID string, p Page) *pageState {
+	if p.ID == "" {
+		s.seq++
+		p.ID = fmt.Sprintf("%s-%d", d.ID, s.seq)
+	}
+	p.DocID = d.ID
+	if p.DateCreated == 0 {
+		p.DateCreated = float32(s.Now().UnixMilli())
+	}
+	if len(p.Authors) == 0 {
+		p.Authors = []float32{float32(s.user.ID)}
+	}
+	ps := &pageState{Page: p, parentID: parentID}
+	d.pages = append(d.pages, ps)
+	return ps
+}
+
+// render returns a task as served: custom fields from its list with the
+// task's values filled in, and its checklists with their counts.
+func (s *Server) render(t *taskState) Task {
+	out := t.Task
+	out.CustomFields = []CustomField{}
+	if l := s.list(t.List.ID); l != nil {
+		for _, f := range l.fields {
+			f.Value = t.values[f.ID]
+			out.CustomFields = append(out.CustomFields, f)
+		}
+	}
+	out.Checklists = make([]Checklist, 0, len(t.Checklists))
+	for _, cl := range t.Checklists {
+		out.Checklists = append(out.Checklists, renderChecklist(cl))
+	}
+	out.Tags = append([]Tag(nil), t.Tags...)
+	out.Assignees = append([]User(nil), t.Assignees...)
+	return out
+}
+
+// --- HTTP helpers ---
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-RateLimit-Limit", "100")
+	w.Header().Set("X-RateLimit-Remaining", "99")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeError writes a ClickUp-style error body.
+func writeError(w http.ResponseWriter, status int, code, format string, args ...any) {
+	writeJSON(w, status, map[string]string{"err": fmt.Sprintf(format, args...), "ECODE": code})
+}
+
+func notFound(w http.ResponseWriter, kind, code string) {
+	writeError(w, http.StatusNotFound, code, "%s not found", kind)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "INPUT_001", "Invalid JSON body: %v", err)
+		return false
+	}
+	return true
+}
+
+func queryBool(r *http.Request, key string) bool {
+	v, _ := strconv.ParseBool(r.URL.Query().Get(key))
+	return v
+}
@@ -0,0 +1,260 @@
+package fakeclickup_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/triptechtravel/clickup-cli/internal/testutil"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/comment"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/doc"
+	"github.com/triptechtravel/clickup-cli/pkg/cmd/task"
+	"github.com/triptechtravel/clickup-cli/pkg/fakeclickup"
+)
+
+func do(t *testing.T, srv *httptest.Server, method, path, body string) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "pk_test")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	var out map[string]any
+	json.Unmarshal(data, &out)
+	return resp.StatusCode, out
+}
+
+func newServer(t *testing.T) (*fakeclickup.Server, *httptest.Server, fakeclickup.List) {
+	fake := fakeclickup.New()
+	fake.Now = func() time.Time { return time.UnixMilli(1700000000000) }
+	ws := fake.AddWorkspace(fakeclickup.Team{ID: "1", Name: "Acme"})
+	space := fake.AddSpace(ws.ID, fakeclickup.Space{Name: "Eng"})
+	list := fake.AddList(space.ID, "", fakeclickup.List{Name: "Backlog"})
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return fake, srv, list
+}
+
+func TestServer_RequiresToken(t *testing.T) {
+	_, srv, _ := newServer(t)
+	resp, err := http.Get(srv.URL + "/api/v2/user")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServer_TaskLifecycle(t *testing.T) {
+	fake, srv, list := newServer(t)
+
+	status, created := do(t, srv, "POST", "/api/v2/list/"+list.ID+"/task", `{"name":"Fix login","priority":2,"start_date":"1699000000000"}`)
+	require.Equal(t, 200, status)
+	id := created["id"].(string)
+	assert.Equal(t, "1699000000000", created["start_date"], "dates may be sent as strings")
+	assert.Equal(t, "to do", created["status"].(map[string]any)["status"])
+	assert.Equal(t, "high", created["priority"].(map[string]any)["priority"])
+
+	status, _ = do(t, srv, "PUT", "/api/v2/task/"+id+"/", `{"status":"COMPLETE","due_date":1700000000000}`)
+	require.Equal(t, 200, status)
+
+	// Closed tasks are hidden unless include_closed is set.
+	_, page := do(t, srv, "GET", "/api/v2/list/"+list.ID+"/task", "")
+	assert.Empty(t, page["tasks"])
+	_, page = do(t, srv, "GET", "/api/v2/list/"+list.ID+"/task?include_closed=true", "")
+	assert.Len(t, page["tasks"], 1)
+	assert.Equal(t, true, page["last_page"])
+
+	status, _ = do(t, srv, "PUT", "/api/v2/task/"+id, `{"due_date":null,"priority":null}`)
+	require.Equal(t, 200, status)
+	got, ok := fake.Task(id)
+	require.True(t, ok)
+	assert.Empty(t, got.DueDate)
+	assert.Empty(t, got.Priority.Priority)
+	assert.Equal(t, "1700000000000", got.DateClosed)
+
+	status, body := do(t, srv, "PUT", "/api/v2/task/"+id, `{"status":"nope"}`)
+	assert.Equal(t, 400, status)
+	assert.Contains(t, body["err"], "Status does not exist")
+
+	status, _ = do(t, srv, "DELETE", "/api/v2/task/"+id, "")
+	assert.Equal(t, 204, status)
+	status, body = do(t, srv, "GET", "/api/v2/task/"+id, "")
+	assert.Equal(t, 404, status)
+	assert.Equal(t, "ITEM_015", body["ECODE"])
+}
+
+func TestServer_TeamTaskFilters(t *testing.T) {
+	fake, srv, list := newServer(t)
+	other := fake.AddList(list.Space.ID, "", fakeclickup.List{Name: "Other"})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "a", Tags: []fakeclickup.Tag{{Name: "bug"}}})
+	fake.AddTask(list.ID, fakeclickup.Task{Name: "b"})
+	fake.AddTask(other.ID, fakeclickup.Task{Name: "c", Tags: []fakeclickup.Tag{{Name: "bug"}}})
+
+	names := func(path string) []string {
+		_, body := do(t, srv, "GET", path, "")
+		var out []string
+		for _, task := range body["tasks"].([]any) {
+			out = append(out, task.(map[string]any)["name"].(string))
+		}
+		return out
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names("/api/v2/team/1/task"))
+	assert.Equal(t, []string{"a", "c"}, names("/api/v2/team/1/task?tags[]=bug"))
+	assert.Equal(t, []string{"c"}, names("/api/v2/team/1/task?tags[]=bug&list_ids[]="+other.ID))
+}
+
+func TestServer_CustomFieldDropdown(t *testing.T) {
+	fake, srv, list := newServer(t)
+	field := fake.AddField(list.ID, fakeclickup.CustomField{
+		Name: "Env",
+		Type: "drop_down",
+		TypeConfig: map[string]any{"options": []map[string]any{
+			{"id": "opt-a", "name": "staging", "orderindex": 0},
+			{"id": "opt-b", "name": "prod", "orderindex": 1},
+		}},
+	})
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Deploy"})
+
+	status, _ := do(t, srv, "POST", "/api/v2/task/"+task.ID+"/field/"+field.ID, `{"value":"opt-b"}`)
+	require.Equal(t, 200, status)
+
+	got, _ := fake.Task(task.ID)
+	require.Len(t, got.CustomFields, 1)
+	assert.EqualValues(t, 1, got.CustomFields[0].Value)
+}
+
+func TestServer_Checklists(t *testing.T) {
+	fake, srv, list := newServer(t)
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Release"})
+
+	status, body := do(t, srv, "POST", "/api/v2/task/"+task.ID+"/checklist", `{"name":"Launch"}`)
+	require.Equal(t, 200, status)
+	clID := body["checklist"].(map[string]any)["id"].(string)
+	for _, name := range []string{"Docs", "Tests"} {
+		status, _ = do(t, srv, "POST", "/api/v2/checklist/"+clID+"/checklist_item", `{"name":"`+name+`"}`)
+		require.Equal(t, 200, status)
+	}
+
+	got, _ := fake.Task(task.ID)
+	require.Len(t, got.Checklists, 1)
+	cl := got.Checklists[0]
+	assert.Equal(t, task.ID, cl.TaskID)
+	require.Len(t, cl.Items, 2)
+	assert.Equal(t, 2, cl.Unresolved)
+
+	status, _ = do(t, srv, "PUT", "/api/v2/checklist/"+clID+"/checklist_item/"+cl.Items[1].ID, `{"resolved":true}`)
+	require.Equal(t, 200, status)
+	status, _ = do(t, srv, "DELETE", "/api/v2/checklist/"+clID+"/checklist_item/"+cl.Items[0].ID, "")
+	require.Equal(t, 200, status)
+	got, _ = fake.Task(task.ID)
+	require.Len(t, got.Checklists[0].Items, 1)
+	assert.True(t, got.Checklists[0].Items[0].Resolved)
+	assert.Equal(t, 1, got.Checklists[0].Resolved)
+
+	status, _ = do(t, srv, "DELETE", "/api/v2/checklist/"+clID, "")
+	require.Equal(t, 200, status)
+	got, _ = fake.Task(task.ID)
+	assert.Empty(t, got.Checklists)
+	status, _ = do(t, srv, "DELETE", "/api/v2/checklist/"+clID, "")
+	assert.Equal(t, 404, status)
+}
+
+func TestServer_TimerStartStop(t *testing.T) {
+	fake, srv, list := newServer(t)
+	task := fake.AddTask(list.ID, fakeclickup.Task{Name: "Work"})
+
+	status, body := do(t, srv, "POST", "/api/v2/team/1/time_entries/start", `{"tid":"`+task.ID+`","description":"pairing"}`)
+	require.Equal(t, 200, status)
+	assert.EqualValues(t, -1700000000000, body["data"].(map[string]any)["duration"])
+
+	fake.Now = func() time.Time { return time.UnixMilli(1700000060000) }
+	status, body = do(t, srv, "POST", "/api/v2/team/1/time_entries/stop", "")
+	require.Equal(t, 200, status)
+	assert.EqualValues(t, 60000, body["data"].(map[string]any)["duration"])
+
+	_, body = do(t, srv, "GET", "/api/v2/team/1/time_entries?task_id="+task.ID, "")
+	entries := body["data"].([]any)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "60000", entries[0].(map[string]any)["duration"])
+}
+
+// The round trips below drive the real commands against the fake through
+// testutil.TestFactory.Fake.
+
+func TestRoundTrip_TaskCreateEditView(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+
+	err := testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "create", "--list-id", list.ID, "--name", "Fix login", "--priority", "3")
+	require.NoError(t, err)
+	tasks := fake.Tasks()
+	require.Len(t, tasks, 1)
+	id := tasks[0].ID
+
+	err = testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "edit", id, "--name", "Fix login timeout", "--status", "in progress", "--add-tags", "auth")
+	require.NoError(t, err)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, task.NewCmdTask(tf.Factory), "view", id, "--json")
+	require.NoError(t, err)
+
+	var viewed fakeclickup.Task
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &viewed))
+	assert.Equal(t, "Fix login timeout", viewed.Name)
+	assert.Equal(t, "in progress", viewed.Status.Status)
+	assert.Equal(t, "normal", viewed.Priority.Priority)
+	require.Len(t, viewed.Tags, 1)
+	assert.Equal(t, "auth", viewed.Tags[0].Name)
+}
+
+func TestRoundTrip_Comments(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+	list := fake.AddList("67890", "", fakeclickup.List{Name: "Backlog"})
+	tk := fake.AddTask(list.ID, fakeclickup.Task{Name: "Discuss"})
+
+	err := testutil.RunCommand(t, comment.NewCmdComment(tf.Factory), "add", tk.ID, "Looks good to me")
+	require.NoError(t, err)
+	require.Len(t, fake.Comments(tk.ID), 1)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, comment.NewCmdComment(tf.Factory), "list", tk.ID)
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "Looks good to me")
+}
+
+func TestRoundTrip_DocPages(t *testing.T) {
+	tf := testutil.NewTestFactory(t)
+	fake := tf.Fake()
+
+	err := testutil.RunCommand(t, doc.NewCmdDoc(tf.Factory), "create", "--name", "Runbook", "--create-page=false", "--json")
+	require.NoError(t, err)
+	var d fakeclickup.Doc
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &d))
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, doc.NewCmdDoc(tf.Factory), "page", "create", d.ID, "--name", "Deploys", "--content", "Step 1", "--json")
+	require.NoError(t, err)
+	var p fakeclickup.Page
+	require.NoError(t, json.Unmarshal(tf.OutBuf.Bytes(), &p))
+
+	err = testutil.RunCommand(t, doc.NewCmdDoc(tf.Factory), "page", "edit", d.ID, p.ID, "--content", "\nStep 2", "--content-edit-mode", "append")
+	require.NoError(t, err)
+
+	got, ok := fake.Page(d.ID, p.ID)
+	require.True(t, ok)
+	assert.Equal(t, "Step 1\nStep 2", got.Content)
+
+	tf.OutBuf.Reset()
+	err = testutil.RunCommand(t, doc.NewCmdDoc(tf.Factory), "page", "view", d.ID, p.ID)
+	require.NoError(t, err)
+	assert.Contains(t, tf.OutBuf.String(), "Deploys")
+}
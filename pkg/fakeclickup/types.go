@@ -0,0 +1,182 @@
+package fakeclickup
+
+import "encoding/json"
+
+// User is a ClickUp user, as reported by GET /user and as a task's creator
+// or assignee.
+type User struct {
+	ID             int    `json:"id"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	Color          string `json:"color"`
+	ProfilePicture string `json:"profilePicture,omitempty"`
+	Initials       string `json:"initials"`
+}
+
+// Team is a workspace. ClickUp's v2 API calls workspaces teams.
+type Team struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Color   string       `json:"color"`
+	Members []TeamMember `json:"members"`
+}
+
+// TeamMember is a member of a workspace.
+type TeamMember struct {
+	User TeamUser `json:"user"`
+}
+
+// TeamUser is a workspace member's user, with their role.
+type TeamUser struct {
+	ID             int    `json:"id"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	Color          string `json:"color"`
+	ProfilePicture string `json:"profilePicture,omitempty"`
+	Initials       string `json:"initials"`
+	Role           int    `json:"role"`
+}
+
+// Status is a workflow status. Spaces and lists carry their statuses, and a
+// task carries the one it is in.
+type Status struct {
+	ID         string `json:"id,omitempty"`
+	Status     string `json:"status"`
+	Type       string `json:"type"`
+	Orderindex int    `json:"orderindex"`
+	Color      string `json:"color"`
+}
+
+// Priority is a task priority.
+type Priority struct {
+	Priority string `json:"priority"`
+	Color    string `json:"color"`
+}
+
+// Location identifies the space, folder or list an object belongs to.
+// Lists directly in a space belong to a hidden folder with ID "none".
+type Location struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Hidden bool   `json:"hidden,omitempty"`
+	Access bool   `json:"access"`
+}
+
+// Space is a space in a workspace.
+type Space struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Private           bool     `json:"private"`
+	Statuses          []Status `json:"statuses"`
+	MultipleAssignees bool     `json:"multiple_assignees"`
+	Archived          bool     `json:"archived"`
+}
+
+// Folder is a folder in a space. Space, TaskCount and Lists are filled in
+// when the folder is served.
+type Folder struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Orderindex int      `json:"orderindex"`
+	Hidden     bool     `json:"hidden"`
+	Space      Location `json:"space"`
+	TaskCount  int      `json:"task_count"`
+	Archived   bool     `json:"archived"`
+	Lists      []List   `json:"lists"`
+}
+
+// List is a list in a folder or directly in a space. Folder, Space and
+// TaskCount are filled in when the list is served.
+type List struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Orderindex int      `json:"orderindex"`
+	Content    string   `json:"content"`
+	TaskCount  int      `json:"task_count"`
+	DueDate    string   `json:"due_date,omitempty"`
+	StartDate  string   `json:"start_date,omitempty"`
+	Folder     Location `json:"folder"`
+	Space      Location `json:"space"`
+	Statuses   []Status `json:"statuses"`
+	Archived   bool     `json:"archived"`
+}
+
+// Tag is a space tag, or a tag on a task.
+type Tag struct {
+	Name    string `json:"name"`
+	TagFg   string `json:"tag_fg"`
+	TagBg   string `json:"tag_bg"`
+	Creator int    `json:"creator,omitempty"`
+}
+
+// CustomField is a list's custom field. On a task, Value holds the task's
+// value in the form ClickUp returns it.
+type CustomField struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	TypeConfig     any    `json:"type_config"`
+	DateCreated    string `json:"date_created"`
+	HideFromGuests bool   `json:"hide_from_guests"`
+	Value          any    `json:"value"`
+}
+
+// Task is a task. Dates are unix milliseconds as strings, as ClickUp sends
+// them; List, Folder, Space, TeamID and URL are filled in from the list the
+// task is added to.
+type Task struct {
+	ID                  string        `json:"id"`
+	CustomID            string        `json:"custom_id"`
+	CustomItemID        int           `json:"custom_item_id"`
+	Name                string        `json:"name"`
+	TextContent         string        `json:"text_content"`
+	Description         string        `json:"description"`
+	MarkdownDescription string        `json:"markdown_description"`
+	Status              Status        `json:"status"`
+	Orderindex          int           `json:"orderindex"`
+	DateCreated         string        `json:"date_created"`
+	DateUpdated         string        `json:"date_updated"`
+	DateClosed          string        `json:"date_closed"`
+	Archived            bool          `json:"archived"`
+	Creator             User          `json:"creator"`
+	Assignees           []User        `json:"assignees,omitempty"`
+	Checklists          []Checklist   `json:"checklists"`
+	Tags                []Tag         `json:"tags,omitempty"`
+	Parent              string        `json:"parent"`
+	Priority            Priority      `json:"priority"`
+	DueDate             string        `json:"due_date,omitempty"`
+	StartDate           string        `json:"start_date,omitempty"`
+	Points              json.Number   `json:"points,omitempty"`
+	TimeEstimate        int64         `json:"time_estimate"`
+	TimeSpent           int64         `json:"time_spent"`
+	CustomFields        []CustomField `json:"custom_fields"`
+	TeamID              string        `json:"team_id"`
+	URL                 string        `json:"url"`
+	List                Location      `json:"list"`
+	Folder              Location      `json:"folder"`
+	Space               Location      `json:"space"`
+}
+
+// Checklist is a checklist on a task. Resolved and Unresolved count its
+// items when it is served.
+type Checklist struct {
+	ID         string          `json:"id"`
+	TaskID     string          `json:"task_id"`
+	Name       string          `json:"name"`
+	Orderindex int             `json:"orderindex"`
+	Resolved   int             `json:"resolved"`
+	Unresolved int             `json:"unresolved"`
+	Items      []ChecklistItem `json:"items"`
+}
+
+// ChecklistItem is an item of a checklist. Parent is the ID of the item it
+// is nested under, if any.
+type ChecklistItem struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Orderindex  int     `json:"orderindex"`
+	Assignee    *User   `json:"assignee"`
+	Resolved    bool    `json:"resolved"`
+	Parent      *string `json:"parent"`
+	DateCreated string  `json:"date_created"`
+}
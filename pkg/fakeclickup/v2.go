@@ -0,0 +1,1455 @@
+package fakeclickup
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/api/clickupv2"
+)
+
+// Error codes. Not-found codes match the ones ClickUp returns; the fake's
+// own validation errors use FAKE_ codes.
+const (
+	codeTaskNotFound = "ITEM_015"
+	codeListNotFound = "LIST_001"
+	codeDocNotFound  = "DOC_000"
+	codeNotFound     = "FAKE_404"
+	codeBadRequest   = "FAKE_400"
+)
+
+// taskPageSize is the page size of the task list endpoints.
+const taskPageSize = 100
+
+var priorities = []Priority{
+	{Priority: "urgent", Color: "#f50000"},
+	{Priority: "high", Color: "#f8ae00"},
+	{Priority: "normal", Color: "#6fddff"},
+	{Priority: "low", Color: "#d8d8d8"},
+}
+
+func (s *Server) routes() {
+	m := http.NewServeMux()
+	v2 := func(pattern string, h func(http.ResponseWriter, *http.Request)) {
+		method, path, _ := strings.Cut(pattern, " ")
+		m.HandleFunc(method+" /api/v2/"+path, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			h(w, r)
+		})
+	}
+
+	v2("GET user", s.getUser)
+	v2("GET team", s.getTeams)
+
+	v2("GET team/{team}/space", s.getSpaces)
+	v2("POST team/{team}/space", s.createSpace)
+	v2("GET space/{space}", s.getSpace)
+	v2("PUT space/{space}", s.updateSpace)
+	v2("DELETE space/{space}", s.deleteSpace)
+
+	v2("GET space/{space}/folder", s.getFolders)
+	v2("POST space/{space}/folder", s.createFolder)
+	v2("GET folder/{folder}", s.getFolder)
+	v2("PUT folder/{folder}", s.updateFolder)
+	v2("DELETE folder/{folder}", s.deleteFolder)
+
+	v2("GET folder/{folder}/list", s.getLists)
+	v2("POST folder/{folder}/list", s.createList)
+	v2("GET space/{space}/list", s.getLists)
+	v2("POST space/{space}/list", s.createList)
+	v2("GET list/{list}", s.getList)
+	v2("PUT list/{list}", s.updateList)
+	v2("DELETE list/{list}", s.deleteList)
+	v2("GET list/{list}/field", s.getFields)
+
+	v2("GET list/{list}/task", s.getListTasks)
+	v2("POST list/{list}/task", s.createTask)
+	v2("POST list/{list}/task/{task}", s.addTaskToList)
+	v2("DELETE list/{list}/task/{task}", s.removeTaskFromList)
+	v2("GET team/{team}/task", s.getTeamTasks)
+	v2("GET task/{task}", s.getTask)
+	v2("PUT task/{task}", s.updateTask)
+	v2("DELETE task/{task}", s.deleteTask)
+	v2("POST task/{task}/field/{field}", s.setFieldValue)
+	v2("DELETE task/{task}/field/{field}", s.removeFieldValue)
+
+	v2("GET space/{space}/tag", s.getSpaceTags)
+	v2("POST space/{space}/tag", s.createSpaceTag)
+	v2("POST task/{task}/tag/{tag}", s.addTaskTag)
+	v2("DELETE task/{task}/tag/{tag}", s.removeTaskTag)
+
+	v2("POST task/{task}/checklist", s.createChecklist)
+	v2("DELETE checklist/{checklist}", s.deleteChecklist)
+	v2("POST checklist/{checklist}/checklist_item", s.createChecklistItem)
+	v2("PUT checklist/{checklist}/checklist_item/{item}", s.updateChecklistItem)
+	v2("DELETE checklist/{checklist}/checklist_item/{item}", s.deleteChecklistItem)
+
+	v2("GET task/{task}/comment", s.getComments)
+	v2("POST task/{task}/comment", s.createComment)
+	v2("PUT comment/{comment}", s.updateComment)
+	v2("DELETE comment/{comment}", s.deleteComment)
+	v2("GET comment/{comment}/reply", s.getReplies)
+	v2("POST comment/{comment}/reply", s.createReply)
+
+	v2("GET team/{team}/time_entries", s.getTimeEntries)
+	v2("POST team/{team}/time_entries", s.createTimeEntry)
+	v2("GET team/{team}/time_entries/current", s.getRunningTimer)
+	v2("POST team/{team}/time_entries/start", s.startTimer)
+	v2("POST team/{team}/time_entries/stop", s.stopTimer)
+	v2("DELETE team/{team}/time_entries/{entry}", s.deleteTimeEntry)
+
+	s.routesV3(m)
+
+	m.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, codeNotFound, "Route not found: %s %s", r.Method, r.URL.Path)
+	})
+	s.mux = m
+}
+
+// --- User and workspaces ---
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"user": s.user})
+}
+
+func (s *Server) getTeams(w http.ResponseWriter, r *http.Request) {
+	teams := make([]Team, 0, len(s.teams))
+	for _, t := range s.teams {
+		teams = append(teams, *t)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"teams": teams})
+}
+
+// --- Spaces ---
+
+func (s *Server) getSpaces(w http.ResponseWriter, r *http.Request) {
+	teamID := r.PathValue("team")
+	if s.team(teamID) == nil {
+		notFound(w, "Team", codeNotFound)
+		return
+	}
+	archived := queryBool(r, "archived")
+	spaces := []Space{}
+	for _, sp := range s.spaces {
+		if sp.teamID == teamID && sp.Archived == archived {
+			spaces = append(spaces, sp.Space)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"spaces": spaces})
+}
+
+func (s *Server) createSpace(w http.ResponseWriter, r *http.Request) {
+	teamID := r.PathValue("team")
+	if s.team(teamID) == nil {
+		notFound(w, "Team", codeNotFound)
+		return
+	}
+	var req clickupv2.CreateSpaceJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Space name is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.addSpace(teamID, Space{Name: req.Name}).Space)
+}
+
+func (s *Server) getSpace(w http.ResponseWriter, r *http.Request) {
+	sp := s.space(r.PathValue("space"))
+	if sp == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, sp.Space)
+}
+
+func (s *Server) updateSpace(w http.ResponseWriter, r *http.Request) {
+	sp := s.space(r.PathValue("space"))
+	if sp == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	var req struct {
+		Name     *string `json:"name"`
+		Private  *bool   `json:"private"`
+		Archived *bool   `json:"archived"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name != nil {
+		sp.Name = *req.Name
+	}
+	if req.Private != nil {
+		sp.Private = *req.Private
+	}
+	if req.Archived != nil {
+		sp.Archived = *req.Archived
+	}
+	writeJSON(w, http.StatusOK, sp.Space)
+}
+
+func (s *Server) deleteSpace(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("space")
+	if s.space(id) == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	s.spaces = slices.DeleteFunc(s.spaces, func(sp *spaceState) bool { return sp.ID == id })
+	s.folders = slices.DeleteFunc(s.folders, func(f *Folder) bool { return f.Space.ID == id })
+	s.removeLists(func(l *listState) bool { return l.Space.ID == id })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// --- Folders ---
+
+func (s *Server) folderView(f *Folder) Folder {
+	out := *f
+	out.Lists = []List{}
+	out.TaskCount = 0
+	for _, l := range s.lists {
+		if l.Folder.ID != f.ID {
+			continue
+		}
+		entry := s.listView(l)
+		out.TaskCount += entry.TaskCount
+		out.Lists = append(out.Lists, entry)
+	}
+	return out
+}
+
+func (s *Server) getFolders(w http.ResponseWriter, r *http.Request) {
+	spaceID := r.PathValue("space")
+	if s.space(spaceID) == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	archived := queryBool(r, "archived")
+	folders := []Folder{}
+	for _, f := range s.folders {
+		if f.Space.ID == spaceID && f.Archived == archived {
+			folders = append(folders, s.folderView(f))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"folders": folders})
+}
+
+func (s *Server) createFolder(w http.ResponseWriter, r *http.Request) {
+	spaceID := r.PathValue("space")
+	if s.space(spaceID) == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	var req clickupv2.CreateFolderJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Folder name is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.folderView(s.addFolder(spaceID, Folder{Name: req.Name})))
+}
+
+func (s *Server) getFolder(w http.ResponseWriter, r *http.Request) {
+	f := s.folder(r.PathValue("folder"))
+	if f == nil {
+		notFound(w, "Folder", codeNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.folderView(f))
+}
+
+func (s *Server) updateFolder(w http.ResponseWriter, r *http.Request) {
+	f := s.folder(r.PathValue("folder"))
+	if f == nil {
+		notFound(w, "Folder", codeNotFound)
+		return
+	}
+	var req struct {
+		Name     *string `json:"name"`
+		Archived *bool   `json:"archived"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name != nil {
+		f.Name = *req.Name
+		for _, l := range s.lists {
+			if l.Folder.ID == f.ID {
+				l.Folder.Name = f.Name
+			}
+		}
+	}
+	if req.Archived != nil {
+		f.Archived = *req.Archived
+	}
+	writeJSON(w, http.StatusOK, s.folderView(f))
+}
+
+func (s *Server) deleteFolder(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("folder")
+	if s.folder(id) == nil {
+		notFound(w, "Folder", codeNotFound)
+		return
+	}
+	s.folders = slices.DeleteFunc(s.folders, func(f *Folder) bool { return f.ID == id })
+	s.removeLists(func(l *listState) bool { return l.Folder.ID == id })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// --- Lists ---
+
+func (s *Server) taskCount(listID string) int {
+	n := 0
+	for _, t := range s.tasks {
+		if t.inList(listID) {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Server) listView(l *listState) List {
+	out := l.List
+	out.TaskCount = s.taskCount(l.ID)
+	return out
+}
+
+// removeLists deletes the matching lists and the tasks that live in them.
+func (s *Server) removeLists(match func(*listState) bool) {
+	var gone []string
+	s.lists = slices.DeleteFunc(s.lists, func(l *listState) bool {
+		if match(l) {
+			gone = append(gone, l.ID)
+			return true
+		}
+		return false
+	})
+	s.tasks = slices.DeleteFunc(s.tasks, func(t *taskState) bool { return slices.Contains(gone, t.List.ID) })
+}
+
+func (s *Server) getLists(w http.ResponseWriter, r *http.Request) {
+	folderID, spaceID := r.PathValue("folder"), r.PathValue("space")
+	switch {
+	case folderID != "" && s.folder(folderID) == nil:
+		notFound(w, "Folder", codeNotFound)
+		return
+	case spaceID != "" && s.space(spaceID) == nil:
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	archived := queryBool(r, "archived")
+	lists := []List{}
+	for _, l := range s.lists {
+		inParent := folderID != "" && l.Folder.ID == folderID ||
+			spaceID != "" && l.Space.ID == spaceID && l.Folder.ID == "none"
+		if inParent && l.Archived == archived {
+			lists = append(lists, s.listView(l))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"lists": lists})
+}
+
+func (s *Server) createList(w http.ResponseWriter, r *http.Request) {
+	folderID, spaceID := r.PathValue("folder"), r.PathValue("space")
+	if folderID != "" {
+		f := s.folder(folderID)
+		if f == nil {
+			notFound(w, "Folder", codeNotFound)
+			return
+		}
+		spaceID = f.Space.ID
+	} else if s.space(spaceID) == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	var req struct {
+		Name    string `json:"name"`
+		Content string `json:"content"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "List name is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.listView(s.addList(spaceID, folderID, List{Name: req.Name, Content: req.Content})))
+}
+
+func (s *Server) getList(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.listView(l))
+}
+
+func (s *Server) updateList(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	var req struct {
+		Name    *string `json:"name"`
+		Content *string `json:"content"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name != nil {
+		l.Name = *req.Name
+		for _, t := range s.tasks {
+			if t.List.ID == l.ID {
+				t.List.Name = l.Name
+			}
+		}
+	}
+	if req.Content != nil {
+		l.Content = *req.Content
+	}
+	writeJSON(w, http.StatusOK, s.listView(l))
+}
+
+func (s *Server) deleteList(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("list")
+	if s.list(id) == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	s.removeLists(func(l *listState) bool { return l.ID == id })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) getFields(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	fields := append([]CustomField{}, l.fields...)
+	writeJSON(w, http.StatusOK, map[string]any{"fields": fields})
+}
+
+// --- Tasks ---
+
+func (t *taskState) inList(listID string) bool {
+	return t.List.ID == listID || slices.Contains(t.extraLists, listID)
+}
+
+func (t *taskState) closed() bool {
+	return t.Status.Type == "closed" || t.Status.Type == "done"
+}
+
+// lookupTask resolves the {task} path value, honouring custom_task_ids.
+func (s *Server) lookupTask(w http.ResponseWriter, r *http.Request) *taskState {
+	t := s.task(r.PathValue("task"), queryBool(r, "custom_task_ids"))
+	if t == nil {
+		notFound(w, "Task", codeTaskNotFound)
+	}
+	return t
+}
+
+// paginate returns one page of tasks and whether it is the last page.
+func paginate(r *http.Request, tasks []Task) ([]Task, bool) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	start := min(page*taskPageSize, len(tasks))
+	end := min(start+taskPageSize, len(tasks))
+	return tasks[start:end], end == len(tasks)
+}
+
+func (s *Server) getListTasks(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	q := r.URL.Query()
+	includeClosed := queryBool(r, "include_closed")
+	subtasks := queryBool(r, "subtasks")
+	archived := queryBool(r, "archived")
+	statuses := q["statuses[]"]
+	assignees := q["assignees[]"]
+	tags := q["tags[]"]
+
+	tasks := []Task{}
+	for _, t := range s.tasks {
+		if !t.inList(l.ID) || t.Archived != archived || t.Parent != "" && !subtasks || t.closed() && !includeClosed {
+			continue
+		}
+		if !matchTask(t, statuses, assignees, tags) || !inDateRanges(t, q) {
+			continue
+		}
+		tasks = append(tasks, s.render(t))
+	}
+	page, last := paginate(r, tasks)
+	writeJSON(w, http.StatusOK, map[string]any{"tasks": page, "last_page": last})
+}
+
+func (s *Server) getTeamTasks(w http.ResponseWriter, r *http.Request) {
+	teamID := r.PathValue("team")
+	if s.team(teamID) == nil {
+		notFound(w, "Team", codeNotFound)
+		return
+	}
+	q := r.URL.Query()
+	includeClosed := queryBool(r, "include_closed")
+	subtasks := queryBool(r, "subtasks")
+	archived := queryBool(r, "archived")
+	listIDs := q["list_ids[]"]
+	spaceIDs := q["space_ids[]"]
+
+	tasks := []Task{}
+	for _, t := range s.tasks {
+		if t.TeamID != teamID || t.Archived != archived || t.Parent != "" && !subtasks || t.closed() && !includeClosed {
+			continue
+		}
+		if len(listIDs) > 0 && !slices.ContainsFunc(listIDs, t.inList) {
+			continue
+		}
+		if len(spaceIDs) > 0 && !slices.Contains(spaceIDs, t.Space.ID) {
+			continue
+		}
+		if !matchTask(t, q["statuses[]"], q["assignees[]"], q["tags[]"]) || !inDateRanges(t, q) {
+			continue
+		}
+		tasks = append(tasks, s.render(t))
+	}
+	page, last := paginate(r, tasks)
+	writeJSON(w, http.StatusOK, map[string]any{"tasks": page, "last_page": last})
+}
+
+// dateRanges are the dates task endpoints filter with <key>_gt and
+// <key>_lt.
+var dateRanges = []struct {
+	key   string
+	value func(*taskState) string
+}{
+	{"date_updated", func(t *taskState) string { return t.DateUpdated }},
+	{"date_created", func(t *taskState) string { return t.DateCreated }},
+	{"due_date", func(t *taskState) string { return t.DueDate }},
+}
+
+// requestDate is a date in a request body: unix milliseconds as a number
+// or a string. It holds the milliseconds as tasks store them; null and ""
+// are no date.
+type requestDate string
+
+func (d *requestDate) UnmarshalJSON(b []byte) error {
+	*d = ""
+	if string(b) == "null" || string(b) == `""` {
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	ms, err := n.Int64()
+	if err != nil {
+		return err
+	}
+	*d = requestDate(strconv.FormatInt(ms, 10))
+	return nil
+}
+
+// inDateRanges applies the date range filters.
+func inDateRanges(t *taskState, q url.Values) bool {
+	for _, rg := range dateRanges {
+		v, _ := strconv.ParseInt(rg.value(t), 10, 64)
+		if gt, err := strconv.ParseInt(q.Get(rg.key+"_gt"), 10, 64); err == nil && v <= gt {
+			return false
+		}
+		if lt, err := strconv.ParseInt(q.Get(rg.key+"_lt"), 10, 64); err == nil && (v == 0 || v >= lt) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTask applies the statuses[], assignees[] and tags[] filters.
+func matchTask(t *taskState, statuses, assignees, tags []string) bool {
+	if len(statuses) > 0 && !slices.ContainsFunc(statuses, func(s string) bool { return strings.EqualFold(s, t.Status.Status) }) {
+		return false
+	}
+	if len(assignees) > 0 && !slices.ContainsFunc(t.Assignees, func(u User) bool { return slices.Contains(assignees, strconv.Itoa(u.ID)) }) {
+		return false
+	}
+	if len(tags) > 0 && !slices.ContainsFunc(t.Tags, func(tag Tag) bool {
+		return slices.ContainsFunc(tags, func(s string) bool { return strings.EqualFold(s, tag.Name) })
+	}) {
+		return false
+	}
+	return true
+}
+
+// createTaskRequest is the body of POST list/{list}/task.
+type createTaskRequest struct {
+	Name                string      `json:"name"`
+	Description         string      `json:"description"`
+	MarkdownDescription string      `json:"markdown_description"`
+	Assignees           []int       `json:"assignees"`
+	Tags                []string    `json:"tags"`
+	Status              string      `json:"status"`
+	Priority            int         `json:"priority"`
+	DueDate             requestDate `json:"due_date"`
+	StartDate           requestDate `json:"start_date"`
+	TimeEstimate        int64       `json:"time_estimate"`
+	Parent              string      `json:"parent"`
+	CustomItemID        int         `json:"custom_item_id"`
+	CustomFields        []struct {
+		ID    string `json:"id"`
+		Value any    `json:"value"`
+	} `json:"custom_fields"`
+}
+
+func (s *Server) createTask(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	var req createTaskRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Task name invalid")
+		return
+	}
+	t := Task{
+		Name:         req.Name,
+		Description:  req.Description,
+		TextContent:  req.Description,
+		DueDate:      string(req.DueDate),
+		StartDate:    string(req.StartDate),
+		TimeEstimate: req.TimeEstimate,
+		Parent:       req.Parent,
+		CustomItemID: req.CustomItemID,
+	}
+	if req.MarkdownDescription != "" {
+		t.MarkdownDescription = req.MarkdownDescription
+		t.Description, t.TextContent = req.MarkdownDescription, req.MarkdownDescription
+	}
+	if req.Status != "" {
+		if t.Status = l.status(req.Status); t.Status.Status == "" {
+			writeError(w, http.StatusBadRequest, codeBadRequest, "Status does not exist: %s", req.Status)
+			return
+		}
+	}
+	if req.Priority > 0 {
+		if req.Priority > len(priorities) {
+			writeError(w, http.StatusBadRequest, codeBadRequest, "Priority invalid: %d", req.Priority)
+			return
+		}
+		t.Priority = priorities[req.Priority-1]
+	}
+	if req.Parent != "" && s.task(req.Parent, false) == nil {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Parent task not found: %s", req.Parent)
+		return
+	}
+	for _, id := range req.Assignees {
+		t.Assignees = append(t.Assignees, s.member(id))
+	}
+	for _, name := range req.Tags {
+		t.Tags = appendTag(t.Tags, s.spaceTag(l.Space.ID, name))
+	}
+
+	ts, err := s.addTask(l.ID, t)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "%v", err)
+		return
+	}
+	for _, cf := range req.CustomFields {
+		if f := l.field(cf.ID); f != nil {
+			ts.values[f.ID] = fieldValue(*f, cf.Value)
+		}
+	}
+	writeJSON(w, http.StatusOK, s.render(ts))
+}
+
+func (s *Server) getTask(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	out := struct {
+		Task
+		Subtasks []Task `json:"subtasks,omitempty"`
+	}{Task: s.render(t)}
+	if !queryBool(r, "include_markdown_description") {
+		out.MarkdownDescription = ""
+	}
+	if queryBool(r, "include_subtasks") {
+		for _, sub := range s.tasks {
+			if sub.Parent == t.ID {
+				out.Subtasks = append(out.Subtasks, s.render(sub))
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) updateTask(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	var req map[string]json.RawMessage
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if msg := s.applyTaskUpdate(t, req); msg != "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "%s", msg)
+		return
+	}
+	t.DateUpdated = s.stamp()
+	writeJSON(w, http.StatusOK, s.render(t))
+}
+
+// applyTaskUpdate applies a PUT /task body. It takes the raw fields so that
+// an explicit null (clearing a date or priority) is distinguishable from an
+// absent field. It returns a validation message, or "" on success.
+func (s *Server) applyTaskUpdate(t *taskState, req map[string]json.RawMessage) string {
+	isNull := func(raw json.RawMessage) bool { return string(raw) == "null" }
+	for key, raw := range req {
+		var err error
+		switch key {
+		case "name":
+			err = json.Unmarshal(raw, &t.Name)
+		case "description":
+			err = json.Unmarshal(raw, &t.Description)
+			t.TextContent = t.Description
+		case "markdown_content", "markdown_description":
+			err = json.Unmarshal(raw, &t.MarkdownDescription)
+			t.Description, t.TextContent = t.MarkdownDescription, t.MarkdownDescription
+		case "status":
+			var name string
+			if err = json.Unmarshal(raw, &name); err == nil {
+				l := s.list(t.List.ID)
+				st := l.status(name)
+				if st.Status == "" {
+					return "Status does not exist: " + name
+				}
+				if st.Type == "closed" && !t.closed() {
+					t.DateClosed = s.stamp()
+				} else if st.Type != "closed" {
+					t.DateClosed = ""
+				}
+				t.Status = st
+			}
+		case "priority":
+			if isNull(raw) {
+				t.Priority = Priority{}
+				continue
+			}
+			var p int
+			if err = json.Unmarshal(raw, &p); err == nil {
+				if p < 1 || p > len(priorities) {
+					return "Priority invalid: " + string(raw)
+				}
+				t.Priority = priorities[p-1]
+			}
+		case "due_date", "start_date":
+			date := &t.DueDate
+			if key == "start_date" {
+				date = &t.StartDate
+			}
+			var d requestDate
+			if err = json.Unmarshal(raw, &d); err == nil {
+				*date = string(d)
+			}
+		case "time_estimate":
+			t.TimeEstimate = 0
+			if !isNull(raw) {
+				err = json.Unmarshal(raw, &t.TimeEstimate)
+			}
+		case "points":
+			t.Points = ""
+			if !isNull(raw) {
+				err = json.Unmarshal(raw, &t.Points)
+			}
+		case "parent":
+			err = json.Unmarshal(raw, &t.Parent)
+		case "archived":
+			err = json.Unmarshal(raw, &t.Archived)
+		case "assignees":
+			var a struct {
+				Add []int `json:"add"`
+				Rem []int `json:"rem"`
+			}
+			if err = json.Unmarshal(raw, &a); err == nil {
+				for _, id := range a.Add {
+					if !slices.ContainsFunc(t.Assignees, func(u User) bool { return u.ID == id }) {
+						t.Assignees = append(t.Assignees, s.member(id))
+					}
+				}
+				t.Assignees = slices.DeleteFunc(t.Assignees, func(u User) bool { return slices.Contains(a.Rem, u.ID) })
+			}
+		}
+		if err != nil {
+			return "Invalid value for " + key + ": " + err.Error()
+		}
+	}
+	return ""
+}
+
+func (s *Server) deleteTask(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	s.tasks = slices.DeleteFunc(s.tasks, func(x *taskState) bool { return x == t })
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) addTaskToList(w http.ResponseWriter, r *http.Request) {
+	l := s.list(r.PathValue("list"))
+	if l == nil {
+		notFound(w, "List", codeListNotFound)
+		return
+	}
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	if !t.inList(l.ID) {
+		t.extraLists = append(t.extraLists, l.ID)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) removeTaskFromList(w http.ResponseWriter, r *http.Request) {
+	listID := r.PathValue("list")
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	if t.List.ID == listID {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Cannot remove a task from its home list")
+		return
+	}
+	t.extraLists = slices.DeleteFunc(t.extraLists, func(id string) bool { return id == listID })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// member returns the workspace member with the given ID, or a bare user if
+// the ID is unknown.
+func (s *Server) member(id int) User {
+	if id == s.user.ID {
+		return s.user
+	}
+	for _, t := range s.teams {
+		for _, m := range t.Members {
+			if m.User.ID == id {
+				return User{ID: id, Username: m.User.Username, Email: m.User.Email, Color: m.User.Color, Initials: m.User.Initials}
+			}
+		}
+	}
+	return User{ID: id}
+}
+
+// --- Custom fields ---
+
+func (l *listState) field(id string) *CustomField {
+	for i := range l.fields {
+		if l.fields[i].ID == id {
+			return &l.fields[i]
+		}
+	}
+	return nil
+}
+
+// taskField finds a custom field on any list the task belongs to.
+func (s *Server) taskField(t *taskState, id string) *CustomField {
+	for _, listID := range append([]string{t.List.ID}, t.extraLists...) {
+		if l := s.list(listID); l != nil {
+			if f := l.field(id); f != nil {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// fieldValue converts a written value to the form ClickUp returns: dropdown
+// option IDs are stored as the option's orderindex.
+func fieldValue(f CustomField, v any) any {
+	id, ok := v.(string)
+	if f.Type != "drop_down" || !ok {
+		return v
+	}
+	raw, _ := json.Marshal(f.TypeConfig)
+	var cfg struct {
+		Options []struct {
+			ID         string      `json:"id"`
+			Orderindex json.Number `json:"orderindex"`
+		} `json:"options"`
+	}
+	if json.Unmarshal(raw, &cfg) == nil {
+		for _, o := range cfg.Options {
+			if o.ID == id {
+				if n, err := o.Orderindex.Int64(); err == nil {
+					return n
+				}
+			}
+		}
+	}
+	return v
+}
+
+func (s *Server) setFieldValue(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	f := s.taskField(t, r.PathValue("field"))
+	if f == nil {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Custom field not found on the task's lists")
+		return
+	}
+	var req struct {
+		Value any `json:"value"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	t.values[f.ID] = fieldValue(*f, req.Value)
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) removeFieldValue(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	delete(t.values, r.PathValue("field"))
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// --- Tags ---
+
+// spaceTag returns the space's tag named name, creating it if needed, as
+// ClickUp does when a new tag is applied to a task.
+func (s *Server) spaceTag(spaceID, name string) Tag {
+	sp := s.space(spaceID)
+	if sp == nil {
+		return Tag{Name: name}
+	}
+	for _, t := range sp.tags {
+		if strings.EqualFold(t.Name, name) {
+			return t
+		}
+	}
+	t := Tag{Name: strings.ToLower(name), TagFg: "#ffffff", TagBg: "#7b68ee", Creator: s.user.ID}
+	sp.tags = append(sp.tags, t)
+	return t
+}
+
+func appendTag(tags []Tag, t Tag) []Tag {
+	if slices.ContainsFunc(tags, func(x Tag) bool { return strings.EqualFold(x.Name, t.Name) }) {
+		return tags
+	}
+	return append(tags, t)
+}
+
+func (s *Server) getSpaceTags(w http.ResponseWriter, r *http.Request) {
+	sp := s.space(r.PathValue("space"))
+	if sp == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"tags": append([]Tag{}, sp.tags...)})
+}
+
+func (s *Server) createSpaceTag(w http.ResponseWriter, r *http.Request) {
+	sp := s.space(r.PathValue("space"))
+	if sp == nil {
+		notFound(w, "Space", codeNotFound)
+		return
+	}
+	var req struct {
+		Tag Tag `json:"tag"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Tag.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Tag name is required")
+		return
+	}
+	if slices.ContainsFunc(sp.tags, func(t Tag) bool { return strings.EqualFold(t.Name, req.Tag.Name) }) {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Tag already exists")
+		return
+	}
+	req.Tag.Creator = s.user.ID
+	sp.tags = append(sp.tags, req.Tag)
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) addTaskTag(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	t.Tags = appendTag(t.Tags, s.spaceTag(t.Space.ID, r.PathValue("tag")))
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) removeTaskTag(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	name := r.PathValue("tag")
+	t.Tags = slices.DeleteFunc(t.Tags, func(x Tag) bool { return strings.EqualFold(x.Name, name) })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// --- Checklists ---
+
+// lookupChecklist finds a checklist on any task, writing a 404 if there is
+// none.
+func (s *Server) lookupChecklist(w http.ResponseWriter, r *http.Request) (*taskState, *Checklist) {
+	id := r.PathValue("checklist")
+	for _, t := range s.tasks {
+		for i := range t.Checklists {
+			if t.Checklists[i].ID == id {
+				return t, &t.Checklists[i]
+			}
+		}
+	}
+	notFound(w, "Checklist", codeNotFound)
+	return nil, nil
+}
+
+// lookupChecklistItem finds an item of a checklist, writing a 404 if there
+// is none.
+func (s *Server) lookupChecklistItem(w http.ResponseWriter, r *http.Request) (*Checklist, *ChecklistItem) {
+	_, cl := s.lookupChecklist(w, r)
+	if cl == nil {
+		return nil, nil
+	}
+	id := r.PathValue("item")
+	for i := range cl.Items {
+		if cl.Items[i].ID == id {
+			return cl, &cl.Items[i]
+		}
+	}
+	notFound(w, "Checklist item", codeNotFound)
+	return nil, nil
+}
+
+func (s *Server) createChecklist(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	var req clickupv2.CreateChecklistJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Checklist name is required")
+		return
+	}
+	t.Checklists = append(t.Checklists, Checklist{
+		ID:         s.nextUUID(),
+		TaskID:     t.ID,
+		Name:       req.Name,
+		Orderindex: len(t.Checklists),
+		Items:      []ChecklistItem{},
+	})
+	t.DateUpdated = s.stamp()
+	writeJSON(w, http.StatusOK, map[string]any{"checklist": renderChecklist(t.Checklists[len(t.Checklists)-1])})
+}
+
+func (s *Server) deleteChecklist(w http.ResponseWriter, r *http.Request) {
+	t, cl := s.lookupChecklist(w, r)
+	if cl == nil {
+		return
+	}
+	id := cl.ID
+	t.Checklists = slices.DeleteFunc(t.Checklists, func(c Checklist) bool { return c.ID == id })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) createChecklistItem(w http.ResponseWriter, r *http.Request) {
+	_, cl := s.lookupChecklist(w, r)
+	if cl == nil {
+		return
+	}
+	var req clickupv2.CreateChecklistItemJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == nil || *req.Name == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Checklist item name is required")
+		return
+	}
+	item := ChecklistItem{
+		ID:          s.nextUUID(),
+		Name:        *req.Name,
+		Orderindex:  len(cl.Items),
+		DateCreated: s.stamp(),
+	}
+	if req.Assignee != nil {
+		u := s.member(*req.Assignee)
+		item.Assignee = &u
+	}
+	cl.Items = append(cl.Items, item)
+	writeJSON(w, http.StatusOK, map[string]any{"checklist": renderChecklist(*cl)})
+}
+
+func (s *Server) updateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	cl, item := s.lookupChecklistItem(w, r)
+	if item == nil {
+		return
+	}
+	// Not clickupv2.EditChecklistItemJSONRequest: its nullable fields only
+	// marshal.
+	var req struct {
+		Name     *string         `json:"name"`
+		Resolved *bool           `json:"resolved"`
+		Parent   json.RawMessage `json:"parent"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name != nil {
+		item.Name = *req.Name
+	}
+	if req.Resolved != nil {
+		item.Resolved = *req.Resolved
+	}
+	if req.Parent != nil {
+		item.Parent = nil
+		if err := json.Unmarshal(req.Parent, &item.Parent); err != nil {
+			writeError(w, http.StatusBadRequest, codeBadRequest, "Invalid value for parent: %v", err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"checklist": renderChecklist(*cl)})
+}
+
+func (s *Server) deleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	cl, item := s.lookupChecklistItem(w, r)
+	if item == nil {
+		return
+	}
+	id := item.ID
+	cl.Items = slices.DeleteFunc(cl.Items, func(x ChecklistItem) bool { return x.ID == id })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// renderChecklist returns a checklist as served, with its resolved and
+// unresolved counts.
+func renderChecklist(cl Checklist) Checklist {
+	cl.Items = append([]ChecklistItem{}, cl.Items...)
+	cl.Resolved, cl.Unresolved = 0, 0
+	for _, item := range cl.Items {
+		if item.Resolved {
+			cl.Resolved++
+		} else {
+			cl.Unresolved++
+		}
+	}
+	return cl
+}
+
+// --- Comments ---
+
+// commentText flattens a comment body: plain comment_text, or the text of
+// each rich-text block.
+func commentText(plain *string, blocks []*string) string {
+	if plain != nil {
+		return *plain
+	}
+	var b strings.Builder
+	for _, t := range blocks {
+		if t != nil {
+			b.WriteString(*t)
+		}
+	}
+	return b.String()
+}
+
+func commentCreated(c *Comment) map[string]any {
+	id, _ := strconv.Atoi(c.ID)
+	date, _ := strconv.ParseInt(c.Date, 10, 64)
+	return map[string]any{"id": id, "hist_id": "hist-" + c.ID, "date": date}
+}
+
+func (s *Server) getComments(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	// Newest first, as ClickUp returns them.
+	comments := []Comment{}
+	for i := len(s.comments) - 1; i >= 0; i-- {
+		if c := s.comments[i]; c.TaskID == t.ID && c.ParentID == "" {
+			comments = append(comments, *c)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"comments": comments})
+}
+
+func (s *Server) createComment(w http.ResponseWriter, r *http.Request) {
+	t := s.lookupTask(w, r)
+	if t == nil {
+		return
+	}
+	var req clickupv2.CreateTaskCommentJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	var blocks []*string
+	for _, b := range req.Comment {
+		blocks = append(blocks, b.Text)
+	}
+	text := commentText(req.CommentText, blocks)
+	if text == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Comment text is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, commentCreated(s.addComment(t.ID, "", text)))
+}
+
+func (s *Server) updateComment(w http.ResponseWriter, r *http.Request) {
+	c := s.comment(r.PathValue("comment"))
+	if c == nil {
+		notFound(w, "Comment", codeNotFound)
+		return
+	}
+	var req clickupv2.UpdateCommentJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	var blocks []*string
+	for _, b := range req.Comment {
+		blocks = append(blocks, b.Text)
+	}
+	if req.CommentText != nil || len(blocks) > 0 {
+		c.CommentText = commentText(req.CommentText, blocks)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) deleteComment(w http.ResponseWriter, r *http.Request) {
+	c := s.comment(r.PathValue("comment"))
+	if c == nil {
+		notFound(w, "Comment", codeNotFound)
+		return
+	}
+	if p := s.comment(c.ParentID); p != nil {
+		p.ReplyCount--
+	}
+	s.comments = slices.DeleteFunc(s.comments, func(x *Comment) bool { return x == c || x.ParentID == c.ID })
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) getReplies(w http.ResponseWriter, r *http.Request) {
+	parent := s.comment(r.PathValue("comment"))
+	if parent == nil {
+		notFound(w, "Comment", codeNotFound)
+		return
+	}
+	replies := []Comment{}
+	for _, c := range s.comments {
+		if c.ParentID == parent.ID {
+			replies = append(replies, *c)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"comments": replies})
+}
+
+func (s *Server) createReply(w http.ResponseWriter, r *http.Request) {
+	parent := s.comment(r.PathValue("comment"))
+	if parent == nil {
+		notFound(w, "Comment", codeNotFound)
+		return
+	}
+	var req clickupv2.CreateThreadedCommentJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	var blocks []*string
+	for _, b := range req.Comment {
+		blocks = append(blocks, b.Text)
+	}
+	text := commentText(req.CommentText, blocks)
+	if text == "" {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Comment text is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, commentCreated(s.addComment(parent.TaskID, parent.ID, text)))
+}
+
+// --- Time tracking ---
+
+// entryJSON is the time entry shape returned by the list endpoint, where
+// numbers are strings.
+func (s *Server) entryJSON(e *TimeEntry) map[string]any {
+	out := map[string]any{
+		"id":          e.ID,
+		"description": e.Description,
+		"start":       strconv.FormatInt(e.Start, 10),
+		"end":         "",
+		"duration":    strconv.FormatInt(e.Duration(), 10),
+		"billable":    e.Billable,
+		"user":        e.User,
+		"tags":        entryTags(e),
+	}
+	if e.End != 0 {
+		out["end"] = strconv.FormatInt(e.End, 10)
+	}
+	if t := s.task(e.TaskID, false); t != nil {
+		out["task"] = map[string]any{"id": t.ID, "name": t.Name, "status": t.Status}
+		out["task_location"] = map[string]any{"list_id": t.List.ID, "folder_id": t.Folder.ID, "space_id": t.Space.ID}
+	}
+	return out
+}
+
+// timerJSON is the shape returned by the single-entry timer endpoints,
+// where duration is a number.
+func (s *Server) timerJSON(e *TimeEntry) map[string]any {
+	out := s.entryJSON(e)
+	out["duration"] = e.Duration()
+	if _, ok := out["task"]; !ok {
+		out["task"] = nil
+	}
+	return out
+}
+
+func entryTags(e *TimeEntry) []map[string]string {
+	tags := []map[string]string{}
+	for _, t := range e.Tags {
+		tags = append(tags, map[string]string{"name": t})
+	}
+	return tags
+}
+
+func (s *Server) running() *TimeEntry {
+	for _, e := range s.entries {
+		if e.End == 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+func (s *Server) getTimeEntries(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	startGte, _ := strconv.ParseInt(q.Get("start_date"), 10, 64)
+	startLte, _ := strconv.ParseInt(q.Get("end_date"), 10, 64)
+	data := []map[string]any{}
+	for _, e := range s.entries {
+		if e.End == 0 || q.Get("task_id") != "" && e.TaskID != q.Get("task_id") {
+			continue
+		}
+		if startGte > 0 && e.Start < startGte || startLte > 0 && e.Start > startLte {
+			continue
+		}
+		if a := q.Get("assignee"); a != "" && !slices.Contains(strings.Split(a, ","), strconv.Itoa(e.User.ID)) {
+			continue
+		}
+		data = append(data, s.entryJSON(e))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": data})
+}
+
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var req clickupv2.CreateatimeentryJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Duration <= 0 {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "Duration must be positive")
+		return
+	}
+	e := &TimeEntry{ID: s.nextID(), Start: int64(req.Start), End: int64(req.Start) + int64(req.Duration), User: s.user}
+	if req.Description != nil {
+		e.Description = *req.Description
+	}
+	if req.Billable != nil {
+		e.Billable = *req.Billable
+	}
+	if req.Tid != nil {
+		if s.task(*req.Tid, false) == nil {
+			notFound(w, "Task", codeTaskNotFound)
+			return
+		}
+		e.TaskID = *req.Tid
+	}
+	if req.Assignee != nil {
+		e.User = s.member(*req.Assignee)
+	}
+	s.entries = append(s.entries, e)
+	writeJSON(w, http.StatusOK, map[string]any{"data": s.timerJSON(e)})
+}
+
+func (s *Server) getRunningTimer(w http.ResponseWriter, r *http.Request) {
+	if e := s.running(); e != nil {
+		writeJSON(w, http.StatusOK, map[string]any{"data": s.timerJSON(e)})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": nil})
+}
+
+// startTimer starts a timer, stopping any running one first as ClickUp does.
+func (s *Server) startTimer(w http.ResponseWriter, r *http.Request) {
+	var req clickupv2.StartatimeEntryJSONRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	now := s.Now().UnixMilli()
+	if e := s.running(); e != nil {
+		e.End = now
+	}
+	e := &TimeEntry{ID: s.nextID(), Start: now, User: s.user}
+	if req.Description != nil {
+		e.Description = *req.Description
+	}
+	if req.Billable != nil {
+		e.Billable = *req.Billable
+	}
+	if req.Tid != nil {
+		if s.task(*req.Tid, false) == nil {
+			notFound(w, "Task", codeTaskNotFound)
+			return
+		}
+		e.TaskID = *req.Tid
+	}
+	s.entries = append(s.entries, e)
+	writeJSON(w, http.StatusOK, map[string]any{"data": s.timerJSON(e)})
+}
+
+func (s *Server) stopTimer(w http.ResponseWriter, r *http.Request) {
+	e := s.running()
+	if e == nil {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "No timer running")
+		return
+	}
+	e.End = max(s.Now().UnixMilli(), e.Start+1)
+	writeJSON(w, http.StatusOK, map[string]any{"data": s.timerJSON(e)})
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("entry")
+	i := slices.IndexFunc(s.entries, func(e *TimeEntry) bool { return e.ID == id })
+	if i < 0 {
+		notFound(w, "Time entry", codeNotFound)
+		return
+	}
+	e := s.entries[i]
+	s.entries = slices.Delete(s.entries, i, i+1)
+	writeJSON(w, http.StatusOK, map[string]any{"data": s.timerJSON(e)})
+}
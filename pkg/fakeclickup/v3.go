@@ -0,0 +1,238 @@
+package fakeclickup
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/triptechtravel/clickup-cli/api/clickupv3"
+)
+
+// docPageSize is the default page size of the doc search endpoint.
+const docPageSize = 50
+
+func (s *Server) routesV3(m *http.ServeMux) {
+	v3 := func(pattern string, h func(http.ResponseWriter, *http.Request)) {
+		method, path, _ := strings.Cut(pattern, " ")
+		m.HandleFunc(method+" /api/v3/"+path, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			h(w, r)
+		})
+	}
+
+	v3("GET workspaces/{ws}/docs", s.searchDocs)
+	v3("POST workspaces/{ws}/docs", s.createDoc)
+	v3("GET workspaces/{ws}/docs/{doc}", s.getDoc)
+	v3("GET workspaces/{ws}/docs/{doc}/pages", s.getPages)
+	v3("POST workspaces/{ws}/docs/{doc}/pages", s.createPage)
+	v3("GET workspaces/{ws}/docs/{doc}/pages/{page}", s.getPage)
+	v3("PUT workspaces/{ws}/docs/{doc}/pages/{page}", s.editPage)
+}
+
+// lookupDoc resolves the {ws} and {doc} path values.
+func (s *Server) lookupDoc(w http.ResponseWriter, r *http.Request) *docState {
+	d := s.doc(r.PathValue("doc"))
+	if d == nil || d.workspaceID != r.PathValue("ws") {
+		notFound(w, "Doc", codeDocNotFound)
+		return nil
+	}
+	return d
+}
+
+func (s *Server) lookupPage(w http.ResponseWriter, r *http.Request) (*docState, *pageState) {
+	d := s.lookupDoc(w, r)
+	if d == nil {
+		return nil, nil
+	}
+	p := d.page(r.PathValue("page"))
+	if p == nil {
+		notFound(w, "Page", codeDocNotFound)
+		return nil, nil
+	}
+	return d, p
+}
+
+// tree returns the pages under parentID, each with its sub-pages nested
+// up to depth levels (unlimited when depth < 0).
+func (d *docState) tree(parentID string, depth int) []Page {
+	out := []Page{}
+	for _, p := range d.pages {
+		if p.parentID != parentID {
+			continue
+		}
+		page := p.Page
+		page.Pages = nil
+		if depth != 0 {
+			if sub := d.tree(p.ID, depth-1); len(sub) > 0 {
+				page.Pages = sub
+			}
+		}
+		out = append(out, page)
+	}
+	return out
+}
+
+func (s *Server) searchDocs(w http.ResponseWriter, r *http.Request) {
+	ws := r.PathValue("ws")
+	q := r.URL.Query()
+	wantDeleted := queryBool(r, "deleted")
+	wantArchived := queryBool(r, "archived")
+
+	var docs []Doc
+	for _, d := range s.docs {
+		deleted := d.Deleted != nil && *d.Deleted
+		archived := d.Archived != nil && *d.Archived
+		if d.workspaceID != ws || deleted != wantDeleted || archived != wantArchived {
+			continue
+		}
+		if id := q.Get("parent_id"); id != "" && d.Parent.ID != id {
+			continue
+		}
+		docs = append(docs, d.Doc)
+	}
+
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = docPageSize
+	}
+	start, _ := strconv.Atoi(q.Get("cursor"))
+	start = min(max(start, 0), len(docs))
+	end := min(start+limit, len(docs))
+	var next *string
+	if end < len(docs) {
+		cursor := strconv.Itoa(end)
+		next = &cursor
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"docs": append([]Doc{}, docs[start:end]...), "next_cursor": next})
+}
+
+func (s *Server) createDoc(w http.ResponseWriter, r *http.Request) {
+	ws := r.PathValue("ws")
+	if s.team(ws) == nil {
+		notFound(w, "Workspace", codeNotFound)
+		return
+	}
+	var req clickupv3.PublicDocsCreateDocOptionsDto
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	doc := Doc{Name: "Untitled"}
+	if req.Name != nil && *req.Name != "" {
+		doc.Name = *req.Name
+	}
+	if req.Parent != nil {
+		doc.Parent = clickupv3.PublicDocsParentDto{ID: req.Parent.ID, Type: req.Parent.Type}
+	} else {
+		doc.Parent = clickupv3.PublicDocsParentDto{ID: ws, Type: 12}
+	}
+	d := s.addDoc(ws, doc)
+	// ClickUp creates an empty first page unless asked not to.
+	if req.CreatePage == nil || *req.CreatePage {
+		s.addPage(d, "", Page{Name: d.Name})
+	}
+	writeJSON(w, http.StatusOK, d.Doc)
+}
+
+func (s *Server) getDoc(w http.ResponseWriter, r *http.Request) {
+	if d := s.lookupDoc(w, r); d != nil {
+		writeJSON(w, http.StatusOK, d.Doc)
+	}
+}
+
+func (s *Server) getPages(w http.ResponseWriter, r *http.Request) {
+	d := s.lookupDoc(w, r)
+	if d == nil {
+		return
+	}
+	depth := -1
+	if v, err := strconv.Atoi(r.URL.Query().Get("max_page_depth")); err == nil {
+		depth = v
+	}
+	writeJSON(w, http.StatusOK, d.tree("", depth))
+}
+
+func (s *Server) createPage(w http.ResponseWriter, r *http.Request) {
+	d := s.lookupDoc(w, r)
+	if d == nil {
+		return
+	}
+	var req clickupv3.PublicDocsPublicCreatePageOptionsDto
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	var parentID string
+	if req.ParentPageID != nil && *req.ParentPageID != "" {
+		parentID = *req.ParentPageID
+		if d.page(parentID) == nil {
+			writeError(w, http.StatusBadRequest, codeBadRequest, "Parent page not found: %s", parentID)
+			return
+		}
+	}
+	p := Page{Name: "Untitled", SubTitle: req.SubTitle}
+	if req.Name != nil && *req.Name != "" {
+		p.Name = *req.Name
+	}
+	if req.Content != nil {
+		p.Content = *req.Content
+	}
+	s.touch(d)
+	writeJSON(w, http.StatusOK, s.addPage(d, parentID, p).Page)
+}
+
+func (s *Server) getPage(w http.ResponseWriter, r *http.Request) {
+	d, p := s.lookupPage(w, r)
+	if p == nil {
+		return
+	}
+	page := p.Page
+	page.Pages = nil
+	if sub := d.tree(p.ID, -1); len(sub) > 0 {
+		page.Pages = sub
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) editPage(w http.ResponseWriter, r *http.Request) {
+	d, p := s.lookupPage(w, r)
+	if p == nil {
+		return
+	}
+	var req clickupv3.PublicDocsPublicEditPageOptionsDto
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name != nil {
+		p.Name = *req.Name
+	}
+	if req.SubTitle != nil {
+		p.SubTitle = req.SubTitle
+	}
+	if req.Content != nil {
+		mode := "replace"
+		if req.ContentEditMode != nil {
+			mode = strings.ToLower(*req.ContentEditMode)
+		}
+		switch mode {
+		case "replace":
+			p.Content = *req.Content
+		case "append":
+			p.Content += *req.Content
+		case "prepend":
+			p.Content = *req.Content + p.Content
+		default:
+			writeError(w, http.StatusBadRequest, codeBadRequest, "Invalid content_edit_mode: %s", mode)
+			return
+		}
+	}
+	now := float32(s.Now().UnixMilli())
+	p.DateUpdated = &now
+	s.touch(d)
+	writeJSON(w, http.StatusOK, nil)
+}
+
+// touch bumps a doc's date_updated.
+func (s *Server) touch(d *docState) {
+	now := float32(s.Now().UnixMilli())
+	d.DateUpdated = &now
+}
@@ -15,10 +15,15 @@
 # Usage:
 #   make smoke                 # uses installed `clickup`
 #   BIN=./bin/clickup make smoke   # uses a local build
+#   RECORD=smoke.yaml make smoke   # also append a scrubbed cassette of every
+#                                  # API call (see internal/cassette)
 
 set -euo pipefail
 
 BIN="${BIN:-clickup}"
+if [ -n "${RECORD:-}" ]; then
+  export CLICKUP_RECORD="$RECORD"
+fi
 TOKEN="smoke$(date +%s)"
 PARENT_ID=""
 SUBTASK_IDS=()
@@ -115,3 +120,6 @@ PARENT_ID=""
 SUBTASK_IDS=()
 
 printf '\n✓ all smoke tests passed\n'
+if [ -n "${RECORD:-}" ]; then
+  printf '  cassette written to %s\n' "$RECORD"
+fi